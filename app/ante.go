@@ -15,7 +15,6 @@ import (
 
 	"github.com/confio/tgrade/x/globalfee"
 	"github.com/confio/tgrade/x/poe"
-	poekeeper "github.com/confio/tgrade/x/poe/keeper"
 	poetypes "github.com/confio/tgrade/x/poe/types"
 )
 
@@ -32,7 +31,9 @@ type HandlerOptions struct {
 	WasmConfig        *wasmtypes.WasmConfig
 	TXCounterStoreKey sdk.StoreKey
 	GlobalFeeSubspace paramtypes.Subspace
-	ContractSource    poekeeper.ContractSource
+	BasePriceSource   globalfee.BasePriceSource
+	DenomRatioSource  globalfee.DenomRatioSource
+	FeeSource         poe.FeeDistributionSource
 }
 
 // NewAnteHandler constructor that setup the full ante handler chain for the application
@@ -58,8 +59,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.GlobalFeeSubspace.Name() == "" {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "param store is required for ante builder")
 	}
-	if options.ContractSource == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "contract source is required for ante builder")
+	if options.BasePriceSource == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "base price source is required for ante builder")
+	}
+	if options.DenomRatioSource == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "denom ratio source is required for ante builder")
+	}
+	if options.FeeSource == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee source is required for ante builder")
 	}
 	if options.IBCCoreKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "ibc core keeper is required for ante builder")
@@ -77,12 +84,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreKey),
 		ante.NewRejectExtensionOptionsDecorator(),
 		ante.NewMempoolFeeDecorator(),
-		globalfee.NewGlobalMinimumChainFeeDecorator(options.GlobalFeeSubspace), // after local min fee check
+		globalfee.NewGlobalMinimumChainFeeDecorator(options.GlobalFeeSubspace, options.BasePriceSource, options.DenomRatioSource), // after local min fee check
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		poe.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.ContractSource),
+		poe.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.FeeSource),
 
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
@@ -10,6 +10,7 @@ import (
 	"github.com/confio/tgrade/app/upgrades"
 	v2 "github.com/confio/tgrade/app/upgrades/v2"
 	v3 "github.com/confio/tgrade/app/upgrades/v3"
+	v4 "github.com/confio/tgrade/app/upgrades/v4"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -153,9 +154,10 @@ var (
 		icatypes.ModuleName:         nil,
 		twasm.ModuleName:            {authtypes.Minter, authtypes.Burner},
 		poetypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		poetypes.ModuleName:         {authtypes.Burner},
 	}
 
-	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade, v4.Upgrade}
 )
 
 var (
@@ -191,6 +193,8 @@ type TgradeApp struct {
 	authzKeeper      authzkeeper.Keeper
 	twasmKeeper      twasmkeeper.Keeper
 	poeKeeper        poekeeper.Keeper
+	basePriceKeeper  globalfee.BasePriceKeeper
+	denomRatioKeeper globalfee.DenomRatioKeeper
 
 	scopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	scopedICAHostKeeper  capabilitykeeper.ScopedKeeper
@@ -234,6 +238,7 @@ func NewTgradeApp(
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey, wasm.StoreKey, poe.StoreKey, icahosttypes.StoreKey,
+		globalfee.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -368,7 +373,15 @@ func NewTgradeApp(
 	// if we want to allow any custom callbacks
 	availableCapabilities := "staking,stargate,iterator,tgrade,cosmwasm_1_1"
 
-	wasmOpts = append(SetupWasmHandlers(appCodec, app.bankKeeper, govRouter, &app.twasmKeeper, &app.poeKeeper, app), wasmOpts...)
+	app.denomRatioKeeper = globalfee.NewDenomRatioKeeper(keys[globalfee.StoreKey], app.getSubspace(globalfee.ModuleName))
+	wasmOpts = append(SetupWasmHandlers(appCodec, WasmHandlerKeepers{
+		BankKeeper:             app.bankKeeper,
+		GovRouter:              govRouter,
+		TWasmKeeper:            &app.twasmKeeper,
+		PoEKeeper:              &app.poeKeeper,
+		ConsensusParamsUpdater: app,
+		FeeDenomRatioUpdater:   app.denomRatioKeeper,
+	}), wasmOpts...)
 
 	stakingAdapter := stakingKeeper
 	app.twasmKeeper = twasmkeeper.NewKeeper(
@@ -409,6 +422,11 @@ func NewTgradeApp(
 		app.twasmKeeper,
 		app.accountKeeper,
 	)
+	app.basePriceKeeper = globalfee.NewBasePriceKeeper(
+		appCodec,
+		keys[globalfee.StoreKey],
+		app.getSubspace(globalfee.ModuleName),
+	)
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -438,7 +456,7 @@ func NewTgradeApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
-		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName), app.basePriceKeeper, app.denomRatioKeeper),
 		icaModule,
 		crisis.NewAppModule(&app.crisisKeeper, skipGenesisInvariants),
 	)
@@ -523,6 +541,7 @@ func NewTgradeApp(
 	app.mm.RegisterServices(app.configurator)
 
 	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -547,7 +566,7 @@ func NewTgradeApp(
 		),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
-		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName), app.basePriceKeeper, app.denomRatioKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -568,7 +587,9 @@ func NewTgradeApp(
 			WasmConfig:        &twasmConfig.WasmConfig,
 			TXCounterStoreKey: keys[twasm.StoreKey],
 			GlobalFeeSubspace: app.getSubspace(globalfee.ModuleName),
-			ContractSource:    &app.poeKeeper,
+			BasePriceSource:   app.basePriceKeeper,
+			DenomRatioSource:  app.denomRatioKeeper,
+			FeeSource:         &app.poeKeeper,
 		},
 	)
 	if err != nil {
@@ -717,12 +738,32 @@ func (app *TgradeApp) setupUpgradeHandlers() {
 			upgrade.CreateUpgradeHandler(
 				app.mm,
 				app.configurator,
-				app.accountKeeper,
+				upgrades.AppKeepers{
+					AccountKeeper: app.accountKeeper,
+					TWasmKeeper:   app.twasmKeeper,
+				},
 			),
 		)
 	}
 }
 
+// setupUpgradeStoreLoaders adds the new stores of a scheduled upgrade at the upgrade height
+func (app *TgradeApp) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+	if app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
+
 // RegisterSwaggerAPI registers swagger route with API Server
 func RegisterSwaggerAPI(rtr *mux.Router) {
 	statikFS, err := fs.New()
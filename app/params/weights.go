@@ -2,10 +2,13 @@ package params
 
 // Default simulation operation weights for messages and gov proposals
 const (
-	DefaultWeightMsgCreateValidator int = 100
-	DefaultWeightMsgUpdateValidator int = 10
-	DefaultWeightMsgDelegate        int = 200
-	DefaultWeightMsgUndelegate      int = 50
+	DefaultWeightMsgCreateValidator    int = 100
+	DefaultWeightMsgUpdateValidator    int = 10
+	DefaultWeightMsgDelegate           int = 200
+	DefaultWeightMsgUndelegate         int = 50
+	DefaultWeightMsgClaimRewards       int = 50
+	DefaultWeightMsgSetWithdrawAddress int = 20
+	DefaultWeightMsgUnjail             int = 20
 
 	DefaultWeightMsgStoreCode           int = 50
 	DefaultWeightMsgInstantiateContract int = 100
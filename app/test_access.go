@@ -11,6 +11,8 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+
+	twasmkeeper "github.com/confio/tgrade/x/twasm/keeper"
 )
 
 type TestSupport struct {
@@ -57,3 +59,7 @@ func (s TestSupport) TransferKeeper() ibctransferkeeper.Keeper {
 func (s TestSupport) AccountKeeper() authkeeper.AccountKeeper {
 	return s.app.accountKeeper
 }
+
+func (s TestSupport) TWasmKeeper() twasmkeeper.Keeper {
+	return s.app.twasmKeeper
+}
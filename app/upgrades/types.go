@@ -1,11 +1,20 @@
 package upgrades
 
 import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	twasmkeeper "github.com/confio/tgrade/x/twasm/keeper"
 )
 
+// AppKeepers contains the keepers that an upgrade handler can use to migrate state
+type AppKeepers struct {
+	AccountKeeper authkeeper.AccountKeeper
+	TWasmKeeper   twasmkeeper.Keeper
+}
+
 // Upgrade defines a struct containing necessary fields that a SoftwareUpgradeProposal
 // must have written, in order for the state migration to go smoothly.
 // An upgrade must implement this struct, and then set it in the app.go.
@@ -15,5 +24,8 @@ type Upgrade struct {
 	UpgradeName string
 
 	// CreateUpgradeHandler defines the function that creates an upgrade handler
-	CreateUpgradeHandler func(*module.Manager, module.Configurator, authkeeper.AccountKeeper) upgradetypes.UpgradeHandler
+	CreateUpgradeHandler func(*module.Manager, module.Configurator, AppKeepers) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades are the stores added, renamed or deleted with the upgrade
+	StoreUpgrades store.StoreUpgrades
 }
@@ -5,9 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/confio/tgrade/app/upgrades"
 )
 
 const (
@@ -88,8 +89,9 @@ func Addresses() []string {
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
-	ak authkeeper.AccountKeeper,
+	keepers upgrades.AppKeepers,
 ) upgradetypes.UpgradeHandler {
+	ak := keepers.AccountKeeper
 	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		for _, addr := range addresses {
 			accAddr, err := sdk.AccAddressFromBech32(addr)
@@ -16,6 +16,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/confio/tgrade/app"
+	"github.com/confio/tgrade/app/upgrades"
 	v3 "github.com/confio/tgrade/app/upgrades/v3"
 )
 
@@ -43,7 +44,7 @@ func TestCreateUpgradeHandler(t *testing.T) {
 		require.NotNil(t, ak.GetAccount(ctx, acc.GetAddress()))
 	}
 	// when
-	handler := v3.CreateUpgradeHandler(&module.Manager{}, module.NewConfigurator(nil, nil, nil), ak)
+	handler := v3.CreateUpgradeHandler(&module.Manager{}, module.NewConfigurator(nil, nil, nil), upgrades.AppKeepers{AccountKeeper: ak})
 	_, err := handler(ctx, upgradetypes.Plan{}, module.VersionMap{})
 	// then
 	require.NoError(t, err)
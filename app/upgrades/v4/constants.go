@@ -0,0 +1,19 @@
+package v4
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/confio/tgrade/app/upgrades"
+	"github.com/confio/tgrade/x/globalfee"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Tgrade v4 upgrade.
+const UpgradeName = "v4"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{globalfee.StoreKey},
+	},
+}
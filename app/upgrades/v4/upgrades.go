@@ -0,0 +1,47 @@
+package v4
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/confio/tgrade/app/upgrades"
+	poetypes "github.com/confio/tgrade/x/poe/types"
+	twasmtypes "github.com/confio/tgrade/x/twasm/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the tgrade params are new with this version so that the bounds and limits are not set on the chain
+		keepers.TWasmKeeper.SetTgradeParams(ctx, twasmtypes.DefaultTgradeParams())
+		if err := ensurePoEModuleAccount(ctx, keepers.AccountKeeper); err != nil {
+			return nil, err
+		}
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+// ensurePoEModuleAccount sets up the poe module account that receives the fee share to be burned.
+// The address may already hold a plain account when funds were sent to it before the upgrade.
+// Such an account is converted in place, keeping its account number and sequence.
+func ensurePoEModuleAccount(ctx sdk.Context, ak authkeeper.AccountKeeper) error {
+	addr := authtypes.NewModuleAddress(poetypes.ModuleName)
+	switch acc := ak.GetAccount(ctx, addr).(type) {
+	case nil:
+		ak.GetModuleAccount(ctx, poetypes.ModuleName) // creates the account with the registered permissions
+	case authtypes.ModuleAccountI:
+	case *authtypes.BaseAccount:
+		ak.SetModuleAccount(ctx, authtypes.NewModuleAccount(acc, poetypes.ModuleName, authtypes.Burner))
+	default:
+		return fmt.Errorf("unexpected account type %T for poe module address %s", acc, addr)
+	}
+	return nil
+}
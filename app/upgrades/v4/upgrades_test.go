@@ -0,0 +1,112 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/confio/tgrade/app"
+	"github.com/confio/tgrade/app/upgrades"
+	v4 "github.com/confio/tgrade/app/upgrades/v4"
+	poetypes "github.com/confio/tgrade/x/poe/types"
+	twasmtypes "github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestCreateUpgradeHandler(t *testing.T) {
+	poeAddr := authtypes.NewModuleAddress(poetypes.ModuleName)
+	specs := map[string]struct {
+		setup     func(ctx sdk.Context, ak authkeeper.AccountKeeper)
+		expAccNum *uint64
+		expSeq    uint64
+	}{
+		"no account": {
+			setup: func(ctx sdk.Context, ak authkeeper.AccountKeeper) {},
+		},
+		"base account converted": {
+			setup: func(ctx sdk.Context, ak authkeeper.AccountKeeper) {
+				acc := authtypes.NewBaseAccount(poeAddr, nil, 123, 7)
+				ak.SetAccount(ctx, acc)
+			},
+			expAccNum: uint64Ptr(123),
+			expSeq:    7,
+		},
+		"module account kept": {
+			setup: func(ctx sdk.Context, ak authkeeper.AccountKeeper) {
+				acc := authtypes.NewModuleAccount(authtypes.NewBaseAccount(poeAddr, nil, 124, 0), poetypes.ModuleName, authtypes.Burner)
+				ak.SetAccount(ctx, acc)
+			},
+			expAccNum: uint64Ptr(124),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tgrade := app.Setup(true)
+			tgrade.InitChain(
+				abci.RequestInitChain{
+					Validators:      []abci.ValidatorUpdate{},
+					ConsensusParams: app.DefaultConsensusParams,
+					AppStateBytes:   []byte(`{}`),
+				},
+			)
+			h := app.NewTestSupport(t, tgrade)
+			ak := h.AccountKeeper()
+			ctx := tgrade.NewContext(false, tmproto.Header{})
+			spec.setup(ctx, ak)
+
+			// when
+			handler := v4.CreateUpgradeHandler(&module.Manager{}, module.NewConfigurator(nil, nil, nil), upgrades.AppKeepers{AccountKeeper: ak, TWasmKeeper: h.TWasmKeeper()})
+			_, err := handler(ctx, upgradetypes.Plan{}, module.VersionMap{})
+
+			// then
+			require.NoError(t, err)
+			acc, ok := ak.GetAccount(ctx, poeAddr).(authtypes.ModuleAccountI)
+			require.True(t, ok, "module account")
+			assert.Equal(t, poetypes.ModuleName, acc.GetName())
+			assert.True(t, acc.HasPermission(authtypes.Burner))
+			if spec.expAccNum != nil {
+				assert.Equal(t, *spec.expAccNum, acc.GetAccountNumber())
+			}
+			assert.Equal(t, spec.expSeq, acc.GetSequence())
+		})
+	}
+}
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
+
+func TestCreateUpgradeHandlerSetsTgradeParams(t *testing.T) {
+	tgrade := app.Setup(true)
+	tgrade.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: app.DefaultConsensusParams,
+			AppStateBytes:   []byte(`{}`),
+		},
+	)
+	h := app.NewTestSupport(t, tgrade)
+	k := h.TWasmKeeper()
+	ctx := tgrade.NewContext(false, tmproto.Header{})
+	// the params are not set on a chain before the upgrade
+	k.SetTgradeParams(ctx, twasmtypes.TgradeParams{})
+	require.Zero(t, k.GetTgradeParams(ctx).ConsensusParamBounds.MinBlockMaxGas)
+
+	// when
+	handler := v4.CreateUpgradeHandler(&module.Manager{}, module.NewConfigurator(nil, nil, nil), upgrades.AppKeepers{AccountKeeper: h.AccountKeeper(), TWasmKeeper: k})
+	_, err := handler(ctx, upgradetypes.Plan{}, module.VersionMap{})
+
+	// then
+	require.NoError(t, err)
+	got := k.GetTgradeParams(ctx)
+	assert.Equal(t, twasmtypes.DefaultTgradeParams().ConsensusParamBounds, got.ConsensusParamBounds)
+	assert.Equal(t, twasmtypes.DefaultMinBlockMaxBytes, got.ConsensusParamBounds.MinBlockMaxBytes)
+	assert.Equal(t, twasmtypes.DefaultMinBlockMaxGas, got.ConsensusParamBounds.MinBlockMaxGas)
+}
@@ -3,44 +3,54 @@ package app
 import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	poekeeper "github.com/confio/tgrade/x/poe/keeper"
 	poewasm "github.com/confio/tgrade/x/poe/wasm"
 	twasmkeeper "github.com/confio/tgrade/x/twasm/keeper"
 	twasmtypes "github.com/confio/tgrade/x/twasm/types"
 )
 
-func SetupWasmHandlers(
-	cdc codec.Codec,
-	bankKeeper twasmtypes.BankKeeper,
-	govRouter govtypes.Router,
-	twasmKeeper twasmkeeper.TgradeWasmHandlerKeeper,
-	poeKeeper poewasm.ViewKeeper,
-	consensusParamsUpdater twasmkeeper.ConsensusParamsUpdater,
-) []wasmkeeper.Option {
+// PoEWasmKeeper is the subset of the PoE keeper used by the wasm handlers
+type PoEWasmKeeper interface {
+	poewasm.ViewKeeper
+	poekeeper.SlashingRecorder
+	poekeeper.StakingMessageKeeper
+}
+
+// TWasmHandlerKeeper is the subset of the twasm keeper used by the wasm handlers
+type TWasmHandlerKeeper interface {
+	twasmkeeper.TgradeWasmHandlerKeeper
+	poekeeper.ContractKeeperSource
+}
+
+// WasmHandlerKeepers groups the dependencies of the custom wasm message and query handlers
+type WasmHandlerKeepers struct {
+	BankKeeper             twasmtypes.BankKeeper
+	GovRouter              govtypes.Router
+	TWasmKeeper            TWasmHandlerKeeper
+	PoEKeeper              PoEWasmKeeper
+	ConsensusParamsUpdater twasmkeeper.ConsensusParamsUpdater
+	FeeDenomRatioUpdater   twasmkeeper.FeeDenomRatioUpdater
+}
+
+func SetupWasmHandlers(cdc codec.Codec, keepers WasmHandlerKeepers) []wasmkeeper.Option {
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Staking: poewasm.StakingQuerier(poeKeeper),
-		Custom:  poewasm.CustomQuerier(poeKeeper),
+		Staking: poewasm.StakingQuerier(keepers.PoEKeeper),
+		Custom:  poewasm.CustomQuerier(keepers.PoEKeeper),
 	})
 
 	extMessageHandlerOpt := wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
-		return wasmkeeper.NewMessageHandlerChain(
-			// disable staking messages
-			wasmkeeper.MessageHandlerFunc(func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
-				if msg.Staking != nil {
-					return nil, nil, sdkerrors.Wrap(wasmtypes.ErrExecuteFailed, "not supported, yet")
-				}
-				return nil, nil, wasmtypes.ErrUnknownMsg
-			}),
+		chain := wasmkeeper.NewMessageHandlerChain(
+			// map staking and distribution messages to the PoE contracts
+			poekeeper.NewStakingMessageHandler(keepers.PoEKeeper, keepers.TWasmKeeper),
 			nested,
 			// append our custom message handler
-			twasmkeeper.NewTgradeHandler(cdc, twasmKeeper, bankKeeper, consensusParamsUpdater, govRouter),
+			twasmkeeper.NewTgradeHandler(cdc, keepers.TWasmKeeper, keepers.BankKeeper, keepers.ConsensusParamsUpdater, keepers.GovRouter, keepers.FeeDenomRatioUpdater),
 		)
+		// record the slashes sent by the valset contract
+		return poekeeper.NewSlashingRecorderMessenger(chain, keepers.PoEKeeper)
 	})
 	return []wasm.Option{
 		queryPluginOpt,
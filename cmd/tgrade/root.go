@@ -37,6 +37,7 @@ import (
 
 	"github.com/confio/tgrade/app"
 	appparams "github.com/confio/tgrade/app/params"
+	globalfeecli "github.com/confio/tgrade/x/globalfee/client/cli"
 	"github.com/confio/tgrade/x/poe/client/cli"
 )
 
@@ -81,10 +82,6 @@ func NewRootCmd() (*cobra.Command, appparams.EncodingConfig) {
 				return err
 			}
 
-			if areFeesTooHigh(cmd) {
-				return fmt.Errorf("are you really really sure that you want to send this amount of fees? CLI is preventing fees higher than %dtgd", maxFees)
-			}
-
 			initClientCtx, err = config.ReadFromClientConfig(initClientCtx)
 			if err != nil {
 				return err
@@ -94,6 +91,14 @@ func NewRootCmd() (*cobra.Command, appparams.EncodingConfig) {
 				return err
 			}
 
+			if err := globalfeecli.ResolveAutoFees(cmd, app.BaseCoinUnit); err != nil {
+				return err
+			}
+
+			if areFeesTooHigh(cmd) {
+				return fmt.Errorf("are you really really sure that you want to send this amount of fees? CLI is preventing fees higher than %dtgd", maxFees)
+			}
+
 			return server.InterceptConfigsPreRunHandler(cmd, "", nil)
 		},
 	}
@@ -129,6 +134,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appparams.EncodingConfig
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		poeCommand(),
 		keys.Commands(app.DefaultNodeHome),
 	)
 }
@@ -177,6 +183,22 @@ func queryCommand() *cobra.Command {
 	return cmd
 }
 
+func poeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "poe",
+		Short:                      "Proof of Engagement tools",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		cli.NewSimulateRewardsCmd(),
+	)
+
+	return cmd
+}
+
 func txCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "tx",
@@ -347,7 +369,11 @@ func areFeesTooHigh(cmd *cobra.Command) bool {
 			return false
 		}
 
-		parsedGasPrices, err := sdk.ParseDecCoins(flags.FlagGasPrices)
+		gasPrices, err := cmd.Flags().GetString(flags.FlagGasPrices)
+		if err != nil {
+			return false
+		}
+		parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
 		if err != nil {
 			return false
 		}
@@ -119,7 +119,7 @@ func TestPoEAddPostGenesisValidatorWithAutoEngagementPoints(t *testing.T) {
 	//    and: is added to the active validator set
 	cli := NewTgradeCli(t, sut, verbose)
 	sut.ModifyGenesisJSON(t,
-		SetPoEParamsMutator(t, poetypes.NewParams(100, 10, sdk.NewCoins(sdk.NewCoin("utgd", sdk.NewInt(5))))),
+		SetPoEParamsMutator(t, poetypes.NewParams(100, 10, sdk.NewCoins(sdk.NewCoin("utgd", sdk.NewInt(5))), poetypes.DefaultSignedBlocksWindow, poetypes.DefaultHistoricalValsetMode, poetypes.DefaultFeeSplit())),
 	)
 	sut.StartChain(t)
 	newNode := sut.AddFullnode(t)
@@ -152,7 +152,7 @@ func TestPoEAddPostGenesisValidatorWithGovProposalEngagementPoints(t *testing.T)
 	//   then: is added to the active validator set
 	cli := NewTgradeCli(t, sut, verbose)
 	sut.ModifyGenesisJSON(t,
-		SetPoEParamsMutator(t, poetypes.NewParams(100, 0, sdk.NewCoins(sdk.NewCoin("utgd", sdk.NewInt(5))))),
+		SetPoEParamsMutator(t, poetypes.NewParams(100, 0, sdk.NewCoins(sdk.NewCoin("utgd", sdk.NewInt(5))), poetypes.DefaultSignedBlocksWindow, poetypes.DefaultHistoricalValsetMode, poetypes.DefaultFeeSplit())),
 	)
 	sut.StartChain(t)
 	engagementGroupAddr := gjson.Get(cli.CustomQuery("q", "poe", "contract-address", "ENGAGEMENT"), "address").String()
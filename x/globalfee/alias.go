@@ -8,4 +8,5 @@ import (
 
 const (
 	ModuleName = types.ModuleName
+	StoreKey   = types.StoreKey
 )
@@ -16,49 +16,140 @@ type paramSource interface {
 	Has(ctx sdk.Context, key []byte) bool
 }
 
+// BasePriceSource provides the dynamic base gas price
+type BasePriceSource interface {
+	GetBasePrice(ctx sdk.Context) (sdk.DecCoin, bool)
+}
+
 // GlobalMinimumChainFeeDecorator Ante decorator that enforces a minimum fee set for all transactions.
-// This minimum can be 0 though.
+// This minimum can be 0 though. When the dynamic base price is enabled, the max of the minimum gas price and the base
+// price is required for the base price denom. Denoms with a ratio to the base denom are accepted at the derived price.
+// Transactions that contain only messages of the bypass types and stay within the max bypass gas are free of the minimum.
 type GlobalMinimumChainFeeDecorator struct {
 	paramSource paramSource
+	basePrices  BasePriceSource
+	denomRatios DenomRatioSource
 }
 
 // NewGlobalMinimumChainFeeDecorator constructor
-func NewGlobalMinimumChainFeeDecorator(paramSpace paramtypes.Subspace) GlobalMinimumChainFeeDecorator {
+func NewGlobalMinimumChainFeeDecorator(paramSpace paramtypes.Subspace, basePrices BasePriceSource, denomRatios DenomRatioSource) GlobalMinimumChainFeeDecorator {
 	if !paramSpace.HasKeyTable() {
 		panic("paramspace was not set up via module")
 	}
 
 	return GlobalMinimumChainFeeDecorator{
 		paramSource: paramSpace,
+		basePrices:  basePrices,
+		denomRatios: denomRatios,
 	}
 }
 
 // AnteHandle method that performs custom pre- and post-processing.
 func (g GlobalMinimumChainFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if !simulate && g.paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
-		feeTx, ok := tx.(sdk.FeeTx)
-		if !ok {
-			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a sdk FeeTx")
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+	minGasPrices := effectiveGasPrices(ctx, g.paramSource, g.basePrices, g.denomRatios)
+	if minGasPrices.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a sdk FeeTx")
+	}
+	if !g.isBypassTx(ctx, feeTx) {
+		requiredFees := requiredFees(minGasPrices, feeTx.GetGas())
+		if !feeTx.GetFee().IsAnyGTE(requiredFees) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "got: %s required: %s", feeTx.GetFee(), requiredFees)
 		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// effectiveGasPrices returns the minimum gas prices with the dynamic base price and the prices that are derived from
+// the denom ratios applied
+func effectiveGasPrices(ctx sdk.Context, paramSource paramSource, basePrices BasePriceSource, denomRatios DenomRatioSource) sdk.DecCoins {
+	var gasPrices sdk.DecCoins
+	if paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &gasPrices)
+	}
+	if basePrice, ok := basePrices.GetBasePrice(ctx); ok {
+		gasPrices = MaxGasPrices(gasPrices, basePrice)
+	}
+	if !paramSource.Has(ctx, types.ParamStoreKeyBaseDenomPrice) {
+		return gasPrices
+	}
+	ratios := denomRatios.GetDenomRatios(ctx)
+	var baseDenomPrice sdk.DecCoin
+	paramSource.Get(ctx, types.ParamStoreKeyBaseDenomPrice, &baseDenomPrice)
+	if ratios.Empty() || baseDenomPrice.Denom == "" {
+		return gasPrices
+	}
+	// the ratios apply to the highest price of the base denom
+	if amount := gasPrices.AmountOf(baseDenomPrice.Denom); amount.GT(baseDenomPrice.Amount) {
+		baseDenomPrice.Amount = amount
+	}
+	gasPrices = MaxGasPrices(gasPrices, baseDenomPrice)
+	for _, r := range ratios {
+		gasPrices = MaxGasPrices(gasPrices, sdk.NewDecCoinFromDec(r.Denom, baseDenomPrice.Amount.Mul(r.Amount)))
+	}
+	return gasPrices
+}
 
-		var minGasPrices sdk.DecCoins
-		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
-		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
+// requiredFees determines the required fees by multiplying each required minimum gas
+// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+func requiredFees(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	result := make(sdk.Coins, len(gasPrices))
+	glDec := sdk.NewDec(int64(gasLimit))
+	for i, gp := range gasPrices {
+		fee := gp.Amount.Mul(glDec)
+		amount := fee.Ceil().RoundInt()
+		result[i] = sdk.NewCoin(gp.Denom, amount)
+	}
+	return result
+}
 
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(feeTx.GetGas()))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				amount := fee.Ceil().RoundInt()
-				requiredFees[i] = sdk.NewCoin(gp.Denom, amount)
-			}
+// MaxGasPrices returns the gas prices with the amount of the base price denom raised to the base price.
+// The other denoms stay alternatives.
+func MaxGasPrices(gasPrices sdk.DecCoins, basePrice sdk.DecCoin) sdk.DecCoins {
+	if gasPrices.AmountOf(basePrice.Denom).GTE(basePrice.Amount) {
+		return gasPrices
+	}
+	result := make(sdk.DecCoins, 0, len(gasPrices)+1)
+	for _, c := range gasPrices {
+		if c.Denom != basePrice.Denom {
+			result = append(result, c)
+		}
+	}
+	return sdk.NewDecCoins(append(result, basePrice)...)
+}
 
-			if !feeTx.GetFee().IsAnyGTE(requiredFees) {
-				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "got: %s required: %s", feeTx.GetFee(), requiredFees)
-			}
+// isBypassTx returns true when all messages of the tx are of a bypass type and the gas limit does not exceed the
+// max bypass gas
+func (g GlobalMinimumChainFeeDecorator) isBypassTx(ctx sdk.Context, feeTx sdk.FeeTx) bool {
+	if !g.paramSource.Has(ctx, types.ParamStoreKeyBypassMsgTypes) || !g.paramSource.Has(ctx, types.ParamStoreKeyMaxBypassGas) {
+		return false
+	}
+	var maxBypassGas uint64
+	g.paramSource.Get(ctx, types.ParamStoreKeyMaxBypassGas, &maxBypassGas)
+	if feeTx.GetGas() > maxBypassGas {
+		return false
+	}
+	var bypassMsgTypes []string
+	g.paramSource.Get(ctx, types.ParamStoreKeyBypassMsgTypes, &bypassMsgTypes)
+	msgs := feeTx.GetMsgs()
+	if len(msgs) == 0 || len(bypassMsgTypes) == 0 {
+		return false
+	}
+	allowed := make(map[string]struct{}, len(bypassMsgTypes))
+	for _, t := range bypassMsgTypes {
+		allowed[t] = struct{}{}
+	}
+	for _, msg := range msgs {
+		if _, ok := allowed[sdk.MsgTypeURL(msg)]; !ok {
+			return false
 		}
 	}
-	return next(ctx, tx, simulate)
+	return true
 }
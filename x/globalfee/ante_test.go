@@ -9,8 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,13 +24,25 @@ import (
 )
 
 func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
+	bypassParams := types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		BypassMsgTypes:   []string{"/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket"},
+		MaxBypassGas:     2,
+	}
+	ratioParams := types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		BaseDenomPrice:   sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+		DenomRatios:      sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2)), sdk.NewDecCoinFromDec("CLX", sdk.NewDecWithPrec(5, 1))),
+	}
 	specs := map[string]struct {
-		setupStore func(ctx sdk.Context, s paramstypes.Subspace)
-		next       sdk.AnteDecorator
-		simulation bool
-		feeAmount  sdk.Coins
-		gasLimit   sdk.Gas
-		expErr     *sdkerrors.Error
+		setupStore   func(ctx sdk.Context, s paramstypes.Subspace)
+		oracleRatios sdk.DecCoins
+		next         sdk.AnteDecorator
+		simulation   bool
+		msgs         []sdk.Msg
+		feeAmount    sdk.Coins
+		gasLimit     sdk.Gas
+		expErr       *sdkerrors.Error
 	}{
 		"single fee above min": {
 			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
@@ -160,6 +175,179 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 			gasLimit: 1,
 			expErr:   sdkerrors.ErrInsufficientFee,
 		},
+		"bypass msgs only": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &bypassParams)
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{}},
+			gasLimit: 2,
+		},
+		"bypass msgs above max gas": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &bypassParams)
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}},
+			gasLimit: 3,
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"bypass msgs mixed with other msg": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &bypassParams)
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}, &banktypes.MsgSend{}},
+			gasLimit: 1,
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"bypass msgs with fee": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &bypassParams)
+			},
+			msgs:      []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			gasLimit:  2,
+		},
+		"no bypass msg types set": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					MaxBypassGas:     2,
+				})
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}},
+			gasLimit: 1,
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"bypass params not in store": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())))
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}},
+			gasLimit: 1,
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base price above min": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					DynamicBasePrice: dynamicBasePrice(sdk.NewDec(2)),
+				})
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base price paid": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					DynamicBasePrice: dynamicBasePrice(sdk.NewDec(2)),
+				})
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			gasLimit:  1,
+		},
+		"dynamic base price without min gas prices": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{DynamicBasePrice: dynamicBasePrice(sdk.NewDec(2))})
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base price below min": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+					DynamicBasePrice: dynamicBasePrice(sdk.OneDec()),
+				})
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base price with bypass msgs": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				p := bypassParams
+				p.DynamicBasePrice = dynamicBasePrice(sdk.NewDec(2))
+				s.SetParamSet(ctx, &p)
+			},
+			msgs:     []sdk.Msg{&ibcclienttypes.MsgUpdateClient{}},
+			gasLimit: 1,
+		},
+		"denom ratio fee equal derived price": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(4))),
+			gasLimit:  1,
+		},
+		"denom ratio fee below derived price": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(3))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"denom ratio fee with fraction": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("CLX", sdk.OneInt())),
+			gasLimit:  1,
+		},
+		"base denom price above min": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.OneInt())),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"oracle denom ratios replace params": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			oracleRatios: sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(3))),
+			feeAmount:    sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(6))),
+			gasLimit:     1,
+		},
+		"oracle denom ratios fee below derived price": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			oracleRatios: sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(3))),
+			feeAmount:    sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(5))),
+			gasLimit:     1,
+			expErr:       sdkerrors.ErrInsufficientFee,
+		},
+		"param denom not in oracle denom ratios": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &ratioParams)
+			},
+			oracleRatios: sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(3))),
+			feeAmount:    sdk.NewCoins(sdk.NewCoin("CLX", sdk.NewInt(100))),
+			gasLimit:     1,
+			expErr:       sdkerrors.ErrInsufficientFee,
+		},
+		"denom ratios apply to dynamic base price": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				p := ratioParams
+				p.DynamicBasePrice = dynamicBasePrice(sdk.NewDec(4))
+				s.SetParamSet(ctx, &p)
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(4))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
+		"denom ratios params not in store": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())))
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(4))),
+			gasLimit:  1,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
 		"simulation with no fee set": {
 			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
 				s.SetParamSet(ctx, &types.Params{
@@ -180,16 +368,20 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, encCfg, subspace := setupTestStore(t)
+			ctx, encCfg, subspace, basePrices, denomRatios := setupTestStore(t)
 			spec.setupStore(ctx, subspace)
+			if spec.oracleRatios != nil {
+				require.NoError(t, denomRatios.SetDenomRatios(ctx, spec.oracleRatios))
+			}
 
 			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(spec.msgs...))
 			txBuilder.SetFeeAmount(spec.feeAmount)
 			txBuilder.SetGasLimit(spec.gasLimit)
 			tx := txBuilder.GetTx()
 			captured := &CapturingAnteHandler{}
 			anteHandler := sdk.ChainAnteDecorators(
-				NewGlobalMinimumChainFeeDecorator(subspace),
+				NewGlobalMinimumChainFeeDecorator(subspace, basePrices, denomRatios),
 				captured,
 			)
 			_, gotErr := anteHandler(ctx, tx, spec.simulation)
@@ -203,14 +395,16 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 	}
 }
 
-func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace) {
+func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace, BasePriceKeeper, DenomRatioKeeper) {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	encCfg := simapp.MakeTestEncodingConfig()
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyGlobalFee := sdk.NewKVStoreKey(types.StoreKey)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGlobalFee, sdk.StoreTypeIAVL, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
@@ -221,7 +415,20 @@ func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, par
 	}, false, log.NewNopLogger())
 
 	subspace := paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable())
-	return ctx, encCfg, subspace
+	return ctx, encCfg, subspace, NewBasePriceKeeper(encCfg.Marshaler, keyGlobalFee, subspace), NewDenomRatioKeeper(keyGlobalFee, subspace)
+}
+
+// dynamicBasePrice returns an enabled dynamic base price that starts with the given price
+func dynamicBasePrice(price sdk.Dec) types.DynamicBasePrice {
+	return types.DynamicBasePrice{
+		Enabled:        true,
+		Denom:          "ALX",
+		MinBasePrice:   price,
+		MaxBasePrice:   price,
+		TargetBlockGas: 1,
+		MaxChangeRate:  sdk.OneDec(),
+		HistoryLength:  1,
+	}
 }
 
 type CapturingAnteHandler struct {
@@ -0,0 +1,131 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// BasePriceKeeper maintains the dynamic base gas price and its history
+type BasePriceKeeper struct {
+	cdc         codec.Codec
+	storeKey    sdk.StoreKey
+	paramSource paramSource
+}
+
+// NewBasePriceKeeper constructor
+func NewBasePriceKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSource paramSource) BasePriceKeeper {
+	return BasePriceKeeper{cdc: cdc, storeKey: storeKey, paramSource: paramSource}
+}
+
+// GetBasePrice returns the current base price. The bool is false when the dynamic base price is not enabled.
+func (k BasePriceKeeper) GetBasePrice(ctx sdk.Context) (sdk.DecCoin, bool) {
+	config, ok := k.getConfig(ctx)
+	if !ok {
+		return sdk.DecCoin{}, false
+	}
+	return sdk.NewDecCoinFromDec(config.Denom, k.currentBasePrice(ctx, config)), true
+}
+
+// UpdateBasePrice sets the base price for the next block from the gas used in the current block
+// and records it in the history.
+func (k BasePriceKeeper) UpdateBasePrice(ctx sdk.Context, blockGas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	config, ok := k.getConfig(ctx)
+	if !ok {
+		// start from the min base price again when enabled later
+		store.Delete(types.BasePriceKey)
+		return
+	}
+	next := config.NextBasePrice(k.currentBasePrice(ctx, config), blockGas)
+	bz, err := next.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BasePriceKey, bz)
+
+	store.Set(types.GetBasePriceHistoryKey(ctx.BlockHeight()), k.cdc.MustMarshal(&types.BasePriceRecord{
+		Height:    ctx.BlockHeight(),
+		BlockGas:  blockGas,
+		BasePrice: next,
+	}))
+	k.pruneHistory(ctx, ctx.BlockHeight()-int64(config.HistoryLength))
+}
+
+// IterateBasePriceHistory iterates over the base price records by height ascending until the callback returns true
+func (k BasePriceKeeper) IterateBasePriceHistory(ctx sdk.Context, cb func(types.BasePriceRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.BasePriceHistoryKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var r types.BasePriceRecord
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		if cb(r) {
+			return
+		}
+	}
+}
+
+// BasePriceHistory returns a page of the base price records by height ascending
+func (k BasePriceKeeper) BasePriceHistory(ctx sdk.Context, pagination *query.PageRequest) ([]types.BasePriceRecord, *query.PageResponse, error) {
+	var result []types.BasePriceRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BasePriceHistoryKeyPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var r types.BasePriceRecord
+		if err := k.cdc.Unmarshal(value, &r); err != nil {
+			return err
+		}
+		result = append(result, r)
+		return nil
+	})
+	return result, pageRes, err
+}
+
+// pruneHistory deletes all records up to including the given height
+func (k BasePriceKeeper) pruneHistory(ctx sdk.Context, height int64) {
+	if height < 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.BasePriceHistoryKeyPrefix, types.GetBasePriceHistoryKey(height+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// currentBasePrice returns the stored base price within the bounds or the min base price when not set
+func (k BasePriceKeeper) currentBasePrice(ctx sdk.Context, config types.DynamicBasePrice) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BasePriceKey)
+	if bz == nil {
+		return config.MinBasePrice
+	}
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	// bounds may have been changed by governance
+	switch {
+	case price.LT(config.MinBasePrice):
+		return config.MinBasePrice
+	case price.GT(config.MaxBasePrice):
+		return config.MaxBasePrice
+	}
+	return price
+}
+
+// getConfig returns the dynamic base price params when enabled
+func (k BasePriceKeeper) getConfig(ctx sdk.Context) (types.DynamicBasePrice, bool) {
+	if !k.paramSource.Has(ctx, types.ParamStoreKeyDynamicBasePrice) {
+		return types.DynamicBasePrice{}, false
+	}
+	var config types.DynamicBasePrice
+	k.paramSource.Get(ctx, types.ParamStoreKeyDynamicBasePrice, &config)
+	return config, config.Enabled
+}
@@ -0,0 +1,168 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestNextBasePrice(t *testing.T) {
+	config := types.DynamicBasePrice{
+		Enabled:        true,
+		Denom:          "ALX",
+		MinBasePrice:   sdk.NewDecWithPrec(1, 1),
+		MaxBasePrice:   sdk.NewDec(10),
+		TargetBlockGas: 1000,
+		MaxChangeRate:  sdk.NewDecWithPrec(125, 3),
+		HistoryLength:  10,
+	}
+	specs := map[string]struct {
+		current  sdk.Dec
+		blockGas uint64
+		exp      sdk.Dec
+	}{
+		"at target": {
+			current:  sdk.OneDec(),
+			blockGas: 1000,
+			exp:      sdk.OneDec(),
+		},
+		"twice the target": {
+			current:  sdk.OneDec(),
+			blockGas: 2000,
+			exp:      sdk.NewDecWithPrec(1125, 3),
+		},
+		"above twice the target is capped by max change rate": {
+			current:  sdk.OneDec(),
+			blockGas: 10_000,
+			exp:      sdk.NewDecWithPrec(1125, 3),
+		},
+		"half the target": {
+			current:  sdk.OneDec(),
+			blockGas: 500,
+			exp:      sdk.MustNewDecFromStr("0.9375"),
+		},
+		"empty block": {
+			current:  sdk.OneDec(),
+			blockGas: 0,
+			exp:      sdk.MustNewDecFromStr("0.875"),
+		},
+		"not below min": {
+			current:  sdk.NewDecWithPrec(1, 1),
+			blockGas: 0,
+			exp:      sdk.NewDecWithPrec(1, 1),
+		},
+		"not above max": {
+			current:  sdk.NewDec(10),
+			blockGas: 2000,
+			exp:      sdk.NewDec(10),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := config.NextBasePrice(spec.current, spec.blockGas)
+			assert.Equal(t, spec.exp.String(), got.String())
+		})
+	}
+}
+
+func TestUpdateBasePrice(t *testing.T) {
+	ctx, _, subspace, basePrices, _ := setupTestStore(t)
+	config := types.DynamicBasePrice{
+		Enabled:        true,
+		Denom:          "ALX",
+		MinBasePrice:   sdk.OneDec(),
+		MaxBasePrice:   sdk.NewDec(2),
+		TargetBlockGas: 1000,
+		MaxChangeRate:  sdk.NewDecWithPrec(5, 1),
+		HistoryLength:  2,
+	}
+
+	// not enabled without params
+	_, enabled := basePrices.GetBasePrice(ctx)
+	assert.False(t, enabled)
+	basePrices.UpdateBasePrice(ctx, 2000)
+
+	// starts at min
+	subspace.Set(ctx, types.ParamStoreKeyDynamicBasePrice, config)
+	got, enabled := basePrices.GetBasePrice(ctx)
+	require.True(t, enabled)
+	assert.Equal(t, sdk.NewDecCoinFromDec("ALX", sdk.OneDec()), got)
+
+	// when blocks are full
+	for i, exp := range []sdk.Dec{sdk.NewDecWithPrec(15, 1), sdk.NewDec(2), sdk.NewDec(2)} {
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		basePrices.UpdateBasePrice(ctx, 2000)
+		got, _ := basePrices.GetBasePrice(ctx)
+		assert.Equal(t, sdk.NewDecCoinFromDec("ALX", exp), got, "block %d", i+1)
+	}
+	// then history is pruned
+	var gotHistory []types.BasePriceRecord
+	basePrices.IterateBasePriceHistory(ctx, func(r types.BasePriceRecord) bool {
+		gotHistory = append(gotHistory, r)
+		return false
+	})
+	assert.Equal(t, []types.BasePriceRecord{
+		{Height: 2, BlockGas: 2000, BasePrice: sdk.NewDec(2)},
+		{Height: 3, BlockGas: 2000, BasePrice: sdk.NewDec(2)},
+	}, gotHistory)
+
+	// and bounds changed by governance are applied
+	config.MaxBasePrice = sdk.NewDecWithPrec(15, 1)
+	subspace.Set(ctx, types.ParamStoreKeyDynamicBasePrice, config)
+	got, _ = basePrices.GetBasePrice(ctx)
+	assert.Equal(t, sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(15, 1)), got)
+
+	// and disabling resets to min
+	config.Enabled = false
+	subspace.Set(ctx, types.ParamStoreKeyDynamicBasePrice, config)
+	basePrices.UpdateBasePrice(ctx.WithBlockHeight(4), 2000)
+	_, enabled = basePrices.GetBasePrice(ctx)
+	assert.False(t, enabled)
+	config.Enabled = true
+	subspace.Set(ctx, types.ParamStoreKeyDynamicBasePrice, config)
+	got, _ = basePrices.GetBasePrice(ctx)
+	assert.Equal(t, sdk.NewDecCoinFromDec("ALX", sdk.OneDec()), got)
+}
+
+func TestMaxGasPrices(t *testing.T) {
+	specs := map[string]struct {
+		src       sdk.DecCoins
+		basePrice sdk.DecCoin
+		exp       sdk.DecCoins
+	}{
+		"base price above min": {
+			src:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+			basePrice: sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+			exp:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+		},
+		"base price below min": {
+			src:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+			basePrice: sdk.NewDecCoin("ALX", sdk.OneInt()),
+			exp:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+		},
+		"other denoms stay": {
+			src:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt()), sdk.NewDecCoin("BLX", sdk.OneInt())),
+			basePrice: sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+			exp:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+		},
+		"base price denom not in min": {
+			src:       sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.OneInt())),
+			basePrice: sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+			exp:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+		},
+		"no min": {
+			basePrice: sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+			exp:       sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := MaxGasPrices(spec.src, spec.basePrice)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+const (
+	// FeesAuto is the value of the fees flag to use the estimated fee
+	FeesAuto = "auto"
+
+	flagDenoms = "denoms"
+)
+
+// ResolveAutoFees replaces `--fees auto` of a tx command with the minimum fee that is accepted by the chain and the
+// node. The preferred denom is used when accepted, otherwise the first accepted denom. When the gas is simulated,
+// the gas prices are set instead so that the fee is calculated for the simulated gas.
+// Commands without a fees flag are ignored.
+func ResolveAutoFees(cmd *cobra.Command, preferredDenom string) error {
+	fees, err := cmd.Flags().GetString(flags.FlagFees)
+	if err != nil || fees != FeesAuto {
+		return nil
+	}
+	if gasPrices, _ := cmd.Flags().GetString(flags.FlagGasPrices); gasPrices != "" {
+		return errors.New("cannot provide both auto fees and gas prices")
+	}
+	gasStr, _ := cmd.Flags().GetString(flags.FlagGas)
+	gasSetting, err := flags.ParseGasSetting(gasStr)
+	if err != nil {
+		return err
+	}
+	gas := gasSetting.Gas
+	if gasSetting.Simulate {
+		gas = flags.DefaultGasLimit
+	}
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := types.NewQueryClient(clientCtx).EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{Gas: gas})
+	if err != nil {
+		return err
+	}
+	if err := cmd.Flags().Set(flags.FlagFees, ""); err != nil {
+		return err
+	}
+	if res.GasPrices.Empty() { // no fee required
+		return nil
+	}
+	if gasSetting.Simulate {
+		gasPrice := res.GasPrices[0]
+		if amount := res.GasPrices.AmountOf(preferredDenom); amount.IsPositive() {
+			gasPrice = sdk.NewDecCoinFromDec(preferredDenom, amount)
+		}
+		return cmd.Flags().Set(flags.FlagGasPrices, gasPrice.String())
+	}
+	fee := res.Fees[0]
+	if amount := res.Fees.AmountOf(preferredDenom); amount.IsPositive() {
+		fee = sdk.NewCoin(preferredDenom, amount)
+	}
+	return cmd.Flags().Set(flags.FlagFees, fee.String())
+}
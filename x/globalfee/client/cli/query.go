@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -18,6 +20,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowMinimumGasPrices(),
+		GetCmdShowBypassMsgTypes(),
+		GetCmdShowBasePrice(),
+		GetCmdShowEffectiveGasPrices(),
+		GetCmdEstimateFee(),
 	)
 	return queryCmd
 }
@@ -46,3 +52,116 @@ func GetCmdShowMinimumGasPrices() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdShowBypassMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bypass-msg-types",
+		Short:   "Show bypass message types",
+		Long:    "Show the message types that are free of the minimum gas prices and the max gas of a bypass tx",
+		Aliases: []string{"bypass"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BypassMsgTypes(cmd.Context(), &types.QueryBypassMsgTypesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowBasePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-price",
+		Short: "Show the dynamic base gas price",
+		Long:  "Show the current dynamic base gas price and its history by height",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BasePrice(cmd.Context(), &types.QueryBasePriceRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "base price history")
+	return cmd
+}
+
+func GetCmdShowEffectiveGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "effective-gas-prices",
+		Short:   "Show effective gas prices",
+		Long:    "Show the gas prices per denom that are required, including the dynamic base price and the prices derived from the denom ratios",
+		Aliases: []string{"effective"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EffectiveGasPrices(cmd.Context(), &types.QueryEffectiveGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [gas]",
+		Short: "Estimate the fee for a gas limit",
+		Long: "Show the minimum fees per denom for the gas limit that are accepted by the chain and the minimum gas prices of the queried node. " +
+			"Each of the fees is sufficient on its own",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			gas, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			denoms, err := cmd.Flags().GetStringSlice(flagDenoms)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{Gas: gas, Denoms: denoms})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().StringSlice(flagDenoms, nil, "Fee denoms to estimate the fee for. All when empty")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
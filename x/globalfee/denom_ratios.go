@@ -0,0 +1,79 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// DenomRatioSource provides the denom ratios to the base denom price
+type DenomRatioSource interface {
+	GetDenomRatios(ctx sdk.Context) sdk.DecCoins
+}
+
+// DenomRatioKeeper maintains the denom ratios of the fee oracle contract. They are stored apart from the params so
+// that the governance params are only changed by governance.
+type DenomRatioKeeper struct {
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewDenomRatioKeeper constructor
+func NewDenomRatioKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) DenomRatioKeeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return DenomRatioKeeper{storeKey: storeKey, paramSpace: paramSpace}
+}
+
+// SetDenomRatios replaces the fee oracle denom ratios. The ratios are validated with the params rules.
+func (k DenomRatioKeeper) SetDenomRatios(ctx sdk.Context, ratios sdk.DecCoins) error {
+	if err := ratios.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	var params types.Params
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	if err := types.ValidateOracleDenomRatios(params, ratios); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleDenomRatioKeyPrefix)
+	for _, r := range k.GetOracleDenomRatios(ctx) {
+		store.Delete([]byte(r.Denom))
+	}
+	for _, r := range ratios {
+		bz, err := r.Amount.Marshal()
+		if err != nil {
+			return sdkerrors.Wrap(err, "marshal ratio")
+		}
+		store.Set([]byte(r.Denom), bz)
+	}
+	return nil
+}
+
+// GetOracleDenomRatios returns the denom ratios set by the fee oracle contract sorted by denom
+func (k DenomRatioKeeper) GetOracleDenomRatios(ctx sdk.Context) sdk.DecCoins {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleDenomRatioKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	var ratios sdk.DecCoins
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Dec
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		ratios = append(ratios, sdk.NewDecCoinFromDec(string(iter.Key()), amount))
+	}
+	return ratios
+}
+
+// GetDenomRatios returns the fee oracle denom ratios or the denom ratios param when the oracle has not set any
+func (k DenomRatioKeeper) GetDenomRatios(ctx sdk.Context) sdk.DecCoins {
+	if ratios := k.GetOracleDenomRatios(ctx); !ratios.Empty() {
+		return ratios
+	}
+	var ratios sdk.DecCoins
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDenomRatios, &ratios)
+	return ratios
+}
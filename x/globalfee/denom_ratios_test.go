@@ -0,0 +1,153 @@
+package globalfee
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestSetDenomRatios(t *testing.T) {
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		src        sdk.DecCoins
+		expErr     *sdkerrors.Error
+	}{
+		"all good": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt())})
+			},
+			src: sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2)), sdk.NewDecCoinFromDec("CLX", sdk.NewDecWithPrec(5, 1))),
+		},
+		"empty ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt())})
+			},
+			src: sdk.DecCoins{},
+		},
+		"empty ratios without base denom price": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{})
+			},
+			src: sdk.DecCoins{},
+		},
+		"no base denom price set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{})
+			},
+			src:    sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2))),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"no param set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+			src:        sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2))),
+			expErr:     wasmtypes.ErrInvalid,
+		},
+		"base denom in ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt())})
+			},
+			src:    sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"invalid ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt())})
+			},
+			src:    sdk.DecCoins{sdk.DecCoin{Denom: "BLX", Amount: sdk.ZeroDec()}},
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, subspace, _, k := setupTestStore(t)
+			spec.setupStore(ctx, subspace)
+			prevRatios := sdk.NewDecCoins(sdk.NewDecCoin("DLX", sdk.NewInt(3)))
+			k.setOracleDenomRatios(ctx, prevRatios)
+
+			// when
+			gotErr := k.SetDenomRatios(ctx, spec.src)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "exp : %s but got %#+v", spec.expErr, gotErr)
+			// the denom ratios param is not modified
+			assert.Empty(t, getDenomRatiosParam(ctx, subspace))
+			if spec.expErr != nil {
+				assert.Equal(t, prevRatios, k.GetOracleDenomRatios(ctx))
+				return
+			}
+			got := k.GetOracleDenomRatios(ctx)
+			assert.True(t, spec.src.IsEqual(got), "exp %s but got %s", spec.src, got)
+		})
+	}
+}
+
+func TestGetDenomRatios(t *testing.T) {
+	paramRatios := sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2)))
+	oracleRatios := sdk.NewDecCoins(sdk.NewDecCoin("CLX", sdk.NewInt(3)))
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace, k DenomRatioKeeper)
+		exp        sdk.DecCoins
+	}{
+		"oracle ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace, k DenomRatioKeeper) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt()), DenomRatios: paramRatios})
+				require.NoError(t, k.SetDenomRatios(ctx, oracleRatios))
+			},
+			exp: oracleRatios,
+		},
+		"param ratios without oracle ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace, k DenomRatioKeeper) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt()), DenomRatios: paramRatios})
+			},
+			exp: paramRatios,
+		},
+		"param ratios with empty oracle ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace, k DenomRatioKeeper) {
+				s.SetParamSet(ctx, &types.Params{BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.OneInt()), DenomRatios: paramRatios})
+				require.NoError(t, k.SetDenomRatios(ctx, oracleRatios))
+				require.NoError(t, k.SetDenomRatios(ctx, sdk.DecCoins{}))
+			},
+			exp: paramRatios,
+		},
+		"nothing set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace, k DenomRatioKeeper) {},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, subspace, _, k := setupTestStore(t)
+			spec.setupStore(ctx, subspace, k)
+
+			// when
+			got := k.GetDenomRatios(ctx)
+
+			// then
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+// setOracleDenomRatios stores the ratios without validation
+func (k DenomRatioKeeper) setOracleDenomRatios(ctx sdk.Context, ratios sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, r := range ratios {
+		bz, err := r.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetOracleDenomRatioKey(r.Denom), bz)
+	}
+}
+
+func getDenomRatiosParam(ctx sdk.Context, s paramtypes.Subspace) sdk.DecCoins {
+	var ratios sdk.DecCoins
+	s.GetIfExists(ctx, types.ParamStoreKeyDenomRatios, &ratios)
+	return ratios
+}
@@ -14,7 +14,7 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := simapp.MakeTestEncodingConfig()
 	gotJson := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJson), string(gotJson))
+	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[],"bypass_msg_types":[],"max_bypass_gas":"0","dynamic_base_price":{"enabled":false,"denom":"","min_base_price":"0.000000000000000000","max_base_price":"0.000000000000000000","target_block_gas":"0","max_change_rate":"0.125000000000000000","history_length":100},"base_denom_price":{"denom":"","amount":"0.000000000000000000"},"denom_ratios":[]},"oracle_denom_ratios":[]}`, string(gotJson), string(gotJson))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -32,6 +32,92 @@ func TestValidateGenesis(t *testing.T) {
 		"minimum not set": {
 			src: `{"params":{}}`,
 		},
+		"with bypass msg types": {
+			src: `{"params":{"bypass_msg_types":["/ibc.core.client.v1.MsgUpdateClient"],"max_bypass_gas":"1000000"}}`,
+		},
+		"bypass msg types without max gas": {
+			src:    `{"params":{"bypass_msg_types":["/ibc.core.client.v1.MsgUpdateClient"]}}`,
+			expErr: true,
+		},
+		"duplicate bypass msg types not allowed": {
+			src:    `{"params":{"bypass_msg_types":["/ibc.core.client.v1.MsgUpdateClient","/ibc.core.client.v1.MsgUpdateClient"],"max_bypass_gas":"1000000"}}`,
+			expErr: true,
+		},
+		"invalid bypass msg type": {
+			src:    `{"params":{"bypass_msg_types":["ibc.core.client.v1.MsgUpdateClient"],"max_bypass_gas":"1000000"}}`,
+			expErr: true,
+		},
+		"empty bypass msg type": {
+			src:    `{"params":{"bypass_msg_types":[""],"max_bypass_gas":"1000000"}}`,
+			expErr: true,
+		},
+		"with dynamic base price": {
+			src: `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0.1","max_base_price":"10","target_block_gas":"1000","max_change_rate":"0.125","history_length":10}}}`,
+		},
+		"disabled dynamic base price not validated": {
+			src: `{"params":{"dynamic_base_price":{"enabled":false,"max_change_rate":"2"}}}`,
+		},
+		"dynamic base price without denom": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"min_base_price":"0.1","max_base_price":"10","target_block_gas":"1000","max_change_rate":"0.125","history_length":10}}}`,
+			expErr: true,
+		},
+		"dynamic base price with zero min": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0","max_base_price":"10","target_block_gas":"1000","max_change_rate":"0.125","history_length":10}}}`,
+			expErr: true,
+		},
+		"dynamic base price with max below min": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"1","max_base_price":"0.5","target_block_gas":"1000","max_change_rate":"0.125","history_length":10}}}`,
+			expErr: true,
+		},
+		"dynamic base price without target block gas": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0.1","max_base_price":"10","max_change_rate":"0.125","history_length":10}}}`,
+			expErr: true,
+		},
+		"dynamic base price with change rate above 1": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0.1","max_base_price":"10","target_block_gas":"1000","max_change_rate":"1.1","history_length":10}}}`,
+			expErr: true,
+		},
+		"dynamic base price without history length": {
+			src:    `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0.1","max_base_price":"10","target_block_gas":"1000","max_change_rate":"0.125"}}}`,
+			expErr: true,
+		},
+		"with denom ratios": {
+			src: `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"},"denom_ratios":[{"denom":"BLX","amount":"0.5"}]}}`,
+		},
+		"base denom price without ratios": {
+			src: `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"}}}`,
+		},
+		"denom ratios without base denom price": {
+			src:    `{"params":{"denom_ratios":[{"denom":"BLX","amount":"0.5"}]}}`,
+			expErr: true,
+		},
+		"denom ratios with zero base denom price": {
+			src:    `{"params":{"base_denom_price":{"denom":"ALX","amount":"0"},"denom_ratios":[{"denom":"BLX","amount":"0.5"}]}}`,
+			expErr: true,
+		},
+		"base denom in denom ratios": {
+			src:    `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"},"denom_ratios":[{"denom":"ALX","amount":"0.5"}]}}`,
+			expErr: true,
+		},
+		"zero denom ratio not allowed": {
+			src:    `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"},"denom_ratios":[{"denom":"BLX","amount":"0"}]}}`,
+			expErr: true,
+		},
+		"oracle denom ratios": {
+			src: `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"}},"oracle_denom_ratios":[{"denom":"BLX","amount":"0.5"}]}`,
+		},
+		"oracle denom ratios without base denom price": {
+			src:    `{"params":{},"oracle_denom_ratios":[{"denom":"BLX","amount":"0.5"}]}`,
+			expErr: true,
+		},
+		"base denom in oracle denom ratios": {
+			src:    `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"}},"oracle_denom_ratios":[{"denom":"ALX","amount":"0.5"}]}`,
+			expErr: true,
+		},
+		"zero oracle denom ratio not allowed": {
+			src:    `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"}},"oracle_denom_ratios":[{"denom":"BLX","amount":"0"}]}`,
+			expErr: true,
+		},
 		"zero amount not allowed": {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"0"}]}}`,
 			expErr: true,
@@ -58,28 +144,91 @@ func TestValidateGenesis(t *testing.T) {
 }
 
 func TestInitExportGenesis(t *testing.T) {
+	notSet := types.DynamicBasePrice{MinBasePrice: sdk.ZeroDec(), MaxBasePrice: sdk.ZeroDec(), MaxChangeRate: sdk.ZeroDec()}
+	noBaseDenomPrice := sdk.DecCoin{Amount: sdk.ZeroDec()}
 	specs := map[string]struct {
 		src string
 		exp types.GenesisState
 	}{
 		"single fee": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}]}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))}},
+			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1))), BypassMsgTypes: []string{}, DynamicBasePrice: notSet, BaseDenomPrice: noBaseDenomPrice, DenomRatios: sdk.DecCoins{}}, OracleDenomRatios: sdk.DecCoins{}},
 		},
 		"multiple fee options": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}, {"denom":"BLX", "amount":"0.001"}]}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
-				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))}},
+			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
+				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3))), BypassMsgTypes: []string{}, DynamicBasePrice: notSet, BaseDenomPrice: noBaseDenomPrice, DenomRatios: sdk.DecCoins{}}, OracleDenomRatios: sdk.DecCoins{}},
+		},
+		"bypass msg types": {
+			src: `{"params":{"bypass_msg_types":["/ibc.core.client.v1.MsgUpdateClient"],"max_bypass_gas":"1000000"}}`,
+			exp: types.GenesisState{
+				Params: types.Params{
+					MinimumGasPrices: sdk.DecCoins{},
+					BypassMsgTypes:   []string{"/ibc.core.client.v1.MsgUpdateClient"},
+					MaxBypassGas:     1_000_000,
+					DynamicBasePrice: notSet,
+					BaseDenomPrice:   noBaseDenomPrice,
+					DenomRatios:      sdk.DecCoins{},
+				},
+				OracleDenomRatios: sdk.DecCoins{},
+			},
+		},
+		"dynamic base price": {
+			src: `{"params":{"dynamic_base_price":{"enabled":true,"denom":"ALX","min_base_price":"0.1","max_base_price":"10","target_block_gas":"1000","max_change_rate":"0.125","history_length":10}}}`,
+			exp: types.GenesisState{
+				Params: types.Params{
+					MinimumGasPrices: sdk.DecCoins{},
+					BypassMsgTypes:   []string{},
+					DynamicBasePrice: types.DynamicBasePrice{
+						Enabled:        true,
+						Denom:          "ALX",
+						MinBasePrice:   sdk.NewDecWithPrec(1, 1),
+						MaxBasePrice:   sdk.NewDec(10),
+						TargetBlockGas: 1000,
+						MaxChangeRate:  sdk.NewDecWithPrec(125, 3),
+						HistoryLength:  10,
+					},
+					BaseDenomPrice: noBaseDenomPrice,
+					DenomRatios:    sdk.DecCoins{},
+				},
+				OracleDenomRatios: sdk.DecCoins{},
+			},
+		},
+		"denom ratios": {
+			src: `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"},"denom_ratios":[{"denom":"BLX","amount":"0.5"}]}}`,
+			exp: types.GenesisState{
+				Params: types.Params{
+					MinimumGasPrices: sdk.DecCoins{},
+					BypassMsgTypes:   []string{},
+					DynamicBasePrice: notSet,
+					BaseDenomPrice:   sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+					DenomRatios:      sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1))),
+				},
+				OracleDenomRatios: sdk.DecCoins{},
+			},
+		},
+		"oracle denom ratios": {
+			src: `{"params":{"base_denom_price":{"denom":"ALX","amount":"2"}},"oracle_denom_ratios":[{"denom":"BLX","amount":"0.5"}]}`,
+			exp: types.GenesisState{
+				Params: types.Params{
+					MinimumGasPrices: sdk.DecCoins{},
+					BypassMsgTypes:   []string{},
+					DynamicBasePrice: notSet,
+					BaseDenomPrice:   sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+					DenomRatios:      sdk.DecCoins{},
+				},
+				OracleDenomRatios: sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1))),
+			},
 		},
 		"no fee set": {
 			src: `{"params":{}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.DecCoins{}}},
+			exp: types.GenesisState{Params: types.Params{MinimumGasPrices: sdk.DecCoins{}, BypassMsgTypes: []string{}, DynamicBasePrice: notSet, BaseDenomPrice: noBaseDenomPrice, DenomRatios: sdk.DecCoins{}}, OracleDenomRatios: sdk.DecCoins{}},
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, encCfg, subspace := setupTestStore(t)
-			m := NewAppModule(subspace)
+			ctx, encCfg, subspace, basePrices, denomRatios := setupTestStore(t)
+			m := NewAppModule(subspace, basePrices, denomRatios)
 			m.InitGenesis(ctx, encCfg.Marshaler, []byte(spec.src))
 			gotJSON := m.ExportGenesis(ctx, encCfg.Marshaler)
 			var got types.GenesisState
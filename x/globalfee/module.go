@@ -50,6 +50,12 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, config client
 	if err := data.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if err := data.OracleDenomRatios.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if err := types.ValidateOracleDenomRatios(data.Params, data.OracleDenomRatios); err != nil {
+		return sdkerrors.Wrap(err, "oracle denom ratios")
+	}
 	return nil
 }
 
@@ -78,28 +84,35 @@ func (a AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
 
 type AppModule struct {
 	AppModuleBasic
-	paramSpace paramstypes.Subspace
+	paramSpace  paramstypes.Subspace
+	basePrices  BasePriceKeeper
+	denomRatios DenomRatioKeeper
 }
 
 // NewAppModule constructor
-func NewAppModule(paramSpace paramstypes.Subspace) *AppModule {
+func NewAppModule(paramSpace paramstypes.Subspace, basePrices BasePriceKeeper, denomRatios DenomRatioKeeper) *AppModule {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &AppModule{paramSpace: paramSpace}
+	return &AppModule{paramSpace: paramSpace, basePrices: basePrices, denomRatios: denomRatios}
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 	a.paramSpace.SetParamSet(ctx, &genesisState.Params)
+	if err := a.denomRatios.SetDenomRatios(ctx, genesisState.OracleDenomRatios); err != nil {
+		panic(sdkerrors.Wrap(err, "oracle denom ratios"))
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
 	var genState types.GenesisState
-	a.paramSpace.GetParamSet(ctx, &genState.Params)
+	// params added later may not be set on chains that were started before
+	a.paramSpace.GetParamSetIfExists(ctx, &genState.Params)
+	genState.OracleDenomRatios = a.denomRatios.GetOracleDenomRatios(ctx)
 	return marshaler.MustMarshalJSON(&genState)
 }
 
@@ -119,13 +132,19 @@ func (a AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(a.paramSpace))
+	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(a.paramSpace, a.basePrices, a.denomRatios))
 }
 
 func (a AppModule) BeginBlock(context sdk.Context, block abci.RequestBeginBlock) {
 }
 
-func (a AppModule) EndBlock(context sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock updates the dynamic base price from the gas used in the block
+func (a AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+	var blockGas uint64
+	if ctx.BlockGasMeter() != nil {
+		blockGas = ctx.BlockGasMeter().GasConsumed()
+	}
+	a.basePrices.UpdateBasePrice(ctx, blockGas)
 	return nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/confio/tgrade/x/globalfee/types"
 )
@@ -12,10 +14,12 @@ var _ types.QueryServer = &Querier{}
 
 type Querier struct {
 	paramSource paramSource
+	basePrices  BasePriceKeeper
+	denomRatios DenomRatioSource
 }
 
-func NewQuerier(paramSource paramSource) Querier {
-	return Querier{paramSource: paramSource}
+func NewQuerier(paramSource paramSource, basePrices BasePriceKeeper, denomRatios DenomRatioSource) Querier {
+	return Querier{paramSource: paramSource, basePrices: basePrices, denomRatios: denomRatios}
 }
 
 // MinimumGasPrices return minimum gas prices
@@ -29,3 +33,94 @@ func (g Querier) MinimumGasPrices(stdCtx context.Context, _ *types.QueryMinimumG
 		MinimumGasPrices: minGasPrices,
 	}, nil
 }
+
+// BypassMsgTypes return the message types that are free of the minimum gas prices
+func (g Querier) BypassMsgTypes(stdCtx context.Context, _ *types.QueryBypassMsgTypesRequest) (*types.QueryBypassMsgTypesResponse, error) {
+	var rsp types.QueryBypassMsgTypesResponse
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyBypassMsgTypes) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyBypassMsgTypes, &rsp.BypassMsgTypes)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxBypassGas) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxBypassGas, &rsp.MaxBypassGas)
+	}
+	return &rsp, nil
+}
+
+// BasePrice return the current dynamic base price and its history
+func (g Querier) BasePrice(stdCtx context.Context, req *types.QueryBasePriceRequest) (*types.QueryBasePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	basePrice, enabled := g.basePrices.GetBasePrice(ctx)
+	history, pageRes, err := g.basePrices.BasePriceHistory(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryBasePriceResponse{
+		Enabled:    enabled,
+		BasePrice:  basePrice,
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EffectiveGasPrices return the gas prices per denom that are required by the ante handler
+func (g Querier) EffectiveGasPrices(stdCtx context.Context, _ *types.QueryEffectiveGasPricesRequest) (*types.QueryEffectiveGasPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryEffectiveGasPricesResponse{
+		EffectiveGasPrices: effectiveGasPrices(ctx, g.paramSource, g.basePrices, g.denomRatios),
+	}, nil
+}
+
+// EstimateFee return the minimum fees per denom for the gas limit that are accepted by the ante handler and the
+// minimum gas prices of this node
+func (g Querier) EstimateFee(stdCtx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Gas == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gas must not be zero")
+	}
+	denoms := make(map[string]struct{}, len(req.Denoms))
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		denoms[denom] = struct{}{}
+	}
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	gasPrices := combineGasPrices(effectiveGasPrices(ctx, g.paramSource, g.basePrices, g.denomRatios), ctx.MinGasPrices())
+	if len(denoms) != 0 {
+		filtered := make(sdk.DecCoins, 0, len(denoms))
+		for _, gp := range gasPrices {
+			if _, ok := denoms[gp.Denom]; ok {
+				filtered = append(filtered, gp)
+			}
+		}
+		gasPrices = filtered
+	}
+	return &types.QueryEstimateFeeResponse{
+		Fees:      sdk.NewCoins(requiredFees(gasPrices, req.Gas)...),
+		GasPrices: gasPrices,
+	}, nil
+}
+
+// combineGasPrices returns the gas prices that pass both, the global and the local minimum gas prices. When both are
+// set, only the denoms in both are accepted with the higher price.
+func combineGasPrices(global, local sdk.DecCoins) sdk.DecCoins {
+	switch {
+	case local.IsZero():
+		return global
+	case global.IsZero():
+		return sdk.NewDecCoins(local...)
+	}
+	result := make(sdk.DecCoins, 0, len(global))
+	for _, l := range local {
+		if g := global.AmountOf(l.Denom); g.IsPositive() {
+			result = append(result, sdk.NewDecCoinFromDec(l.Denom, sdk.MaxDec(g, l.Amount)))
+		}
+	}
+	return sdk.NewDecCoins(result...)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,9 +45,9 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, _, subspace := setupTestStore(t)
+			ctx, _, subspace, basePrices, denomRatios := setupTestStore(t)
 			spec.setupStore(ctx, subspace)
-			q := NewQuerier(subspace)
+			q := NewQuerier(subspace, basePrices, denomRatios)
 			gotResp, gotErr := q.MinimumGasPrices(sdk.WrapSDKContext(ctx), nil)
 			require.NoError(t, gotErr)
 			require.NotNil(t, gotResp)
@@ -54,3 +55,246 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBypassMsgTypes(t *testing.T) {
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		exp        types.QueryBypassMsgTypesResponse
+	}{
+		"bypass set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					BypassMsgTypes: []string{"/ibc.core.client.v1.MsgUpdateClient"},
+					MaxBypassGas:   1_000_000,
+				})
+			},
+			exp: types.QueryBypassMsgTypesResponse{
+				BypassMsgTypes: []string{"/ibc.core.client.v1.MsgUpdateClient"},
+				MaxBypassGas:   1_000_000,
+			},
+		},
+		"no bypass set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{})
+			},
+		},
+		"no param set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, subspace, basePrices, denomRatios := setupTestStore(t)
+			spec.setupStore(ctx, subspace)
+			q := NewQuerier(subspace, basePrices, denomRatios)
+			gotResp, gotErr := q.BypassMsgTypes(sdk.WrapSDKContext(ctx), nil)
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.exp.BypassMsgTypes, gotResp.BypassMsgTypes)
+			assert.Equal(t, spec.exp.MaxBypassGas, gotResp.MaxBypassGas)
+		})
+	}
+}
+
+func TestQueryEffectiveGasPrices(t *testing.T) {
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		exp        sdk.DecCoins
+	}{
+		"min gas prices only": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+				})
+			},
+			exp: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		},
+		"with denom ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					BaseDenomPrice:   sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+					DenomRatios:      sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1))),
+				})
+			},
+			exp: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+		},
+		"with dynamic base price and denom ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					DynamicBasePrice: dynamicBasePrice(sdk.NewDec(4)),
+					BaseDenomPrice:   sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+					DenomRatios:      sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1))),
+				})
+			},
+			exp: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(4)), sdk.NewDecCoin("BLX", sdk.NewInt(2))),
+		},
+		"no param set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, subspace, basePrices, denomRatios := setupTestStore(t)
+			spec.setupStore(ctx, subspace)
+			q := NewQuerier(subspace, basePrices, denomRatios)
+			gotResp, gotErr := q.EffectiveGasPrices(sdk.WrapSDKContext(ctx), nil)
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.exp, gotResp.EffectiveGasPrices)
+		})
+	}
+}
+
+func TestQueryEstimateFee(t *testing.T) {
+	specs := map[string]struct {
+		setupStore   func(ctx sdk.Context, s paramtypes.Subspace)
+		localMin     sdk.DecCoins
+		req          *types.QueryEstimateFeeRequest
+		expFees      sdk.Coins
+		expGasPrices sdk.DecCoins
+		expErr       bool
+	}{
+		"global min only": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(25, 2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+				})
+			},
+			req:          &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:      sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3)), sdk.NewCoin("BLX", sdk.NewInt(10))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(25, 2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+		},
+		"local min only": {
+			setupStore:   func(ctx sdk.Context, s paramtypes.Subspace) {},
+			localMin:     sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+			req:          &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:      sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(20))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+		},
+		"global and local min": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt()), sdk.NewDecCoin("BLX", sdk.NewInt(3)), sdk.NewDecCoin("CLX", sdk.OneInt())),
+				})
+			},
+			localMin:     sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.OneInt()), sdk.NewDecCoin("DLX", sdk.OneInt())),
+			req:          &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:      sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(20)), sdk.NewCoin("BLX", sdk.NewInt(30))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.NewInt(3))),
+		},
+		"zero local min": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+				})
+			},
+			localMin:     sdk.DecCoins{sdk.NewDecCoin("ALX", sdk.ZeroInt())},
+			req:          &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:      sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		},
+		"with denom ratios": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					BaseDenomPrice: sdk.NewDecCoin("ALX", sdk.NewInt(2)),
+					DenomRatios:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(5, 1))),
+				})
+			},
+			req:          &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:      sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(20)), sdk.NewCoin("BLX", sdk.NewInt(10))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoin("BLX", sdk.OneInt())),
+		},
+		"filtered by denoms": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt()), sdk.NewDecCoin("BLX", sdk.NewInt(2))),
+				})
+			},
+			req:          &types.QueryEstimateFeeRequest{Gas: 10, Denoms: []string{"BLX", "CLX", "BLX"}},
+			expFees:      sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(20))),
+			expGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("BLX", sdk.NewInt(2))),
+		},
+		"no min set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+			req:        &types.QueryEstimateFeeRequest{Gas: 10},
+			expFees:    sdk.NewCoins(),
+		},
+		"zero gas": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+			req:        &types.QueryEstimateFeeRequest{},
+			expErr:     true,
+		},
+		"invalid denom": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+			req:        &types.QueryEstimateFeeRequest{Gas: 10, Denoms: []string{"&&&"}},
+			expErr:     true,
+		},
+		"nil request": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, subspace, basePrices, denomRatios := setupTestStore(t)
+			spec.setupStore(ctx, subspace)
+			q := NewQuerier(subspace, basePrices, denomRatios)
+			gotResp, gotErr := q.EstimateFee(sdk.WrapSDKContext(ctx.WithMinGasPrices(spec.localMin)), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.expFees, gotResp.Fees)
+			assert.Equal(t, spec.expGasPrices, gotResp.GasPrices)
+		})
+	}
+}
+
+func TestQueryBasePrice(t *testing.T) {
+	ctx, _, subspace, basePrices, denomRatios := setupTestStore(t)
+	q := NewQuerier(subspace, basePrices, denomRatios)
+
+	// not enabled
+	gotResp, gotErr := q.BasePrice(sdk.WrapSDKContext(ctx), &types.QueryBasePriceRequest{})
+	require.NoError(t, gotErr)
+	assert.False(t, gotResp.Enabled)
+	assert.Empty(t, gotResp.History)
+
+	// with history
+	subspace.SetParamSet(ctx, &types.Params{DynamicBasePrice: types.DynamicBasePrice{
+		Enabled:        true,
+		Denom:          "ALX",
+		MinBasePrice:   sdk.OneDec(),
+		MaxBasePrice:   sdk.NewDec(10),
+		TargetBlockGas: 1000,
+		MaxChangeRate:  sdk.NewDecWithPrec(5, 1),
+		HistoryLength:  10,
+	}})
+	basePrices.UpdateBasePrice(ctx.WithBlockHeight(1), 2000)
+	basePrices.UpdateBasePrice(ctx.WithBlockHeight(2), 1000)
+
+	gotResp, gotErr = q.BasePrice(sdk.WrapSDKContext(ctx), &types.QueryBasePriceRequest{})
+	require.NoError(t, gotErr)
+	assert.True(t, gotResp.Enabled)
+	assert.Equal(t, sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(15, 1)), gotResp.BasePrice)
+	assert.Equal(t, []types.BasePriceRecord{
+		{Height: 1, BlockGas: 2000, BasePrice: sdk.NewDecWithPrec(15, 1)},
+		{Height: 2, BlockGas: 1000, BasePrice: sdk.NewDecWithPrec(15, 1)},
+	}, gotResp.History)
+
+	// paginated
+	gotResp, gotErr = q.BasePrice(sdk.WrapSDKContext(ctx), &types.QueryBasePriceRequest{Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(t, gotErr)
+	assert.Equal(t, []types.BasePriceRecord{{Height: 2, BlockGas: 1000, BasePrice: sdk.NewDecWithPrec(15, 1)}}, gotResp.History)
+	assert.NotEmpty(t, gotResp.Pagination.NextKey)
+
+	// nil request
+	_, gotErr = q.BasePrice(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, gotErr)
+}
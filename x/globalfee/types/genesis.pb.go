@@ -34,6 +34,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// OracleDenomRatios are the denom ratios set by the fee oracle contract.
+	// They replace the denom ratios of the params when not empty.
+	OracleDenomRatios github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=oracle_denom_ratios,json=oracleDenomRatios,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"oracle_denom_ratios,omitempty" yaml:"oracle_denom_ratios"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,6 +84,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetOracleDenomRatios() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OracleDenomRatios
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// Minimum stores the minimum gas price(s) for all TX on the chain.
@@ -89,6 +99,25 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/master/modules/auth/01_concepts.html
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// BypassMsgTypes defines a list of message type urls that are free of the
+	// minimum gas prices when a tx contains only messages of these types.
+	// For example "/ibc.core.client.v1.MsgUpdateClient"
+	BypassMsgTypes []string `protobuf:"bytes,2,rep,name=bypass_msg_types,json=bypassMsgTypes,proto3" json:"bypass_msg_types,omitempty" yaml:"bypass_msg_types"`
+	// MaxBypassGas is the maximum gas limit of a tx with bypass messages only.
+	// Txs with a higher gas limit have to pay the minimum gas prices.
+	MaxBypassGas uint64 `protobuf:"varint,3,opt,name=max_bypass_gas,json=maxBypassGas,proto3" json:"max_bypass_gas,omitempty" yaml:"max_bypass_gas"`
+	// DynamicBasePrice is an optional base gas price that follows the block gas
+	// usage. When enabled, the max of the minimum gas price and the base price is
+	// required for the base price denom.
+	DynamicBasePrice DynamicBasePrice `protobuf:"bytes,4,opt,name=dynamic_base_price,json=dynamicBasePrice,proto3" json:"dynamic_base_price" yaml:"dynamic_base_price"`
+	// BaseDenomPrice is the gas price in the base denom that the denom ratios
+	// refer to. Not used without denom ratios.
+	BaseDenomPrice types.DecCoin `protobuf:"bytes,5,opt,name=base_denom_price,json=baseDenomPrice,proto3" json:"base_denom_price" yaml:"base_denom_price"`
+	// DenomRatios are the amounts of other denoms that are accepted for one unit
+	// of the base denom. The gas price of a denom is the base denom price
+	// multiplied by its ratio. IBC denoms are supported in the "ibc/<hash>"
+	// form. The ratios can be updated by the fee oracle contract.
+	DenomRatios github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=denom_ratios,json=denomRatios,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"denom_ratios,omitempty" yaml:"denom_ratios"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,9 +165,198 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetBypassMsgTypes() []string {
+	if m != nil {
+		return m.BypassMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBypassGas() uint64 {
+	if m != nil {
+		return m.MaxBypassGas
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicBasePrice() DynamicBasePrice {
+	if m != nil {
+		return m.DynamicBasePrice
+	}
+	return DynamicBasePrice{}
+}
+
+func (m *Params) GetBaseDenomPrice() types.DecCoin {
+	if m != nil {
+		return m.BaseDenomPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *Params) GetDenomRatios() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DenomRatios
+	}
+	return nil
+}
+
+// DynamicBasePrice defines an EIP-1559 style base gas price. At the end of each
+// block the price is raised or lowered by the relative difference of the block
+// gas used to the target block gas, limited by the max change rate and the
+// bounds.
+type DynamicBasePrice struct {
+	// Enabled turns the dynamic base price on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Denom of the base price
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// MinBasePrice is the lower bound and start value of the base price
+	MinBasePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_base_price,json=minBasePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_price" yaml:"min_base_price"`
+	// MaxBasePrice is the upper bound of the base price
+	MaxBasePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_base_price,json=maxBasePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_price" yaml:"max_base_price"`
+	// TargetBlockGas is the block gas used at which the base price is not
+	// changed
+	TargetBlockGas uint64 `protobuf:"varint,5,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// MaxChangeRate is the max relative change of the base price per block. For
+	// example 0.125 for 12.5%
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+	// HistoryLength is the number of blocks that the base price history is kept
+	// for
+	HistoryLength uint32 `protobuf:"varint,7,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty" yaml:"history_length"`
+}
+
+func (m *DynamicBasePrice) Reset()         { *m = DynamicBasePrice{} }
+func (m *DynamicBasePrice) String() string { return proto.CompactTextString(m) }
+func (*DynamicBasePrice) ProtoMessage()    {}
+func (*DynamicBasePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{2}
+}
+
+func (m *DynamicBasePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DynamicBasePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicBasePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DynamicBasePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicBasePrice.Merge(m, src)
+}
+
+func (m *DynamicBasePrice) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DynamicBasePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicBasePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicBasePrice proto.InternalMessageInfo
+
+func (m *DynamicBasePrice) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicBasePrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DynamicBasePrice) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *DynamicBasePrice) GetHistoryLength() uint32 {
+	if m != nil {
+		return m.HistoryLength
+	}
+	return 0
+}
+
+// BasePriceRecord is the base price set at the end of a block
+type BasePriceRecord struct {
+	// Height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BlockGas is the gas used in the block
+	BlockGas uint64 `protobuf:"varint,2,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// BasePrice is the new base price
+	BasePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_price"`
+}
+
+func (m *BasePriceRecord) Reset()         { *m = BasePriceRecord{} }
+func (m *BasePriceRecord) String() string { return proto.CompactTextString(m) }
+func (*BasePriceRecord) ProtoMessage()    {}
+func (*BasePriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{3}
+}
+
+func (m *BasePriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BasePriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasePriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BasePriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasePriceRecord.Merge(m, src)
+}
+
+func (m *BasePriceRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BasePriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasePriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasePriceRecord proto.InternalMessageInfo
+
+func (m *BasePriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BasePriceRecord) GetBlockGas() uint64 {
+	if m != nil {
+		return m.BlockGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "confio.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "confio.globalfee.v1beta1.Params")
+	proto.RegisterType((*DynamicBasePrice)(nil), "confio.globalfee.v1beta1.DynamicBasePrice")
+	proto.RegisterType((*BasePriceRecord)(nil), "confio.globalfee.v1beta1.BasePriceRecord")
 }
 
 func init() {
@@ -146,29 +364,59 @@ func init() {
 }
 
 var fileDescriptor_9e1fd18b564cbff8 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0x87, 0xdb, 0x98, 0x30, 0x14, 0x07, 0xd2, 0x38, 0x20, 0x21, 0x57, 0xd2, 0xc1, 0x10, 0xff,
-	0xdc, 0x05, 0xdc, 0x1c, 0x91, 0x84, 0x95, 0xe0, 0xe6, 0x42, 0xae, 0xe5, 0x38, 0x2f, 0x72, 0x7d,
-	0x9b, 0xde, 0x81, 0xf2, 0x2d, 0xfc, 0x1c, 0x7e, 0x06, 0x77, 0x19, 0x19, 0x9d, 0xd0, 0xc0, 0xe6,
-	0xe8, 0x27, 0x30, 0xdc, 0x55, 0x40, 0x89, 0x4b, 0xdb, 0xf4, 0x9e, 0xf7, 0xf7, 0xdc, 0xfb, 0xbe,
-	0xde, 0x49, 0x0c, 0xc9, 0x50, 0x00, 0xe1, 0x23, 0x88, 0xe8, 0x68, 0xc8, 0x18, 0x99, 0x34, 0x22,
-	0xa6, 0x69, 0x83, 0x70, 0x96, 0x30, 0x25, 0x14, 0x4e, 0x33, 0xd0, 0xe0, 0x97, 0x2d, 0x87, 0x37,
-	0x1c, 0xce, 0xb9, 0xca, 0x11, 0x07, 0x0e, 0x06, 0x22, 0xeb, 0x2f, 0xcb, 0x57, 0x50, 0x0c, 0x4a,
-	0x82, 0x22, 0x11, 0x55, 0xdb, 0xc8, 0x18, 0x44, 0xb2, 0x7b, 0xfe, 0x40, 0x95, 0x24, 0xe6, 0x31,
-	0xf9, 0xe3, 0x0b, 0x23, 0xef, 0xb0, 0x63, 0x7f, 0xdc, 0x68, 0xaa, 0x99, 0xdf, 0xf3, 0x0a, 0x29,
-	0xcd, 0xa8, 0x54, 0x65, 0xb7, 0xe6, 0xd6, 0x8b, 0xcd, 0x1a, 0xfe, 0xef, 0x42, 0xb8, 0x6b, 0xb8,
-	0x56, 0x79, 0xb6, 0x08, 0x9c, 0xcf, 0x45, 0x50, 0xb2, 0x75, 0xe7, 0x20, 0x85, 0x66, 0x32, 0xd5,
-	0xd3, 0x5e, 0x9e, 0x14, 0xbe, 0xba, 0x5e, 0xc1, 0xc2, 0xfe, 0x8b, 0xeb, 0xf9, 0x52, 0x24, 0x42,
-	0x8e, 0x65, 0x9f, 0x53, 0xd5, 0x4f, 0x33, 0x11, 0xb3, 0xb5, 0xeb, 0xa0, 0x5e, 0x6c, 0x56, 0xb1,
-	0x6d, 0x06, 0xaf, 0x9b, 0xd9, 0x68, 0xda, 0x2c, 0xbe, 0x06, 0x91, 0xb4, 0xd2, 0xdc, 0x53, 0xdd,
-	0xaf, 0xdf, 0x3a, 0xbf, 0x16, 0xc1, 0xf1, 0x94, 0xca, 0xd1, 0x55, 0xb8, 0x4f, 0x85, 0xcf, 0xef,
-	0xc1, 0x19, 0x17, 0xfa, 0x6e, 0x1c, 0xe1, 0x18, 0x24, 0xc9, 0x27, 0x67, 0x5f, 0x17, 0x6a, 0x70,
-	0x4f, 0xf4, 0x34, 0x65, 0xea, 0x47, 0xa8, 0x7a, 0xa5, 0x3c, 0xa3, 0x43, 0x55, 0xd7, 0x24, 0xb4,
-	0xda, 0xb3, 0x25, 0x72, 0xe7, 0x4b, 0xe4, 0x7e, 0x2c, 0x91, 0xfb, 0xb4, 0x42, 0xce, 0x7c, 0x85,
-	0x9c, 0xb7, 0x15, 0x72, 0x6e, 0x4f, 0x7f, 0x05, 0x9b, 0x55, 0x6b, 0x9e, 0xd1, 0x01, 0x23, 0x8f,
-	0x3b, 0x3b, 0x37, 0x82, 0xa8, 0x60, 0x46, 0x7f, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x3e,
-	0x9f, 0x79, 0x14, 0x02, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xb1, 0x4f, 0xfb, 0x46,
+	0x14, 0x8e, 0x21, 0x04, 0x72, 0x40, 0x48, 0x0d, 0xa5, 0x26, 0xd0, 0x24, 0xf2, 0x80, 0x22, 0xda,
+	0xda, 0x02, 0x54, 0x55, 0xed, 0x54, 0x99, 0x54, 0xe9, 0x50, 0x24, 0x74, 0xed, 0xd4, 0x0e, 0xd6,
+	0xd9, 0x3e, 0x1c, 0x8b, 0x9c, 0xcf, 0xf2, 0x19, 0x9a, 0xa8, 0xff, 0x44, 0x3b, 0x74, 0xea, 0xcc,
+	0xd2, 0xbf, 0xa1, 0x43, 0xd5, 0x89, 0x91, 0xb1, 0xea, 0x90, 0x56, 0xb0, 0x31, 0xf6, 0x2f, 0xf8,
+	0xe9, 0xee, 0x9c, 0x38, 0x76, 0x40, 0x80, 0x58, 0x20, 0xf7, 0xee, 0xbb, 0xef, 0xbd, 0xef, 0xdd,
+	0xf7, 0x7c, 0x60, 0xdf, 0xa5, 0xe1, 0x79, 0x40, 0x4d, 0x7f, 0x40, 0x1d, 0x34, 0x38, 0xc7, 0xd8,
+	0xbc, 0x3a, 0x74, 0x70, 0x82, 0x0e, 0x4d, 0x1f, 0x87, 0x98, 0x05, 0xcc, 0x88, 0x62, 0x9a, 0x50,
+	0x55, 0x93, 0x38, 0x63, 0x8a, 0x33, 0x52, 0x5c, 0x63, 0xcb, 0xa7, 0x3e, 0x15, 0x20, 0x93, 0xff,
+	0x92, 0xf8, 0x46, 0xd3, 0xa5, 0x8c, 0x50, 0x66, 0x3a, 0x88, 0x65, 0x94, 0x2e, 0x0d, 0xc2, 0xd9,
+	0xfd, 0x1f, 0x11, 0x23, 0xa6, 0xf8, 0x73, 0x55, 0xc8, 0xa7, 0x5f, 0x2f, 0x80, 0xb5, 0x9e, 0x8c,
+	0x7c, 0x9b, 0xa0, 0x04, 0xab, 0x10, 0x54, 0x22, 0x14, 0x23, 0xc2, 0x34, 0xa5, 0xad, 0x74, 0x56,
+	0x8f, 0xda, 0xc6, 0x53, 0x15, 0x19, 0x67, 0x02, 0x67, 0x69, 0x37, 0xe3, 0x56, 0xe9, 0x61, 0xdc,
+	0xaa, 0xcb, 0x73, 0x1f, 0x53, 0x12, 0x24, 0x98, 0x44, 0xc9, 0x08, 0xa6, 0x4c, 0xea, 0x9f, 0x0a,
+	0xd8, 0xa4, 0x31, 0x72, 0x07, 0xd8, 0xf6, 0x70, 0x48, 0x89, 0x1d, 0xa3, 0x24, 0xa0, 0x4c, 0x5b,
+	0x68, 0x2f, 0x76, 0x56, 0x8f, 0xf6, 0x0c, 0xa9, 0xc1, 0xe0, 0x1a, 0xa6, 0xe4, 0x5d, 0xec, 0x9e,
+	0xd0, 0x20, 0xb4, 0xe2, 0x94, 0xfd, 0xc3, 0x47, 0x08, 0xb2, 0x54, 0xff, 0x8f, 0x5b, 0x8d, 0x11,
+	0x22, 0x83, 0x2f, 0xf4, 0x47, 0x60, 0xfa, 0xef, 0xff, 0xb6, 0x3e, 0xf2, 0x83, 0xa4, 0x7f, 0xe9,
+	0x18, 0x2e, 0x25, 0x66, 0xda, 0x32, 0xf9, 0xef, 0x13, 0xe6, 0x5d, 0x98, 0xc9, 0x28, 0xc2, 0x6c,
+	0x92, 0x92, 0xc1, 0xf7, 0x24, 0x49, 0x97, 0x73, 0x40, 0x49, 0xf1, 0x57, 0x05, 0x54, 0xa4, 0x5e,
+	0xf5, 0x0f, 0x05, 0xa8, 0x24, 0x08, 0x03, 0x72, 0x49, 0x6c, 0x1f, 0x31, 0x3b, 0x8a, 0x03, 0x17,
+	0xf3, 0x76, 0x3d, 0x2f, 0x26, 0x4a, 0xc5, 0xec, 0xcd, 0x9f, 0xcf, 0x69, 0xd9, 0x91, 0x5a, 0xe6,
+	0x51, 0xaf, 0x96, 0x52, 0x4f, 0x39, 0x7a, 0x88, 0x9d, 0x09, 0x06, 0xd5, 0x05, 0x75, 0x67, 0x14,
+	0x21, 0xc6, 0x6c, 0xc2, 0x7c, 0x5b, 0xc0, 0xc5, 0x45, 0x54, 0xad, 0xcf, 0x1f, 0xc6, 0xad, 0x46,
+	0x71, 0x2f, 0x57, 0xd7, 0x07, 0xb2, 0xae, 0x22, 0x46, 0x87, 0x35, 0x19, 0x3a, 0x65, 0xfe, 0x77,
+	0x3c, 0xa0, 0xfe, 0x00, 0x6a, 0x04, 0x0d, 0xed, 0x14, 0xe8, 0x23, 0xa6, 0x2d, 0xb6, 0x95, 0x4e,
+	0xd9, 0xfa, 0xf4, 0x61, 0xdc, 0xd2, 0xf2, 0x3b, 0xb9, 0x04, 0xef, 0xa7, 0xc2, 0x73, 0x08, 0x1d,
+	0xae, 0x11, 0x34, 0xb4, 0xc4, 0xba, 0x87, 0x98, 0xfa, 0x8b, 0x02, 0x54, 0x6f, 0x14, 0x22, 0x12,
+	0xb8, 0x36, 0x6f, 0xb3, 0xec, 0x8d, 0x56, 0x16, 0x7e, 0x3d, 0x78, 0xda, 0xaf, 0x5d, 0x79, 0xc6,
+	0x42, 0x0c, 0x8b, 0x5e, 0x58, 0x9f, 0xa5, 0xd7, 0xf1, 0x08, 0x5b, 0x76, 0x09, 0xf3, 0x7b, 0x3a,
+	0xac, 0x7b, 0x05, 0x2a, 0xf5, 0x27, 0x50, 0x17, 0x00, 0xe9, 0x3b, 0x59, 0xd0, 0x52, 0x5b, 0x79,
+	0xd6, 0x11, 0xc7, 0x93, 0xe1, 0x29, 0x9e, 0x9e, 0xe9, 0x76, 0x61, 0x87, 0x77, 0x1b, 0x31, 0xe9,
+	0x4e, 0x99, 0xfc, 0x5a, 0x01, 0x6b, 0xb9, 0xc1, 0xaa, 0xbc, 0xc0, 0x8b, 0x5e, 0x9a, 0x79, 0xfb,
+	0xc9, 0x89, 0xda, 0x4c, 0x1b, 0xf0, 0x96, 0x51, 0x5a, 0xf5, 0x66, 0x86, 0xe8, 0xb7, 0x32, 0xa8,
+	0x17, 0x2f, 0x41, 0xd5, 0xc0, 0x32, 0x0e, 0x91, 0x33, 0xc0, 0x9e, 0xf8, 0xe2, 0xac, 0xc0, 0xc9,
+	0x52, 0xdd, 0x02, 0x4b, 0xe2, 0xb4, 0xb6, 0xd0, 0x56, 0x3a, 0x55, 0x28, 0x17, 0x2a, 0x01, 0x35,
+	0x12, 0x84, 0xb3, 0x17, 0xcf, 0xad, 0x55, 0xb5, 0x7a, 0x5c, 0xcf, 0x3f, 0xe3, 0xd6, 0xfe, 0xcb,
+	0xca, 0x9b, 0x31, 0x5b, 0x8e, 0x8d, 0x9b, 0x2d, 0x08, 0xb3, 0xf2, 0x48, 0xea, 0xe4, 0xbc, 0xcf,
+	0xde, 0x92, 0x0e, 0x0d, 0x0b, 0xe9, 0xd0, 0x30, 0x4b, 0xf7, 0x15, 0xa8, 0x27, 0x28, 0xf6, 0x71,
+	0x62, 0x3b, 0x03, 0xea, 0x5e, 0x88, 0xd1, 0x59, 0x12, 0xa3, 0xb3, 0x9b, 0x39, 0xa2, 0x88, 0xd0,
+	0x61, 0x4d, 0x86, 0x2c, 0x1e, 0xe1, 0x23, 0x12, 0x81, 0x0d, 0x9e, 0xc7, 0xed, 0xa3, 0xd0, 0xc7,
+	0xfc, 0xee, 0xb0, 0x56, 0x11, 0x65, 0x7f, 0xfd, 0xea, 0xb2, 0xb7, 0xb3, 0xb2, 0x67, 0xe8, 0x74,
+	0xb8, 0x4e, 0xd0, 0xf0, 0x44, 0x04, 0x20, 0x7f, 0x37, 0xbe, 0x04, 0xb5, 0x7e, 0xc0, 0x12, 0x1a,
+	0x8f, 0xec, 0x01, 0x0e, 0xfd, 0xa4, 0xaf, 0x2d, 0xb7, 0x95, 0xce, 0xba, 0xb5, 0x93, 0x29, 0xcf,
+	0xef, 0xeb, 0x70, 0x3d, 0x0d, 0x7c, 0x23, 0xd7, 0xbf, 0x2a, 0x60, 0x63, 0xda, 0x08, 0x88, 0x5d,
+	0x1a, 0x7b, 0xea, 0x36, 0xa8, 0xf4, 0x71, 0xe0, 0xf7, 0x13, 0xe1, 0x8d, 0x45, 0x98, 0xae, 0xd4,
+	0x5d, 0x50, 0xcd, 0xfa, 0xc3, 0xed, 0x51, 0x86, 0x2b, 0xce, 0x44, 0xfc, 0x29, 0x00, 0x73, 0xee,
+	0x30, 0x5e, 0xa7, 0x1b, 0x56, 0x9d, 0xe9, 0x57, 0xa2, 0x7b, 0x73, 0xd7, 0x54, 0x6e, 0xef, 0x9a,
+	0xca, 0x7f, 0x77, 0x4d, 0xe5, 0xe7, 0xfb, 0x66, 0xe9, 0xf6, 0xbe, 0x59, 0xfa, 0xfb, 0xbe, 0x59,
+	0xfa, 0xfe, 0x20, 0x47, 0x26, 0xde, 0xf7, 0xc4, 0x8f, 0x91, 0x87, 0xcd, 0xe1, 0xcc, 0x43, 0x2f,
+	0x48, 0x9d, 0x8a, 0x78, 0x6f, 0x8f, 0xdf, 0x0d, 0x00, 0x1a, 0x37, 0x20, 0x66, 0x09, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +439,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleDenomRatios) > 0 {
+		for iNdEx := len(m.OracleDenomRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleDenomRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -224,6 +486,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomRatios) > 0 {
+		for iNdEx := len(m.DenomRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.BaseDenomPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DynamicBasePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxBypassGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBypassGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BypassMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BypassMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -241,6 +551,129 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicBasePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicBasePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicBasePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxBasePrice.Size()
+		i -= size
+		if _, err := m.MaxBasePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBasePrice.Size()
+		i -= size
+		if _, err := m.MinBasePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BasePriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasePriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasePriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BasePrice.Size()
+		i -= size
+		if _, err := m.BasePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -261,6 +694,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OracleDenomRatios) > 0 {
+		for _, e := range m.OracleDenomRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -276,22 +715,86 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BypassMsgTypes) > 0 {
+		for _, s := range m.BypassMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxBypassGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBypassGas))
+	}
+	l = m.DynamicBasePrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseDenomPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomRatios) > 0 {
+		for _, e := range m.DenomRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DynamicBasePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinBasePrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBasePrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.HistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryLength))
+	}
+	return n
 }
 
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *BasePriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.BlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGas))
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -349,6 +852,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenomRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenomRatios = append(m.OracleDenomRatios, types.DecCoin{})
+			if err := m.OracleDenomRatios[len(m.OracleDenomRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -434,6 +971,523 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMsgTypes = append(m.BypassMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBypassGas", wireType)
+			}
+			m.MaxBypassGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBypassGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBasePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseDenomPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRatios = append(m.DenomRatios, types.DecCoin{})
+			if err := m.DenomRatios[len(m.DenomRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DynamicBasePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicBasePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicBasePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBasePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBasePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLength", wireType)
+			}
+			m.HistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BasePriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasePriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasePriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGas", wireType)
+			}
+			m.BlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
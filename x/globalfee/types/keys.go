@@ -1,8 +1,32 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
 
+	// StoreKey is the store key string for globalfee
+	StoreKey = ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+var (
+	// BasePriceKey is the key of the current dynamic base price
+	BasePriceKey = []byte{0x01}
+	// BasePriceHistoryKeyPrefix is the prefix of the base price records by height
+	BasePriceHistoryKeyPrefix = []byte{0x02}
+	// OracleDenomRatioKeyPrefix is the prefix of the denom ratios set by the fee oracle contract
+	OracleDenomRatioKeyPrefix = []byte{0x03}
+)
+
+// GetBasePriceHistoryKey returns the key of the base price record for the given height
+func GetBasePriceHistoryKey(height int64) []byte {
+	return append(BasePriceHistoryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetOracleDenomRatioKey returns the key of the fee oracle ratio for the given denom
+func GetOracleDenomRatioKey(denom string) []byte {
+	return append(OracleDenomRatioKeyPrefix, []byte(denom)...)
+}
@@ -1,18 +1,48 @@
 package types
 
 import (
+	"strings"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// ParamStoreKeyMinGasPrices store key
-var ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+var (
+	// ParamStoreKeyMinGasPrices store key
+	ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+	// ParamStoreKeyBypassMsgTypes store key
+	ParamStoreKeyBypassMsgTypes = []byte("BypassMsgTypes")
+	// ParamStoreKeyMaxBypassGas store key
+	ParamStoreKeyMaxBypassGas = []byte("MaxBypassGas")
+	// ParamStoreKeyDynamicBasePrice store key
+	ParamStoreKeyDynamicBasePrice = []byte("DynamicBasePrice")
+	// ParamStoreKeyBaseDenomPrice store key
+	ParamStoreKeyBaseDenomPrice = []byte("BaseDenomPrice")
+	// ParamStoreKeyDenomRatios store key
+	ParamStoreKeyDenomRatios = []byte("DenomRatios")
+)
 
 // DefaultParams returns default wasm parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins{}}
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+		BypassMsgTypes:   []string{},
+		DynamicBasePrice: DefaultDynamicBasePrice(),
+		BaseDenomPrice:   sdk.DecCoin{Amount: sdk.ZeroDec()},
+		DenomRatios:      sdk.DecCoins{},
+	}
+}
+
+// DefaultDynamicBasePrice returns a disabled dynamic base price with EIP-1559 change rate
+func DefaultDynamicBasePrice() DynamicBasePrice {
+	return DynamicBasePrice{
+		MinBasePrice:  sdk.ZeroDec(),
+		MaxBasePrice:  sdk.ZeroDec(),
+		MaxChangeRate: sdk.NewDecWithPrec(125, 3),
+		HistoryLength: 100,
+	}
 }
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -21,7 +51,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ValidateBasic performs basic validation.
 func (p Params) ValidateBasic() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+	if err := validateBypassMsgTypes(p.BypassMsgTypes); err != nil {
+		return sdkerrors.Wrap(err, "bypass msg types")
+	}
+	if err := validateMaxBypassGas(p.MaxBypassGas); err != nil {
+		return sdkerrors.Wrap(err, "max bypass gas")
+	}
+	if len(p.BypassMsgTypes) != 0 && p.MaxBypassGas == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max bypass gas must be set with bypass msg types")
+	}
+	if err := validateDynamicBasePrice(p.DynamicBasePrice); err != nil {
+		return sdkerrors.Wrap(err, "dynamic base price")
+	}
+	if err := validateBaseDenomPrice(p.BaseDenomPrice); err != nil {
+		return sdkerrors.Wrap(err, "base denom price")
+	}
+	if err := validateDenomRatios(p.DenomRatios); err != nil {
+		return sdkerrors.Wrap(err, "denom ratios")
+	}
+	return ValidateDenomRatiosWithBase(p.BaseDenomPrice, p.DenomRatios)
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -30,6 +81,21 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(
 			ParamStoreKeyMinGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices,
 		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBypassMsgTypes, &p.BypassMsgTypes, validateBypassMsgTypes,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxBypassGas, &p.MaxBypassGas, validateMaxBypassGas,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicBasePrice, &p.DynamicBasePrice, validateDynamicBasePrice,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBaseDenomPrice, &p.BaseDenomPrice, validateBaseDenomPrice,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDenomRatios, &p.DenomRatios, validateDenomRatios,
+		),
 	}
 }
 
@@ -40,3 +106,122 @@ func validateMinimumGasPrices(i interface{}) error {
 	}
 	return v.Validate()
 }
+
+func validateBypassMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(v))
+	for _, t := range v {
+		if !strings.HasPrefix(t, "/") || strings.TrimSpace(t) != t || len(t) == 1 {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "msg type url: %q", t)
+		}
+		if _, exists := unique[t]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "msg type url: %s", t)
+		}
+		unique[t] = struct{}{}
+	}
+	return nil
+}
+
+func validateMaxBypassGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateDynamicBasePrice(i interface{}) error {
+	v, ok := i.(DynamicBasePrice)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+// ValidateBasic performs basic validation. A disabled base price is not validated further.
+func (p DynamicBasePrice) ValidateBasic() error {
+	if !p.Enabled {
+		return nil
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "denom")
+	}
+	switch {
+	case p.MinBasePrice.IsNil() || !p.MinBasePrice.IsPositive():
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "min base price must be positive")
+	case p.MaxBasePrice.IsNil() || p.MaxBasePrice.LT(p.MinBasePrice):
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max base price must not be lower than min base price")
+	case p.TargetBlockGas == 0:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "target block gas")
+	case p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(sdk.OneDec()):
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max change rate must be in range (0,1]")
+	case p.HistoryLength == 0:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "history length")
+	}
+	return nil
+}
+
+// NextBasePrice returns the base price for the next block. The current price is changed by the relative
+// difference of the block gas used to the target block gas, at most by the max change rate, and kept within
+// the bounds.
+func (p DynamicBasePrice) NextBasePrice(current sdk.Dec, blockGas uint64) sdk.Dec {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGas)).Sub(target).Quo(target)
+	if delta.GT(sdk.OneDec()) {
+		delta = sdk.OneDec()
+	}
+	next := current.Add(current.Mul(p.MaxChangeRate).Mul(delta))
+	switch {
+	case next.LT(p.MinBasePrice):
+		return p.MinBasePrice
+	case next.GT(p.MaxBasePrice):
+		return p.MaxBasePrice
+	}
+	return next
+}
+
+func validateBaseDenomPrice(i interface{}) error {
+	v, ok := i.(sdk.DecCoin)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	switch {
+	case v.Denom == "" && (v.Amount.IsNil() || v.Amount.IsZero()):
+		return nil // not set
+	case v.Amount.IsNil():
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "amount")
+	}
+	return v.Validate()
+}
+
+func validateDenomRatios(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return v.Validate()
+}
+
+// ValidateDenomRatiosWithBase checks that a positive base denom price is set for the denom ratios and that the
+// base denom has no ratio
+func ValidateDenomRatiosWithBase(baseDenomPrice sdk.DecCoin, ratios sdk.DecCoins) error {
+	if ratios.Empty() {
+		return nil
+	}
+	if baseDenomPrice.Denom == "" || !baseDenomPrice.IsPositive() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "base denom price must be set with denom ratios")
+	}
+	if !ratios.AmountOf(baseDenomPrice.Denom).IsZero() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "base denom must not have a ratio")
+	}
+	return nil
+}
+
+// ValidateOracleDenomRatios checks the fee oracle denom ratios with the params rules as a replacement of the
+// denom ratios param
+func ValidateOracleDenomRatios(params Params, ratios sdk.DecCoins) error {
+	params.DenomRatios = ratios
+	return params.ValidateBasic()
+}
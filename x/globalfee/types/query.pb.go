@@ -12,6 +12,7 @@ import (
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -128,228 +129,1995 @@ func (m *QueryMinimumGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesRequest")
-	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+// QueryBypassMsgTypesRequest is the request type for the
+// Query/BypassMsgTypes RPC method.
+type QueryBypassMsgTypesRequest struct{}
+
+func (m *QueryBypassMsgTypesRequest) Reset()         { *m = QueryBypassMsgTypesRequest{} }
+func (m *QueryBypassMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMsgTypesRequest) ProtoMessage()    {}
+func (*QueryBypassMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{2}
 }
 
-func init() {
-	proto.RegisterFile("confio/globalfee/v1beta1/query.proto", fileDescriptor_1265df7e439588bb)
+func (m *QueryBypassMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-var fileDescriptor_1265df7e439588bb = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0x8a, 0x1e, 0xe2, 0xa5, 0x04, 0x0f, 0xb5, 0xc4, 0x89, 0x04, 0x0f, 0xa2, 0x75,
-	0x86, 0x56, 0x51, 0xf0, 0x58, 0x0b, 0x9e, 0x04, 0xed, 0xd1, 0x4b, 0x99, 0xa4, 0xd3, 0x71, 0x30,
-	0x33, 0x6f, 0x9a, 0x99, 0x88, 0xb9, 0xfa, 0x09, 0x04, 0xbf, 0x85, 0xdf, 0x40, 0xf0, 0xe6, 0xa5,
-	0xc7, 0x82, 0x17, 0x4f, 0xd9, 0xa5, 0xdd, 0xd3, 0x1e, 0xf7, 0x13, 0x2c, 0x4d, 0xd2, 0xfd, 0xd3,
-	0x6e, 0x17, 0xf6, 0x94, 0xc0, 0xf3, 0x7b, 0xdf, 0x87, 0xe7, 0x99, 0xd7, 0x7d, 0x1c, 0x83, 0x9e,
-	0x4a, 0xa0, 0x22, 0x81, 0x88, 0x25, 0x53, 0xce, 0xe9, 0xd7, 0x5e, 0xc4, 0x2d, 0xeb, 0xd1, 0x59,
-	0xce, 0xb3, 0x82, 0xa4, 0x19, 0x58, 0xf0, 0xda, 0x35, 0x45, 0xce, 0x28, 0xd2, 0x50, 0x9d, 0xfb,
-	0x02, 0x04, 0x54, 0x10, 0x5d, 0xff, 0xd5, 0x7c, 0xc7, 0x17, 0x00, 0x22, 0xe1, 0x94, 0xa5, 0x92,
-	0x32, 0xad, 0xc1, 0x32, 0x2b, 0x41, 0x9b, 0x46, 0xc5, 0x31, 0x18, 0x05, 0x86, 0x46, 0xcc, 0x9c,
-	0xdb, 0xc5, 0x20, 0x75, 0xad, 0x87, 0xd8, 0xf5, 0x3f, 0xae, 0xcd, 0xdf, 0x4b, 0x2d, 0x55, 0xae,
-	0xde, 0x31, 0xf3, 0x21, 0x93, 0x31, 0x37, 0x23, 0x3e, 0xcb, 0xb9, 0xb1, 0x61, 0x89, 0xdc, 0x87,
-	0x7b, 0x00, 0x93, 0x82, 0x36, 0xdc, 0xfb, 0x83, 0x5c, 0x4f, 0xd5, 0xe2, 0x58, 0x30, 0x33, 0x4e,
-	0x2b, 0xb9, 0x8d, 0x1e, 0xdd, 0x7e, 0x72, 0xaf, 0xef, 0x93, 0xda, 0x9f, 0xac, 0xfd, 0x37, 0x41,
-	0xc8, 0x90, 0xc7, 0x6f, 0x41, 0xea, 0x41, 0x3a, 0x2f, 0x03, 0xe7, 0xb8, 0x0c, 0xfc, 0xdd, 0xf9,
-	0x2e, 0x28, 0x69, 0xb9, 0x4a, 0x6d, 0x71, 0x52, 0x06, 0x0f, 0x0a, 0xa6, 0x92, 0x37, 0xe1, 0x2e,
-	0x15, 0xfe, 0x3a, 0x08, 0x9e, 0x09, 0x69, 0x3f, 0xe7, 0x11, 0x89, 0x41, 0xd1, 0x26, 0x6c, 0xfd,
-	0x79, 0x6e, 0x26, 0x5f, 0xa8, 0x2d, 0x52, 0x6e, 0x36, 0x86, 0x66, 0xd4, 0x52, 0x5b, 0x31, 0xfa,
-	0x7f, 0x91, 0x7b, 0xa7, 0x0a, 0xe8, 0xfd, 0x46, 0x6e, 0x6b, 0x3b, 0xa5, 0xf7, 0x8a, 0xec, 0x7b,
-	0x0e, 0x72, 0x5d, 0x6f, 0x9d, 0xd7, 0x37, 0x9e, 0xab, 0xeb, 0x0c, 0x5f, 0x7e, 0xff, 0x77, 0xf4,
-	0xf3, 0x16, 0xf1, 0xba, 0xd4, 0x8a, 0x8c, 0x4d, 0xf8, 0x15, 0xd7, 0xb2, 0xdb, 0xc3, 0x60, 0x38,
-	0x5f, 0x62, 0xb4, 0x58, 0x62, 0x74, 0xb8, 0xc4, 0xe8, 0xc7, 0x0a, 0x3b, 0x8b, 0x15, 0x76, 0xfe,
-	0xaf, 0xb0, 0xf3, 0xe9, 0xe9, 0xa5, 0x7a, 0xaa, 0xfb, 0x6b, 0x16, 0x7f, 0xbb, 0xb0, 0xba, 0xaa,
-	0x29, 0xba, 0x5b, 0xdd, 0xc4, 0x8b, 0xd3, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x81, 0x03, 0xd0,
-	0xa9, 0x02, 0x00, 0x00,
+func (m *QueryBypassMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBypassMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMsgTypesRequest.Merge(m, src)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryBypassMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+func (m *QueryBypassMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMsgTypesRequest.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryBypassMsgTypesRequest proto.InternalMessageInfo
+
+// QueryBypassMsgTypesResponse is the response type for the
+// Query/BypassMsgTypes RPC method.
+type QueryBypassMsgTypesResponse struct {
+	// BypassMsgTypes message type urls that are free of the minimum gas prices
+	BypassMsgTypes []string `protobuf:"bytes,1,rep,name=bypass_msg_types,json=bypassMsgTypes,proto3" json:"bypass_msg_types,omitempty" yaml:"bypass_msg_types"`
+	// MaxBypassGas maximum gas limit of a tx with bypass messages only
+	MaxBypassGas uint64 `protobuf:"varint,2,opt,name=max_bypass_gas,json=maxBypassGas,proto3" json:"max_bypass_gas,omitempty" yaml:"max_bypass_gas"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBypassMsgTypesResponse) Reset()         { *m = QueryBypassMsgTypesResponse{} }
+func (m *QueryBypassMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMsgTypesResponse) ProtoMessage()    {}
+func (*QueryBypassMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{3}
 }
 
-func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
-	out := new(QueryMinimumGasPricesResponse)
-	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBypassMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBypassMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+func (m *QueryBypassMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMsgTypesResponse.Merge(m, src)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+func (m *QueryBypassMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
 
-func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+func (m *QueryBypassMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMsgTypesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryBypassMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryBypassMsgTypesResponse) GetBypassMsgTypes() []string {
+	if m != nil {
+		return m.BypassMsgTypes
+	}
+	return nil
 }
 
-func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinimumGasPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBypassMsgTypesResponse) GetMaxBypassGas() uint64 {
+	if m != nil {
+		return m.MaxBypassGas
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	return 0
+}
+
+// QueryBasePriceRequest is the request type for the
+// Query/BasePrice RPC method.
+type QueryBasePriceRequest struct {
+	// pagination defines an optional pagination for the history
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBasePriceRequest) Reset()         { *m = QueryBasePriceRequest{} }
+func (m *QueryBasePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBasePriceRequest) ProtoMessage()    {}
+func (*QueryBasePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{4}
+}
+
+func (m *QueryBasePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBasePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.globalfee.v1beta1.Query/MinimumGasPrices",
+}
+
+func (m *QueryBasePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasePriceRequest.Merge(m, src)
+}
+
+func (m *QueryBasePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBasePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasePriceRequest proto.InternalMessageInfo
+
+func (m *QueryBasePriceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+	return nil
+}
+
+// QueryBasePriceResponse is the response type for the
+// Query/BasePrice RPC method.
+type QueryBasePriceResponse struct {
+	// Enabled is true when the dynamic base price is active
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// BasePrice is the current base price
+	BasePrice types.DecCoin `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price" yaml:"base_price"`
+	// History of the base price by height ascending
+	History []BasePriceRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBasePriceResponse) Reset()         { *m = QueryBasePriceResponse{} }
+func (m *QueryBasePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBasePriceResponse) ProtoMessage()    {}
+func (*QueryBasePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{5}
+}
+
+func (m *QueryBasePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBasePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.globalfee.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MinimumGasPrices",
-			Handler:    _Query_MinimumGasPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/globalfee/v1beta1/query.proto",
+func (m *QueryBasePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasePriceResponse.Merge(m, src)
 }
 
-func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBasePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBasePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasePriceResponse proto.InternalMessageInfo
+
+func (m *QueryBasePriceResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBasePriceResponse) GetBasePrice() types.DecCoin {
+	if m != nil {
+		return m.BasePrice
+	}
+	return types.DecCoin{}
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBasePriceResponse) GetHistory() []BasePriceRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBasePriceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEffectiveGasPricesRequest is the request type for the
+// Query/EffectiveGasPrices RPC method.
+type QueryEffectiveGasPricesRequest struct{}
+
+func (m *QueryEffectiveGasPricesRequest) Reset()         { *m = QueryEffectiveGasPricesRequest{} }
+func (m *QueryEffectiveGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveGasPricesRequest) ProtoMessage()    {}
+func (*QueryEffectiveGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{6}
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEffectiveGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEffectiveGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEffectiveGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveGasPricesRequest.Merge(m, src)
+}
+
+func (m *QueryEffectiveGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEffectiveGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveGasPricesRequest proto.InternalMessageInfo
+
+// QueryEffectiveGasPricesResponse is the response type for the
+// Query/EffectiveGasPrices RPC method.
+type QueryEffectiveGasPricesResponse struct {
+	// EffectiveGasPrices are the gas prices per denom that the ante handler
+	// requires. They include the dynamic base price and the prices derived from
+	// the denom ratios.
+	EffectiveGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=effective_gas_prices,json=effectiveGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"effective_gas_prices" yaml:"effective_gas_prices"`
+}
+
+func (m *QueryEffectiveGasPricesResponse) Reset()         { *m = QueryEffectiveGasPricesResponse{} }
+func (m *QueryEffectiveGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveGasPricesResponse) ProtoMessage()    {}
+func (*QueryEffectiveGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{7}
+}
+
+func (m *QueryEffectiveGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEffectiveGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEffectiveGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveGasPricesResponse.Merge(m, src)
+}
+
+func (m *QueryEffectiveGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEffectiveGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveGasPricesResponse) GetEffectiveGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EffectiveGasPrices
+	}
+	return nil
+}
+
+// QueryEstimateFeeRequest is the request type for the
+// Query/EstimateFee RPC method.
+type QueryEstimateFeeRequest struct {
+	// Gas is the gas limit of the tx
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// Denoms optionally limits the result to these fee denoms
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{8}
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryEstimateFeeRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryEstimateFeeResponse is the response type for the
+// Query/EstimateFee RPC method.
+type QueryEstimateFeeResponse struct {
+	// Fees are the minimum fees per denom for the gas limit. Each of them is
+	// accepted on its own by the global minimum and by the minimum gas prices of
+	// the queried node. Empty when no fee is required.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// GasPrices are the gas prices per denom that the fees are derived from
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
+}
+
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{9}
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *QueryEstimateFeeResponse) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesRequest")
+	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+	proto.RegisterType((*QueryBypassMsgTypesRequest)(nil), "confio.globalfee.v1beta1.QueryBypassMsgTypesRequest")
+	proto.RegisterType((*QueryBypassMsgTypesResponse)(nil), "confio.globalfee.v1beta1.QueryBypassMsgTypesResponse")
+	proto.RegisterType((*QueryBasePriceRequest)(nil), "confio.globalfee.v1beta1.QueryBasePriceRequest")
+	proto.RegisterType((*QueryBasePriceResponse)(nil), "confio.globalfee.v1beta1.QueryBasePriceResponse")
+	proto.RegisterType((*QueryEffectiveGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryEffectiveGasPricesRequest")
+	proto.RegisterType((*QueryEffectiveGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryEffectiveGasPricesResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "confio.globalfee.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "confio.globalfee.v1beta1.QueryEstimateFeeResponse")
+}
+
+func init() {
+	proto.RegisterFile("confio/globalfee/v1beta1/query.proto", fileDescriptor_1265df7e439588bb)
+}
+
+var fileDescriptor_1265df7e439588bb = []byte{
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6f, 0xe3, 0x44,
+	0x1c, 0xee, 0xa4, 0x61, 0x4b, 0x7e, 0x45, 0x55, 0x19, 0xed, 0x23, 0x75, 0x83, 0x5d, 0x59, 0xab,
+	0x12, 0x4a, 0xb1, 0xdb, 0xb0, 0x2c, 0x8f, 0x0b, 0x52, 0xf6, 0x51, 0x38, 0xac, 0xb4, 0x58, 0x88,
+	0x03, 0x17, 0x6b, 0xec, 0x4c, 0xbc, 0x16, 0xb1, 0xc7, 0x9b, 0x71, 0x56, 0xcd, 0x11, 0x10, 0x77,
+	0x24, 0xae, 0x88, 0x03, 0x82, 0xcb, 0xde, 0x38, 0x22, 0x71, 0xe1, 0x82, 0xf6, 0xb8, 0x12, 0x17,
+	0x4e, 0x29, 0x6a, 0x39, 0x71, 0xcc, 0x5f, 0x80, 0x3c, 0x1e, 0xa7, 0x71, 0x1c, 0x37, 0x0d, 0x7b,
+	0x8a, 0xad, 0xdf, 0xeb, 0xfb, 0xbe, 0xf9, 0xcd, 0x17, 0xc3, 0x4d, 0x97, 0x85, 0x5d, 0x9f, 0x99,
+	0x5e, 0x8f, 0x39, 0xa4, 0xd7, 0xa5, 0xd4, 0x7c, 0x72, 0xe8, 0xd0, 0x98, 0x1c, 0x9a, 0x8f, 0x07,
+	0xb4, 0x3f, 0x34, 0xa2, 0x3e, 0x8b, 0x19, 0xae, 0xa7, 0x59, 0xc6, 0x24, 0xcb, 0x90, 0x59, 0xca,
+	0x55, 0x8f, 0x79, 0x4c, 0x24, 0x99, 0xc9, 0x53, 0x9a, 0xaf, 0x34, 0x3c, 0xc6, 0xbc, 0x1e, 0x35,
+	0x49, 0xe4, 0x9b, 0x24, 0x0c, 0x59, 0x4c, 0x62, 0x9f, 0x85, 0x5c, 0x46, 0x55, 0x97, 0xf1, 0x80,
+	0x71, 0xd3, 0x21, 0xfc, 0x7c, 0x9c, 0xcb, 0xfc, 0x50, 0xc6, 0xf7, 0xa6, 0xe3, 0x02, 0xc6, 0x24,
+	0x2b, 0x22, 0x9e, 0x1f, 0x8a, 0x66, 0x32, 0x77, 0xb7, 0x14, 0xbf, 0x47, 0x43, 0xca, 0x7d, 0x39,
+	0x53, 0x57, 0xa1, 0xf1, 0x49, 0xd2, 0xe9, 0x81, 0x1f, 0xfa, 0xc1, 0x20, 0x38, 0x22, 0xfc, 0x61,
+	0xdf, 0x77, 0x29, 0xb7, 0xe8, 0xe3, 0x01, 0xe5, 0xb1, 0x3e, 0x42, 0xf0, 0x5a, 0x49, 0x02, 0x8f,
+	0x58, 0xc8, 0x29, 0xfe, 0x0d, 0x01, 0x0e, 0xd2, 0xa0, 0xed, 0x11, 0x6e, 0x47, 0x22, 0x5c, 0x47,
+	0x3b, 0xab, 0xcd, 0xf5, 0x56, 0xc3, 0x48, 0x31, 0x1b, 0x09, 0xe6, 0x4c, 0x1c, 0xe3, 0x2e, 0x75,
+	0xef, 0x30, 0x3f, 0x6c, 0x47, 0xcf, 0x46, 0xda, 0xca, 0xbf, 0x23, 0xad, 0x51, 0xac, 0xdf, 0x67,
+	0x81, 0x1f, 0xd3, 0x20, 0x8a, 0x87, 0xe3, 0x91, 0xb6, 0x35, 0x24, 0x41, 0xef, 0x03, 0xbd, 0x98,
+	0xa5, 0x3f, 0x3d, 0xd1, 0xde, 0xf4, 0xfc, 0xf8, 0xd1, 0xc0, 0x31, 0x5c, 0x16, 0x98, 0x52, 0xa0,
+	0xf4, 0xe7, 0x2d, 0xde, 0xf9, 0xc2, 0x8c, 0x87, 0x11, 0xe5, 0xd9, 0x40, 0x6e, 0x6d, 0x06, 0x33,
+	0x34, 0xf4, 0x06, 0x28, 0x82, 0x5f, 0x7b, 0x18, 0x11, 0xce, 0x1f, 0x70, 0xef, 0xd3, 0x61, 0x74,
+	0x4e, 0xff, 0x67, 0x04, 0xdb, 0x73, 0xc3, 0x92, 0xfc, 0x3d, 0xd8, 0x74, 0x44, 0xc4, 0x0e, 0xb8,
+	0x67, 0x8b, 0x61, 0x82, 0x79, 0xad, 0xbd, 0x3d, 0x1e, 0x69, 0x37, 0x52, 0xdc, 0xb3, 0x19, 0xba,
+	0xb5, 0xe1, 0xe4, 0xda, 0xe1, 0x0f, 0x61, 0x23, 0x20, 0xc7, 0xb6, 0x4c, 0xf4, 0x08, 0xaf, 0x57,
+	0x76, 0x50, 0xb3, 0xda, 0xde, 0x1a, 0x8f, 0xb4, 0x6b, 0x92, 0x7c, 0x2e, 0xae, 0x5b, 0xaf, 0x04,
+	0xe4, 0x38, 0x05, 0x75, 0x44, 0xb8, 0x6e, 0xc3, 0xb5, 0x14, 0x26, 0xe1, 0x54, 0x10, 0x93, 0x04,
+	0xf0, 0x7d, 0x80, 0xf3, 0xdd, 0xa8, 0xa3, 0x1d, 0xd4, 0x5c, 0x6f, 0xed, 0xe6, 0x0e, 0x25, 0xdd,
+	0xe7, 0xec, 0x68, 0x1e, 0x12, 0x2f, 0xab, 0xb5, 0xa6, 0x2a, 0xf5, 0x1f, 0x2a, 0x70, 0x7d, 0x76,
+	0x82, 0xd4, 0xa0, 0x0e, 0x6b, 0x34, 0x24, 0x4e, 0x8f, 0x76, 0x44, 0xff, 0x97, 0xad, 0xec, 0x15,
+	0x7f, 0x06, 0x90, 0x8c, 0x48, 0x0f, 0x4b, 0x50, 0x5a, 0xb4, 0x11, 0x5b, 0xc9, 0x46, 0x8c, 0x47,
+	0xda, 0xab, 0x52, 0xb9, 0x49, 0xb5, 0x6e, 0xd5, 0x9c, 0x6c, 0x32, 0xfe, 0x18, 0xd6, 0x1e, 0xf9,
+	0x3c, 0x66, 0xfd, 0x61, 0x7d, 0x55, 0xac, 0xd9, 0x1b, 0x46, 0xd9, 0x45, 0x34, 0xa6, 0xf0, 0xba,
+	0xac, 0xdf, 0x69, 0x57, 0x93, 0x09, 0x56, 0x56, 0x8f, 0x8f, 0x72, 0xfa, 0x54, 0x05, 0xc4, 0xd7,
+	0x17, 0xea, 0x93, 0x32, 0xcf, 0x09, 0xb4, 0x03, 0xaa, 0xd0, 0xe7, 0x5e, 0xb7, 0x4b, 0xdd, 0xd8,
+	0x7f, 0x42, 0x0b, 0x57, 0xe9, 0x0f, 0x04, 0x5a, 0x69, 0x8a, 0xd4, 0xf2, 0x47, 0x04, 0x57, 0x69,
+	0x16, 0x5e, 0xf6, 0x3a, 0x59, 0x52, 0xbc, 0xed, 0x54, 0xbc, 0x79, 0x7d, 0x96, 0xbe, 0x30, 0x98,
+	0x16, 0xc0, 0xea, 0x77, 0xe0, 0x46, 0xca, 0x83, 0xc7, 0x7e, 0x40, 0x62, 0x7a, 0x9f, 0x4e, 0xd6,
+	0x6d, 0x13, 0x56, 0x93, 0xed, 0x4d, 0xf6, 0xa0, 0x6a, 0x25, 0x8f, 0xf8, 0x3a, 0x5c, 0xe9, 0xd0,
+	0x90, 0x05, 0xc9, 0x4a, 0xaf, 0x36, 0x6b, 0x96, 0x7c, 0xd3, 0xbf, 0xae, 0x40, 0xbd, 0xd8, 0x45,
+	0xca, 0x60, 0x43, 0xb5, 0x4b, 0x27, 0xac, 0xb7, 0xe6, 0xb2, 0x16, 0x94, 0x0f, 0x12, 0xca, 0x4f,
+	0x4f, 0xb4, 0xe6, 0x25, 0x38, 0xa5, 0x84, 0x44, 0x63, 0xfc, 0x0d, 0x02, 0x98, 0x52, 0xb7, 0x72,
+	0x09, 0x75, 0x3f, 0xca, 0xaf, 0xe6, 0x0b, 0x68, 0x5a, 0xf3, 0x32, 0x29, 0x5b, 0x5f, 0xae, 0xc1,
+	0x4b, 0x42, 0x05, 0xfc, 0x2b, 0x82, 0xcd, 0x59, 0x8f, 0xc5, 0xb7, 0xcb, 0xf7, 0xfa, 0x22, 0xd7,
+	0x56, 0xde, 0x5d, 0xba, 0x2e, 0x15, 0x5e, 0xbf, 0xf5, 0xd5, 0x9f, 0xff, 0x7c, 0x57, 0x31, 0xf0,
+	0xbe, 0x19, 0x7b, 0x7d, 0xd2, 0xa1, 0x73, 0xfe, 0x3f, 0x8a, 0x2e, 0x8c, 0x7f, 0x41, 0xb0, 0x91,
+	0x37, 0x48, 0x7c, 0x6b, 0x01, 0x82, 0xb9, 0x76, 0xab, 0xbc, 0xb3, 0x64, 0x95, 0x44, 0xdd, 0x12,
+	0xa8, 0xf7, 0xf1, 0x5e, 0x39, 0xea, 0x59, 0x0f, 0xc6, 0xdf, 0x23, 0xa8, 0x4d, 0xbc, 0x01, 0x9b,
+	0x8b, 0x06, 0xcf, 0xf8, 0xaa, 0x72, 0x70, 0xf9, 0x02, 0x09, 0x72, 0x5f, 0x80, 0xdc, 0xc5, 0x37,
+	0x2f, 0x00, 0x39, 0xb1, 0x3b, 0xfc, 0x3b, 0x02, 0x5c, 0xf4, 0x09, 0xfc, 0xde, 0x82, 0xb1, 0xa5,
+	0xee, 0xa3, 0xbc, 0xff, 0x3f, 0x2a, 0x25, 0xf2, 0xdb, 0x02, 0xf9, 0x01, 0x36, 0xca, 0x91, 0xcf,
+	0xf3, 0x1a, 0xfc, 0x13, 0x82, 0xf5, 0xa9, 0xdb, 0x8d, 0x0f, 0x17, 0x41, 0x28, 0xf8, 0x89, 0xd2,
+	0x5a, 0xa6, 0x44, 0xc2, 0x35, 0x04, 0xdc, 0x26, 0xde, 0xbd, 0x00, 0xae, 0x2c, 0xb3, 0xbb, 0x94,
+	0xb6, 0xef, 0x3e, 0x3b, 0x55, 0xd1, 0xf3, 0x53, 0x15, 0xfd, 0x7d, 0xaa, 0xa2, 0x6f, 0xcf, 0xd4,
+	0x95, 0xe7, 0x67, 0xea, 0xca, 0x5f, 0x67, 0xea, 0xca, 0xe7, 0x7b, 0xb9, 0x5b, 0x2d, 0xbe, 0xa7,
+	0x64, 0xcb, 0xe3, 0xa9, 0xa6, 0x62, 0x9f, 0x9c, 0x2b, 0xe2, 0x7b, 0xea, 0xed, 0xff, 0x06, 0x00,
+	0xb7, 0x93, 0x2a, 0x2d, 0x39, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+	BypassMsgTypes(ctx context.Context, in *QueryBypassMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMsgTypesResponse, error)
+	BasePrice(ctx context.Context, in *QueryBasePriceRequest, opts ...grpc.CallOption) (*QueryBasePriceResponse, error)
+	EffectiveGasPrices(ctx context.Context, in *QueryEffectiveGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveGasPricesResponse, error)
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
+	out := new(QueryMinimumGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BypassMsgTypes(ctx context.Context, in *QueryBypassMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMsgTypesResponse, error) {
+	out := new(QueryBypassMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/BypassMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BasePrice(ctx context.Context, in *QueryBasePriceRequest, opts ...grpc.CallOption) (*QueryBasePriceResponse, error) {
+	out := new(QueryBasePriceResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/BasePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveGasPrices(ctx context.Context, in *QueryEffectiveGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveGasPricesResponse, error) {
+	out := new(QueryEffectiveGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/EffectiveGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+	BypassMsgTypes(context.Context, *QueryBypassMsgTypesRequest) (*QueryBypassMsgTypesResponse, error)
+	BasePrice(context.Context, *QueryBasePriceRequest) (*QueryBasePriceResponse, error)
+	EffectiveGasPrices(context.Context, *QueryEffectiveGasPricesRequest) (*QueryEffectiveGasPricesResponse, error)
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+}
+
+func (*UnimplementedQueryServer) BypassMsgTypes(ctx context.Context, req *QueryBypassMsgTypesRequest) (*QueryBypassMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BypassMsgTypes not implemented")
+}
+
+func (*UnimplementedQueryServer) BasePrice(ctx context.Context, req *QueryBasePriceRequest) (*QueryBasePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasePrice not implemented")
+}
+
+func (*UnimplementedQueryServer) EffectiveGasPrices(ctx context.Context, req *QueryEffectiveGasPricesRequest) (*QueryEffectiveGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveGasPrices not implemented")
+}
+
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinimumGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/MinimumGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BypassMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBypassMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BypassMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/BypassMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BypassMsgTypes(ctx, req.(*QueryBypassMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/BasePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasePrice(ctx, req.(*QueryBasePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/EffectiveGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveGasPrices(ctx, req.(*QueryEffectiveGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.globalfee.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinimumGasPrices",
+			Handler:    _Query_MinimumGasPrices_Handler,
+		},
+		{
+			MethodName: "BypassMsgTypes",
+			Handler:    _Query_BypassMsgTypes_Handler,
+		},
+		{
+			MethodName: "BasePrice",
+			Handler:    _Query_BasePrice_Handler,
+		},
+		{
+			MethodName: "EffectiveGasPrices",
+			Handler:    _Query_EffectiveGasPrices_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/globalfee/v1beta1/query.proto",
+}
+
+func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBypassGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBypassGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BypassMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMsgTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BypassMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.BasePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveGasPrices) > 0 {
+		for iNdEx := len(m.EffectiveGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EffectiveGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryMinimumGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinimumGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBypassMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBypassMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BypassMsgTypes) > 0 {
+		for _, s := range m.BypassMsgTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxBypassGas != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBypassGas))
+	}
+	return n
+}
+
+func (m *QueryBasePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBasePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.BasePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EffectiveGasPrices) > 0 {
+		for _, e := range m.EffectiveGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBypassMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBypassMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMsgTypes = append(m.BypassMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBypassGas", wireType)
+			}
+			m.MaxBypassGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBypassGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBasePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBasePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BasePriceRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0xa
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
 
-func (m *QueryMinimumGasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEffectiveGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMinimumGasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QueryEffectiveGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveGasPrices = append(m.EffectiveGasPrices, types.DecCoin{})
+			if err := m.EffectiveGasPrices[len(m.EffectiveGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -372,12 +2140,63 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -400,7 +2219,7 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,15 +2242,15 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -458,8 +2277,42 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,7 @@
 package poe
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -17,11 +18,16 @@ import (
 type endBlockKeeper interface {
 	types.Sudoer
 	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	GetCallbackGasLimit(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) uint64
+	TrackCallbackFailure(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress)
+	ResetCallbackFailures(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress)
 }
 
 type abciKeeper interface {
 	UpdateValidatorVotes(validatorVotes []abci.VoteInfo)
+	TrackValidatorSignatures(ctx sdk.Context, votes []abci.VoteInfo)
 	TrackHistoricalInfo(ctx sdk.Context)
+	SetBlockEvidence(ctx sdk.Context, evidence []abci.Evidence)
 }
 
 // EndBlocker calls the Valset contract for the validator diff.
@@ -35,6 +41,8 @@ func EndBlocker(parentCtx sdk.Context, k endBlockKeeper) []abci.ValidatorUpdate
 		logger.Info("privileged contract callback", "type", twasmtypes.PrivilegeTypeValidatorSetUpdate.String())
 		ctx, commit := parentCtx.CacheContext()
 		defer twasm.RecoverToLog(logger, contractAddr)()
+		ctx, gasLimit := twasm.WithCallbackGasLimit(ctx, k, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr)
+		defer twasm.RecoverOutOfGasToEvent(parentCtx, twasmtypes.PrivilegeTypeValidatorSetUpdate, contractAddr, gasLimit)()
 
 		var err error
 		diff, err = contract.CallEndBlockWithValidatorUpdate(ctx, contractAddr, k)
@@ -53,13 +61,33 @@ func EndBlocker(parentCtx sdk.Context, k endBlockKeeper) []abci.ValidatorUpdate
 		}
 		return true // stop at first contract
 	})
+	if len(diff) != 0 {
+		notifyValidatorSetObservers(parentCtx, k, diff)
+	}
 	return diff
 }
 
+// sends the validator set diff to all validator set observer contracts. A failing observer does not affect the update.
+func notifyValidatorSetObservers(ctx sdk.Context, k endBlockKeeper, diff []abci.ValidatorUpdate) {
+	updates, err := contract.NewValidatorUpdates(diff)
+	if err != nil {
+		keeper.ModuleLogger(ctx).Error("failed to convert validator set diff for observers", "cause", err)
+		return
+	}
+	msgBz, err := json.Marshal(contract.ValidatorSetObserverSudoMsg{ValidatorSetUpdate: &contract.ValidatorSetUpdate{Diffs: updates}})
+	if err != nil {
+		panic(err) // this will break consensus
+	}
+	twasm.CallPrivilegedContracts(ctx, k, twasmtypes.PrivilegeTypeValidatorSetObserver, msgBz)
+}
+
 // BeginBlocker ABCI begin block callback
 func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	k.UpdateValidatorVotes(b.LastCommitInfo.Votes)
+	k.TrackValidatorSignatures(ctx, b.LastCommitInfo.Votes)
 	k.TrackHistoricalInfo(ctx)
+	// store before the twasm begin blocker forwards the evidence to the valset contract
+	k.SetBlockEvidence(ctx, b.ByzantineValidators)
 }
@@ -2,6 +2,7 @@ package poe
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -22,13 +23,29 @@ func TestEndBlock(t *testing.T) {
 	var (
 		capturedSudoCalls []tuple
 		myAddr            sdk.AccAddress = rand.Bytes(address.Len)
+		myObserverAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		otherObserverAddr sdk.AccAddress = rand.Bytes(address.Len)
 	)
+	valsetResponseFn := func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+		captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+		if !contractAddress.Equals(myAddr) {
+			return nil, nil
+		}
+		return json.Marshal(&contract.EndWithValidatorUpdateResponse{
+			Diffs: []contract.ValidatorUpdate{
+				{PubKey: contract.ValidatorPubkey{Ed25519: []byte("my key")}, Power: 1},
+			},
+		})
+	}
+	const observerMsg = `{"validator_set_update":{"diffs":[{"pubkey":{"ed25519":"bXkga2V5"},"power":1}]}}`
 
 	specs := map[string]struct {
 		setup           func(m *MockSudoer)
 		expSudoCalls    []tuple
 		expCommitted    []bool
 		expValsetUpdate []abci.ValidatorUpdate
+		expFailures     []sdk.AccAddress
+		expResets       []sdk.AccAddress
 	}{
 		"valset update - empty response": {
 			setup: func(m *MockSudoer) {
@@ -36,7 +53,7 @@ func TestEndBlock(t *testing.T) {
 					_, err := captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
 					return []byte{}, err
 				}
-				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, nil)
 			},
 			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)}},
 			expCommitted: []bool{true},
@@ -49,7 +66,7 @@ func TestEndBlock(t *testing.T) {
 					require.NoError(t, err)
 					return bz, err
 				}
-				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, nil)
 			},
 			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)}},
 			expCommitted: []bool{true},
@@ -67,7 +84,7 @@ func TestEndBlock(t *testing.T) {
 					require.NoError(t, err)
 					return bz, err
 				}
-				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, nil)
 			},
 			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)}},
 			expCommitted: []bool{true},
@@ -79,6 +96,58 @@ func TestEndBlock(t *testing.T) {
 				Power:  2,
 			}},
 		},
+		"valset update - observers notified": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = valsetResponseFn
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, []sdk.AccAddress{myObserverAddr, otherObserverAddr})
+			},
+			expSudoCalls: []tuple{
+				{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)},
+				{addr: myObserverAddr, msg: []byte(observerMsg)},
+				{addr: otherObserverAddr, msg: []byte(observerMsg)},
+			},
+			expCommitted: []bool{true, true, true},
+			expValsetUpdate: []abci.ValidatorUpdate{{
+				PubKey: crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte("my key")}},
+				Power:  1,
+			}},
+			expResets: []sdk.AccAddress{myObserverAddr, otherObserverAddr},
+		},
+		"valset update - failing observer": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					if contractAddress.Equals(myObserverAddr) {
+						captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+						return nil, errors.New("testing")
+					}
+					return valsetResponseFn(ctx, contractAddress, msg)
+				}
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, []sdk.AccAddress{myObserverAddr, otherObserverAddr})
+			},
+			expSudoCalls: []tuple{
+				{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)},
+				{addr: myObserverAddr, msg: []byte(observerMsg)},
+				{addr: otherObserverAddr, msg: []byte(observerMsg)},
+			},
+			expCommitted: []bool{true, false, true},
+			expValsetUpdate: []abci.ValidatorUpdate{{
+				PubKey: crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte("my key")}},
+				Power:  1,
+			}},
+			expFailures: []sdk.AccAddress{myObserverAddr},
+			expResets:   []sdk.AccAddress{otherObserverAddr},
+		},
+		"valset update - observers not called without diff": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					_, err := captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+					return []byte{}, err
+				}
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, []sdk.AccAddress{myObserverAddr})
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_with_validator_update":{}}`)}},
+			expCommitted: []bool{true},
+		},
 		"valset update - panic should be handled": {
 			setup: func(m *MockSudoer) {
 				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
@@ -87,14 +156,24 @@ func TestEndBlock(t *testing.T) {
 					}
 					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
 				}
-				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr})
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, nil, []sdk.AccAddress{myAddr}, nil)
 			},
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			capturedSudoCalls = nil
-			mock := MockSudoer{}
+			var capturedFailures, capturedResets []sdk.AccAddress
+			mock := MockSudoer{
+				TrackCallbackFailureFn: func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+					require.Equal(t, twasmtypes.PrivilegeTypeValidatorSetObserver, privilegeType)
+					capturedFailures = append(capturedFailures, contractAddr)
+				},
+				ResetCallbackFailuresFn: func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+					require.Equal(t, twasmtypes.PrivilegeTypeValidatorSetObserver, privilegeType)
+					capturedResets = append(capturedResets, contractAddr)
+				},
+			}
 			spec.setup(&mock)
 			commitMultistore := mockCommitMultiStore{}
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
@@ -115,6 +194,9 @@ func TestEndBlock(t *testing.T) {
 			for i, v := range spec.expCommitted {
 				assert.Equal(t, v, commitMultistore.committed[i], "tx number %d", i)
 			}
+			// and observer failures tracked
+			assert.Equal(t, spec.expFailures, capturedFailures)
+			assert.Equal(t, spec.expResets, capturedResets)
 		})
 	}
 }
@@ -131,13 +213,15 @@ func iterateContractsFn(t *testing.T, expType twasmtypes.PrivilegeType, addrs ..
 }
 
 // helper function to handle both types in end block
-func endBlockTypeIterateContractsFn(t *testing.T, end []sdk.AccAddress, valset []sdk.AccAddress) func(sdk.Context, twasmtypes.PrivilegeType, func(uint8, sdk.AccAddress) bool) {
+func endBlockTypeIterateContractsFn(t *testing.T, end []sdk.AccAddress, valset []sdk.AccAddress, observers []sdk.AccAddress) func(sdk.Context, twasmtypes.PrivilegeType, func(uint8, sdk.AccAddress) bool) {
 	return func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 		switch privilegeType {
 		case twasmtypes.PrivilegeTypeEndBlock:
 			iterateContractsFn(t, twasmtypes.PrivilegeTypeEndBlock, end...)(ctx, privilegeType, cb)
 		case twasmtypes.PrivilegeTypeValidatorSetUpdate:
 			iterateContractsFn(t, twasmtypes.PrivilegeTypeValidatorSetUpdate, valset...)(ctx, privilegeType, cb)
+		case twasmtypes.PrivilegeTypeValidatorSetObserver:
+			iterateContractsFn(t, twasmtypes.PrivilegeTypeValidatorSetObserver, observers...)(ctx, privilegeType, cb)
 		default:
 			t.Errorf("unexpected privileged type: %q", privilegeType.String())
 		}
@@ -159,6 +243,9 @@ func captureSudos(capturedSudoCalls *[]tuple) func(ctx sdk.Context, contractAddr
 type MockSudoer struct {
 	SudoFn                             func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByTypeFn func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	GetCallbackGasLimitFn              func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) uint64
+	TrackCallbackFailureFn             func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress)
+	ResetCallbackFailuresFn            func(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress)
 }
 
 func (m MockSudoer) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
@@ -175,6 +262,27 @@ func (m MockSudoer) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeT
 	m.IteratePrivilegedContractsByTypeFn(ctx, privilegeType, cb)
 }
 
+func (m MockSudoer) GetCallbackGasLimit(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+	if m.GetCallbackGasLimitFn == nil {
+		return 0 // no limit
+	}
+	return m.GetCallbackGasLimitFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) TrackCallbackFailure(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.TrackCallbackFailureFn == nil {
+		panic("not expected to be called")
+	}
+	m.TrackCallbackFailureFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) ResetCallbackFailures(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.ResetCallbackFailuresFn == nil {
+		panic("not expected to be called")
+	}
+	m.ResetCallbackFailuresFn(ctx, privilegeType, contractAddr)
+}
+
 type mockCommitMultiStore struct {
 	sdk.CommitMultiStore
 	committed []bool
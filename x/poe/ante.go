@@ -12,6 +12,12 @@ import (
 	"github.com/confio/tgrade/x/poe/types"
 )
 
+// FeeDistributionSource is the subset of the PoE keeper to distribute the fees
+type FeeDistributionSource interface {
+	keeper.ContractSource
+	FeeSplit(ctx sdk.Context) types.FeeSplit
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -20,19 +26,20 @@ type DeductFeeDecorator struct {
 	ak             types.AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper ante.FeegrantKeeper
-	contractSource keeper.ContractSource
+	feeSource      FeeDistributionSource
 }
 
-func NewDeductFeeDecorator(ak types.AccountKeeper, bk types.BankKeeper, fk ante.FeegrantKeeper, cs keeper.ContractSource) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak types.AccountKeeper, bk types.BankKeeper, fk ante.FeegrantKeeper, fs FeeDistributionSource) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		ak:             ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
-		contractSource: cs,
+		feeSource:      fs,
 	}
 }
 
-// AnteHandle has the same logic as ante.DeductFeeDecorator except that the fees are transferred to a PoE VALSET contract
+// AnteHandle has the same logic as ante.DeductFeeDecorator except that the fees are split between the PoE VALSET
+// contract, the COMMUNITY_POOL contract and burning
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -81,20 +88,48 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account and splits them by the fee split param.
+// A fee_split event is emitted for each recipient.
 func (dfd DeductFeeDecorator) DeductFees(ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
-	// in POE we have a contract that receives the fees
-	feeCollector, err := dfd.contractSource.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
-	if err != nil {
-		panic(fmt.Sprintf("%s contract address has not been set", types.PoEContractTypeValset))
+	valsetFees, communityPoolFees, burnFees := dfd.feeSource.FeeSplit(ctx).Split(fees)
+
+	// in POE we have contracts that receive the fees
+	for _, r := range []struct {
+		ctype   types.PoEContractType
+		amounts sdk.Coins
+	}{{types.PoEContractTypeValset, valsetFees}, {types.PoEContractTypeCommunityPool, communityPoolFees}} {
+		if r.amounts.IsZero() {
+			continue
+		}
+		feeCollector, err := dfd.feeSource.GetPoEContractAddress(ctx, r.ctype)
+		if err != nil {
+			panic(fmt.Sprintf("%s contract address has not been set", r.ctype))
+		}
+		if err := dfd.bankKeeper.SendCoins(ctx, acc.GetAddress(), feeCollector, r.amounts); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+		emitFeeSplitEvent(ctx, r.ctype.String(), r.amounts)
 	}
 
-	if err := dfd.bankKeeper.SendCoins(ctx, acc.GetAddress(), feeCollector, fees); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	if !burnFees.IsZero() {
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.ModuleName, burnFees); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+		if err := dfd.bankKeeper.BurnCoins(ctx, types.ModuleName, burnFees); err != nil {
+			return sdkerrors.Wrap(err, "burn fees")
+		}
+		emitFeeSplitEvent(ctx, types.AttributeValueFeeBurn, burnFees)
 	}
-
 	return nil
 }
+
+func emitFeeSplitEvent(ctx sdk.Context, recipient string, amounts sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeSplit,
+		sdk.NewAttribute(types.AttributeKeyFeeRecipient, recipient),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	))
+}
@@ -26,10 +26,15 @@ func TestDeductFeeDecorator(t *testing.T) {
 		myFeeGranterAddr sdk.AccAddress = rand.Bytes(address.Len)
 	)
 
-	cs := keeper.PoEKeeperMock{GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
-		require.Equal(t, types.PoEContractTypeValset, ctype)
-		return myContractAddr, nil
-	}}
+	cs := keeper.PoEKeeperMock{
+		GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
+			require.Equal(t, types.PoEContractTypeValset, ctype)
+			return myContractAddr, nil
+		},
+		FeeSplitFn: func(ctx sdk.Context) types.FeeSplit {
+			return types.DefaultFeeSplit()
+		},
+	}
 
 	accountsMock := func(expAddr sdk.AccAddress) types.AccountKeeper {
 		return accountKeeperMock{func(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
@@ -146,14 +151,138 @@ func TestDeductFeeDecorator(t *testing.T) {
 			require.NoError(t, gotErr)
 			assert.True(t, *gotCalled, "next ante handler called")
 			// and an event emitted
-			require.Len(t, em.Events(), 1)
-			require.Len(t, em.Events()[0].Attributes, 1)
-			require.Equal(t, []byte(sdk.AttributeKeyFee), em.Events()[0].Attributes[0].Key)
+			events := em.Events()
+			require.NotEmpty(t, events)
+			txEvent := events[len(events)-1]
+			require.Len(t, txEvent.Attributes, 1)
+			require.Equal(t, []byte(sdk.AttributeKeyFee), txEvent.Attributes[0].Key)
 			assert.Equal(t, spec.expFeesGranted, *capturedGrantedFees)
 		})
 	}
 }
 
+func TestDeductFeeDecoratorFeeSplit(t *testing.T) {
+	var (
+		myValsetAddr        sdk.AccAddress = rand.Bytes(address.Len)
+		myCommunityPoolAddr sdk.AccAddress = rand.Bytes(address.Len)
+		mySenderAddr        sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	type capturedSend struct {
+		to  string
+		amt sdk.Coins
+	}
+	specs := map[string]struct {
+		feeSplit  types.FeeSplit
+		feeAmount sdk.Coins
+		burnErr   error
+		expSends  []capturedSend
+		expBurned sdk.Coins
+		expEvents []string
+		expErr    bool
+	}{
+		"default split": {
+			feeSplit:  types.DefaultFeeSplit(),
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expSends:  []capturedSend{{to: myValsetAddr.String(), amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10)))}},
+			expEvents: []string{"VALSET"},
+		},
+		"all recipients": {
+			feeSplit:  types.FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+			expSends: []capturedSend{
+				{to: myValsetAddr.String(), amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(6)))},
+				{to: myCommunityPoolAddr.String(), amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2)))},
+				{to: types.ModuleName, amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1)))},
+			},
+			expBurned: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			expEvents: []string{"VALSET", "COMMUNITY_POOL", types.AttributeValueFeeBurn},
+		},
+		"all burned": {
+			feeSplit:  types.FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expSends:  []capturedSend{{to: types.ModuleName, amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10)))}},
+			expBurned: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expEvents: []string{types.AttributeValueFeeBurn},
+		},
+		"amount too small to split": {
+			feeSplit:  types.FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.OneInt())),
+			expSends:  []capturedSend{{to: myValsetAddr.String(), amt: sdk.NewCoins(sdk.NewCoin("ALX", sdk.OneInt()))}},
+			expEvents: []string{"VALSET"},
+		},
+		"burn fails": {
+			feeSplit:  types.FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			burnErr:   errors.New("testing"),
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotSends []capturedSend
+			var gotBurned sdk.Coins
+			bank := bankKeeperMock{
+				SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+					require.Equal(t, mySenderAddr, fromAddr)
+					gotSends = append(gotSends, capturedSend{to: toAddr.String(), amt: amt})
+					return nil
+				},
+				SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+					require.Equal(t, mySenderAddr, senderAddr)
+					gotSends = append(gotSends, capturedSend{to: recipientModule, amt: amt})
+					return nil
+				},
+				BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+					require.Equal(t, types.ModuleName, moduleName)
+					if spec.burnErr != nil {
+						return spec.burnErr
+					}
+					gotBurned = gotBurned.Add(amt...)
+					return nil
+				},
+			}
+			fs := keeper.PoEKeeperMock{
+				GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
+					switch ctype {
+					case types.PoEContractTypeValset:
+						return myValsetAddr, nil
+					case types.PoEContractTypeCommunityPool:
+						return myCommunityPoolAddr, nil
+					}
+					t.Fatalf("unexpected contract type: %s", ctype)
+					return nil, nil
+				},
+				FeeSplitFn: func(ctx sdk.Context) types.FeeSplit {
+					return spec.feeSplit
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithEventManager(em)
+			decorator := NewDeductFeeDecorator(nil, bank, nil, fs)
+
+			// when
+			gotErr := decorator.DeductFees(ctx, authtypes.NewBaseAccount(mySenderAddr, nil, 1, 1), spec.feeAmount)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSends, gotSends)
+			assert.Equal(t, spec.expBurned, gotBurned)
+			var gotRecipients []string
+			for _, e := range em.Events() {
+				require.Equal(t, types.EventTypeFeeSplit, e.Type)
+				require.Len(t, e.Attributes, 2)
+				assert.Equal(t, []byte(types.AttributeKeyFeeRecipient), e.Attributes[0].Key)
+				gotRecipients = append(gotRecipients, string(e.Attributes[0].Value))
+			}
+			assert.Equal(t, spec.expEvents, gotRecipients)
+		})
+	}
+}
+
 type capturedGrantedFee struct {
 	feeGranter, feePayer sdk.AccAddress
 	fee                  sdk.Coins
@@ -173,20 +302,21 @@ type bankKeeperMock struct {
 	SendCoinsFromModuleToAccountFn       func(ctx sdk.Context, s string, addr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModuleFn   func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccountFn func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 func (m bankKeeperMock) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	if m.SendCoinsFromAccountToModuleFn == nil {
 		panic("not expected to be called")
 	}
-	return m.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	return m.SendCoinsFromAccountToModuleFn(ctx, senderAddr, recipientModule, amt)
 }
 
 func (m bankKeeperMock) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	if m.SendCoinsFromModuleToAccountFn == nil {
 		panic("not expected to be called")
 	}
-	return m.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	return m.SendCoinsFromModuleToAccountFn(ctx, senderModule, recipientAddr, amt)
 }
 
 func (m bankKeeperMock) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
@@ -210,6 +340,13 @@ func (m bankKeeperMock) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 	return m.SendCoinsFn(ctx, fromAddr, toAddr, amt)
 }
 
+func (m bankKeeperMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if m.BurnCoinsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BurnCoinsFn(ctx, moduleName, amt)
+}
+
 func captureNextHandlerCall() (sdk.AnteHandler, *bool) {
 	var called bool
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -146,10 +146,26 @@ func (m twasmKeeperMock) GetContractKeeper() wasmtypes.ContractOpsKeeper {
 	panic("implement me")
 }
 
+func (m twasmKeeperMock) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	panic("implement me")
+}
+
 func (m twasmKeeperMock) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 	panic("implement me")
 }
 
+func (m twasmKeeperMock) GetCallbackGasLimit(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+	panic("implement me")
+}
+
+func (m twasmKeeperMock) TrackCallbackFailure(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	panic("implement me")
+}
+
+func (m twasmKeeperMock) ResetCallbackFailures(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, contractAddr sdk.AccAddress) {
+	panic("implement me")
+}
+
 func (m twasmKeeperMock) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	if m.QuerySmartFn == nil {
 		panic("not expected to be called")
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -34,7 +35,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryUnbondingPeriod(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryHistoricalInfos(),
 		GetCmdQueryValidatorReward(),
+		GetCmdQuerySigningInfo(),
+		GetCmdQueryValsetEpoch(),
+		GetCmdQueryValsetConfig(),
+		GetCmdQueryNextValidatorSet(),
+		GetCmdQuerySlashings(),
+		GetCmdQueryEngagementPoints(),
+		GetCmdQueryEngagementMembers(),
+		GetCmdQueryEngagementHalflife(),
+		GetCmdQueryMixedPoints(),
+		GetCmdQueryMixerConfig(),
 	)
 	return queryCmd
 }
@@ -105,6 +117,84 @@ func GetCmdQueryUnbondingPeriod() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryValsetEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch",
+		Short: "Query the current valset epoch and the time of the next validator set update",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValsetEpoch(
+				cmd.Context(),
+				&types.QueryValsetEpochRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryValsetConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-config",
+		Short: "Query the valset contract configuration",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValsetConfig(
+				cmd.Context(),
+				&types.QueryValsetConfigRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryNextValidatorSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-valset",
+		Short: "Query the simulated active validator set of the next epoch",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateNextValidatorSet(
+				cmd.Context(),
+				&types.QuerySimulateNextValidatorSetRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -241,8 +331,106 @@ $ %s query poe historical-info 5
 	return cmd
 }
 
+// GetCmdQueryHistoricalInfos implements the historical infos range query command
+func GetCmdQueryHistoricalInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-infos [min-height] [max-height]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Query stored historical infos within a range of heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query stored historical infos within a range of heights. Unbounded when omitted.
+
+Example:
+$ %s query poe historical-infos 5 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var heights [2]int64
+			for i, a := range args {
+				heights[i], err = strconv.ParseInt(a, 10, 64)
+				if err != nil || heights[i] < 0 {
+					return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+				}
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryHistoricalInfosRequest{MinHeight: heights[0], MaxHeight: heights[1], Pagination: pageReq}
+			res, err := queryClient.HistoricalInfos(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddPaginationFlagsToCmd(cmd, "historical infos")
+
+	return cmd
+}
+
 // GetCmdQueryValidatorDelegation implements the command to query the
 // self delegation of a specific validator.
+// GetCmdQuerySigningInfo implements the command to query the liveness data of a validator.
+func GetCmdQuerySigningInfo() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "signing-info [validator-conspub/address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the missed blocks of a validator within the signed blocks window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the missed blocks of a validator within the signed blocks window.
+
+Example:
+$ %s query poe signing-info '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+$ %s query poe signing-info %s1...
+`,
+				version.AppName, version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				var pk cryptotypes.PubKey
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+					return err
+				}
+				consAddr = sdk.ConsAddress(pk.Address())
+			}
+
+			params := &types.QuerySigningInfoRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.SigningInfo(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryValidatorDelegation() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -468,3 +656,226 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	}
 	return flagSet
 }
+
+// GetCmdQuerySlashings implements the slashings query command.
+func GetCmdQuerySlashings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashings",
+		Args:  cobra.NoArgs,
+		Short: "Query the slashings of all validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recorded slashings of all validators ordered by height.
+
+Example:
+$ %s query poe slashings
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Slashings(cmd.Context(), &types.QuerySlashingsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddPaginationFlagsToCmd(cmd, "slashings")
+
+	return cmd
+}
+
+// GetCmdQueryEngagementPoints implements the engagement points query command.
+func GetCmdQueryEngagementPoints() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "engagement-points [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the engagement points of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the engagement points of an address.
+
+Example:
+$ %s query poe engagement-points %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EngagementPoints(cmd.Context(), &types.QueryEngagementPointsRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEngagementMembers implements the engagement members query command.
+func GetCmdQueryEngagementMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engagement-members",
+		Args:  cobra.NoArgs,
+		Short: "Query the members of the engagement contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members of the engagement contract with their points ordered by address.
+
+Example:
+$ %s query poe engagement-members --limit 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListEngagementMembers(cmd.Context(), &types.QueryListEngagementMembersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddPaginationFlagsToCmd(cmd, "engagement members")
+
+	return cmd
+}
+
+// GetCmdQueryEngagementHalflife implements the engagement halflife query command.
+func GetCmdQueryEngagementHalflife() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engagement-halflife",
+		Short: "Query the halflife settings of the engagement contract",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EngagementHalflife(
+				cmd.Context(),
+				&types.QueryEngagementHalflifeRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMixedPoints implements the mixed points query command.
+func GetCmdQueryMixedPoints() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "mixed-points [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the mixed PoE points of an address that decide the validator power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mixed PoE points of an address. They are calculated from the staked tokens and the
+engagement points and decide the validator power.
+
+Example:
+$ %s query poe mixed-points %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MixedPoints(cmd.Context(), &types.QueryMixedPointsRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMixerConfig implements the mixer configuration query command.
+func GetCmdQueryMixerConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mixer-config",
+		Short: "Query the mixer contract configuration",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MixerConfig(
+				cmd.Context(),
+				&types.QueryMixerConfigRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+const (
+	flagGenesisFile     = "genesis-file"
+	flagFees            = "fees"
+	flagTotalPoints     = "total-points"
+	flagTotalEngagement = "total-engagement"
+)
+
+// rewardSimulationConfig is the PoE setup that is used to project points and rewards
+type rewardSimulationConfig struct {
+	sigmoid        types.MixerContractConfig_Sigmoid
+	tokensPerPoint uint64
+	epochLength    time.Duration
+	rewardSplit    types.EpochRewardSplit
+	// totalPoints sum of the mixed points of all validators
+	totalPoints uint64
+	// totalEngagement sum of the engagement points of all members
+	totalEngagement uint64
+}
+
+// RewardsProjection result of the rewards simulation
+type RewardsProjection struct {
+	StakePoints           uint64   `json:"stake_points" yaml:"stake_points"`
+	EngagementPoints      uint64   `json:"engagement_points" yaml:"engagement_points"`
+	MixedPoints           uint64   `json:"mixed_points" yaml:"mixed_points"`
+	TotalMixedPoints      uint64   `json:"total_mixed_points" yaml:"total_mixed_points"`
+	TotalEngagementPoints uint64   `json:"total_engagement_points" yaml:"total_engagement_points"`
+	EpochLength           string   `json:"epoch_length" yaml:"epoch_length"`
+	EpochReward           sdk.Coin `json:"epoch_reward" yaml:"epoch_reward"`
+	ValidatorReward       sdk.Coin `json:"validator_reward" yaml:"validator_reward"`
+	EngagementReward      sdk.Coin `json:"engagement_reward" yaml:"engagement_reward"`
+}
+
+// NewSimulateRewardsCmd returns a command to project the mixed points and epoch rewards for a stake and engagement
+func NewSimulateRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-rewards [stake] [engagement-points]",
+		Short: "Project the mixed points and per-epoch rewards for a stake and engagement points",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Project the mixed points and the per-epoch rewards of a validator with the given stake and
+engagement points. The points are calculated with the mixer sigmoid function and the rewards with the valset reward
+split.
+
+The config is read from the seed contracts of a genesis file when --%s is set, otherwise from the node.
+The network totals are increased by the simulated points as for a new validator unless they are set by flags.
+
+Example:
+$ %s poe simulate-rewards 100000000utgd 1000 --%s 10000utgd
+$ %s poe simulate-rewards 100000000utgd 1000 --%s ~/.tgrade/config/genesis.json
+`,
+				flagGenesisFile, version.AppName, flagFees, version.AppName, flagGenesisFile,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stake, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "stake")
+			}
+			engagement, err := sdk.ParseUint(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "engagement points")
+			}
+
+			var (
+				clientCtx client.Context
+				config    *rewardSimulationConfig
+			)
+			if genesisFile, _ := cmd.Flags().GetString(flagGenesisFile); genesisFile != "" {
+				clientCtx = client.GetClientContextFromCmd(cmd)
+				config, err = readGenesisRewardSimulationConfig(clientCtx, genesisFile)
+			} else {
+				clientCtx, err = client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
+				config, err = queryRewardSimulationConfig(cmd, clientCtx)
+			}
+			if err != nil {
+				return err
+			}
+
+			fees := sdk.NewCoin(config.rewardSplit.EpochReward.Denom, sdk.ZeroInt())
+			if feesArg, _ := cmd.Flags().GetString(flagFees); feesArg != "" {
+				if fees, err = sdk.ParseCoinNormalized(feesArg); err != nil {
+					return sdkerrors.Wrap(err, "fees")
+				}
+			}
+			if fees.Denom != config.rewardSplit.EpochReward.Denom {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fees must be in %s", config.rewardSplit.EpochReward.Denom)
+			}
+			stakePoints := stake.Amount.QuoRaw(int64(config.tokensPerPoint)).Uint64()
+			mixedPoints := config.sigmoid.MixedPoints(stakePoints, engagement.Uint64())
+
+			totalPoints := config.totalPoints + mixedPoints
+			if v, _ := cmd.Flags().GetUint64(flagTotalPoints); v != 0 {
+				totalPoints = v
+			}
+			totalEngagement := config.totalEngagement + engagement.Uint64()
+			if v, _ := cmd.Flags().GetUint64(flagTotalEngagement); v != 0 {
+				totalEngagement = v
+			}
+			rewards := config.rewardSplit.Split(fees.Amount)
+			return clientCtx.PrintObjectLegacy(RewardsProjection{
+				StakePoints:           stakePoints,
+				EngagementPoints:      engagement.Uint64(),
+				MixedPoints:           mixedPoints,
+				TotalMixedPoints:      totalPoints,
+				TotalEngagementPoints: totalEngagement,
+				EpochLength:           config.epochLength.String(),
+				EpochReward:           rewards.Total,
+				ValidatorReward:       types.ShareOf(rewards.Validators, mixedPoints, totalPoints),
+				EngagementReward:      types.ShareOf(rewards.Engagement, engagement.Uint64(), totalEngagement),
+			})
+		},
+	}
+	cmd.Flags().String(flagGenesisFile, "", "Read the config from the seed contracts of this genesis file instead of the node")
+	cmd.Flags().String(flagFees, "", "Fees collected per epoch")
+	cmd.Flags().Uint64(flagTotalPoints, 0, "Total mixed points of all validators including the simulated one")
+	cmd.Flags().Uint64(flagTotalEngagement, 0, "Total engagement points of all members including the simulated one")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readGenesisRewardSimulationConfig reads the config from the seed contracts setup. The totals are calculated from
+// the engagement members and the gentxs.
+func readGenesisRewardSimulationConfig(clientCtx client.Context, genesisFile string) (*rewardSimulationConfig, error) {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(genesisFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+	seed := types.GetGenesisStateFromAppState(clientCtx.Codec, appState).GetSeedContracts()
+	if seed == nil {
+		return nil, sdkerrors.ErrNotSupported.Wrap("in state dump import mode")
+	}
+	if seed.StakeContractConfig == nil || seed.ValsetContractConfig == nil || seed.MixerContractConfig == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "contract config")
+	}
+	r := rewardSimulationConfig{
+		sigmoid:        seed.MixerContractConfig.Sigmoid,
+		tokensPerPoint: seed.StakeContractConfig.TokensPerPoint,
+		epochLength:    seed.ValsetContractConfig.EpochLength,
+		rewardSplit:    types.NewEpochRewardSplit(*seed.ValsetContractConfig),
+	}
+	engagements := make(map[string]uint64, len(seed.Engagement))
+	for _, m := range seed.Engagement {
+		engagements[m.Address] = m.Points
+		r.totalEngagement += m.Points
+	}
+	for i, v := range seed.GenTxs {
+		tx, err := clientCtx.TxConfig.TxJSONDecoder()(v)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "gentx %d", i)
+		}
+		for _, msg := range tx.GetMsgs() {
+			createMsg, ok := msg.(*types.MsgCreateValidator)
+			if !ok {
+				continue
+			}
+			stake := createMsg.Amount.Amount.Add(createMsg.VestingAmount.Amount)
+			stakePoints := stake.QuoRaw(int64(r.tokensPerPoint)).Uint64()
+			r.totalPoints += r.sigmoid.MixedPoints(stakePoints, engagements[createMsg.OperatorAddress])
+		}
+	}
+	return &r, nil
+}
+
+// queryRewardSimulationConfig queries the config from the PoE contracts
+func queryRewardSimulationConfig(cmd *cobra.Command, clientCtx client.Context) (*rewardSimulationConfig, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	wasmQueryClient := wasmtypes.NewQueryClient(clientCtx)
+	rawQuery := func(ctype types.PoEContractType, key string, result interface{}) error {
+		addrRes, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: ctype})
+		if err != nil {
+			return err
+		}
+		res, err := wasmQueryClient.RawContractState(cmd.Context(), &wasmtypes.QueryRawContractStateRequest{
+			Address:   addrRes.Address,
+			QueryData: []byte(key),
+		})
+		if err != nil {
+			return err
+		}
+		return sdkerrors.Wrapf(json.Unmarshal(res.Data, result), "%s %s", ctype, key)
+	}
+
+	var stakeConfig struct {
+		TokensPerPoint uint64 `json:"tokens_per_point,string"`
+	}
+	if err := rawQuery(types.PoEContractTypeStaking, "config", &stakeConfig); err != nil {
+		return nil, err
+	}
+	if stakeConfig.TokensPerPoint == 0 {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "tokens per point")
+	}
+	var totalEngagement uint64
+	if err := rawQuery(types.PoEContractTypeEngagement, "total", &totalEngagement); err != nil {
+		return nil, err
+	}
+	engagementAddr, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeEngagement})
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := queryClient.ValsetEpoch(cmd.Context(), &types.QueryValsetEpochRequest{})
+	if err != nil {
+		return nil, err
+	}
+	valsetConfig, err := queryClient.ValsetConfig(cmd.Context(), &types.QueryValsetConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	mixerConfig, err := queryClient.MixerConfig(cmd.Context(), &types.QueryMixerConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if mixerConfig.Sigmoid == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "mixer function type %q", mixerConfig.FunctionType)
+	}
+
+	// the rewards that are not sent to the distribution contracts go to the validators
+	split := types.EpochRewardSplit{
+		EpochReward:              valsetConfig.EpochReward,
+		FeePercentage:            valsetConfig.FeePercentage,
+		ValidatorRewardRatio:     sdk.OneDec(),
+		EngagementRewardRatio:    sdk.ZeroDec(),
+		CommunityPoolRewardRatio: sdk.ZeroDec(),
+	}
+	for _, v := range valsetConfig.DistributionContracts {
+		if v.Address == engagementAddr.Address {
+			split.EngagementRewardRatio = split.EngagementRewardRatio.Add(v.Ratio)
+		} else {
+			split.CommunityPoolRewardRatio = split.CommunityPoolRewardRatio.Add(v.Ratio)
+		}
+		split.ValidatorRewardRatio = split.ValidatorRewardRatio.Sub(v.Ratio)
+	}
+	return &rewardSimulationConfig{
+		sigmoid:         *mixerConfig.Sigmoid,
+		tokensPerPoint:  stakeConfig.TokensPerPoint,
+		epochLength:     epoch.EpochLength,
+		rewardSplit:     split,
+		totalPoints:     mixerConfig.TotalPoints,
+		totalEngagement: totalEngagement,
+	}, nil
+}
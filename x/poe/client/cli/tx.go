@@ -1,15 +1,12 @@
 package cli
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -18,11 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
-	poecontracts "github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
 )
 
@@ -446,25 +441,7 @@ $ %s tx poe unjail --from mykey
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeValset})
-			if err != nil {
-				return errors.Wrap(err, "query valset contract address")
-			}
-			nodeOperator := clientCtx.GetFromAddress()
-			unjailMsg := &poecontracts.TG4ValsetExecute{
-				Unjail: &poecontracts.UnjailMsg{},
-			}
-			unjailBz, err := json.Marshal(unjailMsg)
-			if err != nil {
-				return errors.Wrap(err, "encode msg payload")
-			}
-
-			msg := &wasmtypes.MsgExecuteContract{
-				Sender:   nodeOperator.String(),
-				Contract: res.Address,
-				Msg:      unjailBz,
-			}
+			msg := types.NewMsgUnjail(clientCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -494,8 +471,6 @@ $ %s tx poe claim-rewards --distribution --engagement
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			nodeOperator := clientCtx.GetFromAddress()
 
 			distrRewards, err := cmd.Flags().GetBool(flagDistribution)
 			if err != nil {
@@ -506,23 +481,11 @@ $ %s tx poe claim-rewards --distribution --engagement
 				return err
 			}
 
-			msgs := []sdk.Msg{}
-			if distrRewards || !(distrRewards || engRewards) {
-				distrMsg, err := buildDistributionWithdrawRewardsMsgExecute(cmd.Context(), queryClient, nodeOperator.String())
-				if err != nil {
-					return err
-				}
-				msgs = append(msgs, distrMsg)
-			}
-
-			if engRewards || !(distrRewards || engRewards) {
-				engMsg, err := buildEngagementWithdrawRewardsMsgExecute(cmd.Context(), queryClient, nodeOperator.String())
-				if err != nil {
-					return err
-				}
-				msgs = append(msgs, engMsg)
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress(), distrRewards, engRewards)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -546,32 +509,12 @@ $ %s tx poe set-withdraw-address tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf`,
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeEngagement})
-			if err != nil {
-				return errors.Wrap(err, "query engagement contract address")
-			}
-			nodeOperator := clientCtx.GetFromAddress()
 
 			delegateAddress, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-			delegateMsg := &poecontracts.TG4EngagementExecute{
-				DelegateWithdrawal: &poecontracts.DelegateWithdrawalMsg{
-					Delegated: delegateAddress.String(),
-				},
-			}
-			delegateBz, err := json.Marshal(delegateMsg)
-			if err != nil {
-				return errors.Wrap(err, "encode msg payload")
-			}
-
-			msg := &wasmtypes.MsgExecuteContract{
-				Sender:   nodeOperator.String(),
-				Contract: res.Address,
-				Msg:      delegateBz,
-			}
+			msg := types.NewMsgSetWithdrawAddress(clientCtx.GetFromAddress(), delegateAddress)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -582,53 +525,3 @@ $ %s tx poe set-withdraw-address tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf`,
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
-
-func buildDistributionWithdrawRewardsMsgExecute(ctx context.Context, queryClient types.QueryClient, sender string) (*wasmtypes.MsgExecuteContract, error) {
-	res, err := queryClient.ContractAddress(ctx, &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeDistribution})
-	if err != nil {
-		return nil, errors.Wrap(err, "query distribution contract address")
-	}
-	withdrawRewardsMsg := &poecontracts.TrustedCircleExecute{
-		WithdrawRewards: &struct{}{},
-	}
-	withdrawRewardsBz, err := json.Marshal(withdrawRewardsMsg)
-	if err != nil {
-		return nil, errors.Wrap(err, "encode msg payload")
-	}
-
-	msg := &wasmtypes.MsgExecuteContract{
-		Sender:   sender,
-		Contract: res.Address,
-		Msg:      withdrawRewardsBz,
-	}
-	if err := msg.ValidateBasic(); err != nil {
-		return nil, err
-	}
-
-	return msg, nil
-}
-
-func buildEngagementWithdrawRewardsMsgExecute(ctx context.Context, queryClient types.QueryClient, sender string) (*wasmtypes.MsgExecuteContract, error) {
-	res, err := queryClient.ContractAddress(ctx, &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeEngagement})
-	if err != nil {
-		return nil, errors.Wrap(err, "query engagement contract address")
-	}
-	withdrawRewardsMsg := &poecontracts.TG4EngagementExecute{
-		WithdrawRewards: &poecontracts.WithdrawRewardsMsg{},
-	}
-	withdrawRewardsBz, err := json.Marshal(withdrawRewardsMsg)
-	if err != nil {
-		return nil, errors.Wrap(err, "encode msg payload")
-	}
-
-	msg := &wasmtypes.MsgExecuteContract{
-		Sender:   sender,
-		Contract: res.Address,
-		Msg:      withdrawRewardsBz,
-	}
-	if err := msg.ValidateBasic(); err != nil {
-		return nil, err
-	}
-
-	return msg, nil
-}
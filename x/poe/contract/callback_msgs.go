@@ -20,6 +20,17 @@ type EndWithValidatorUpdateResponse struct {
 	Diffs []ValidatorUpdate `json:"diffs"`
 }
 
+// ValidatorSetObserverSudoMsg callback message sent to validator set observer contracts
+type ValidatorSetObserverSudoMsg struct {
+	// This is delivered in the end block after the validator set updater contract returned a non empty diff.
+	ValidatorSetUpdate *ValidatorSetUpdate `json:"validator_set_update,omitempty"`
+}
+
+// ValidatorSetUpdate contains the validator set diff that is applied in this block
+type ValidatorSetUpdate struct {
+	Diffs []ValidatorUpdate `json:"diffs"`
+}
+
 // ValidatorUpdate  is used to update the validator set
 // See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L343-L346
 type ValidatorUpdate struct {
@@ -167,6 +167,16 @@ func BondDelegation(ctx sdk.Context, contractAddr sdk.AccAddress, operatorAddres
 	return sdkerrors.Wrap(err, "execute contract")
 }
 
+// ClaimUnbondedTokens releases the matured claims of the owner from the staking contract back to the owner
+func ClaimUnbondedTokens(ctx sdk.Context, contractAddr sdk.AccAddress, ownerAddress sdk.AccAddress, k types.Executor) error {
+	payloadBz, err := json.Marshal(TG4StakeExecute{Claim: &struct{}{}})
+	if err != nil {
+		return sdkerrors.Wrap(err, "serialize payload msg")
+	}
+	_, err = k.Execute(ctx, contractAddr, ownerAddress, payloadBz, nil)
+	return sdkerrors.Wrap(err, "execute contract")
+}
+
 // SetEngagementPoints set engagement points  If the member already exists, its weight will be reset to the weight sent here
 func SetEngagementPoints(ctx sdk.Context, contractAddr sdk.AccAddress, k types.Sudoer, opAddr sdk.AccAddress, points uint64) error {
 	msg := TG4EngagementSudoMsg{
@@ -200,6 +210,37 @@ func ConvertToTendermintPubKey(key ValidatorPubkey) (crypto.PublicKey, error) {
 	}
 }
 
+// ConvertFromTendermintPubKey converts a tendermint public key into the contract representation
+func ConvertFromTendermintPubKey(key crypto.PublicKey) (ValidatorPubkey, error) {
+	switch k := key.Sum.(type) {
+	case *crypto.PublicKey_Ed25519:
+		return ValidatorPubkey{Ed25519: k.Ed25519}, nil
+	case *crypto.PublicKey_Secp256K1:
+		return ValidatorPubkey{Secp256k1: k.Secp256K1}, nil
+	default:
+		return ValidatorPubkey{}, types.ErrValidatorPubKeyTypeNotSupported
+	}
+}
+
+// NewValidatorUpdates converts the abci validator updates into the contract representation
+func NewValidatorUpdates(updates []abci.ValidatorUpdate) ([]ValidatorUpdate, error) {
+	result := make([]ValidatorUpdate, len(updates))
+	for i, v := range updates {
+		pub, err := ConvertFromTendermintPubKey(v.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		if v.Power < 0 {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "negative power")
+		}
+		result[i] = ValidatorUpdate{
+			PubKey: pub,
+			Power:  uint64(v.Power),
+		}
+	}
+	return result, nil
+}
+
 // BaseContractAdapter is the base contract adapter type that contains common methods to interact with the contract
 type BaseContractAdapter struct {
 	contractAddr     sdk.AccAddress
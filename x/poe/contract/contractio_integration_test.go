@@ -177,6 +177,53 @@ func TestUnbondDelegation(t *testing.T) {
 	}
 }
 
+func TestClaimUnbondedTokens(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+	stakingContractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	require.NoError(t, err)
+	myOperatorAddr, _ := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	unbondAmount := sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(10))
+	completionTime, err := contract.UnbondDelegation(ctx, stakingContractAddr, myOperatorAddr, unbondAmount, example.TWasmKeeper.GetContractKeeper())
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		blockTime  time.Time
+		expBalance sdk.Int
+		expErr     bool
+	}{
+		"matured claim": {
+			blockTime:  *completionTime,
+			expBalance: unbondAmount.Amount,
+		},
+		"pending claim": {
+			blockTime: completionTime.Add(-time.Second),
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.WithBlockTime(spec.blockTime).CacheContext()
+			balanceBefore := example.BankKeeper.GetBalance(ctx, myOperatorAddr, types.DefaultBondDenom)
+
+			// when
+			gotErr := contract.ClaimUnbondedTokens(ctx, stakingContractAddr, myOperatorAddr, example.TWasmKeeper.GetContractKeeper())
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			balanceAfter := example.BankKeeper.GetBalance(ctx, myOperatorAddr, types.DefaultBondDenom)
+			assert.Equal(t, spec.expBalance.String(), balanceAfter.Amount.Sub(balanceBefore.Amount).String())
+		})
+	}
+}
+
 // make vesting account with current balance as vested amount
 func convertToFullVestingAccount(t *testing.T, example keeper.TestKeepers, ctx sdk.Context, addr sdk.AccAddress) {
 	vestingtypes.RegisterInterfaces(example.EncodingConfig.InterfaceRegistry)
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 )
 
@@ -51,3 +52,49 @@ func TestConvertToTendermintPubKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNewValidatorUpdates(t *testing.T) {
+	var (
+		ed25519pubkeybz   = ed25519.GenPrivKey().PubKey().Bytes()
+		secp256k1pubkeybz = secp256k1.GenPrivKey().PubKey().Bytes()
+	)
+	specs := map[string]struct {
+		src    []abci.ValidatorUpdate
+		exp    []ValidatorUpdate
+		expErr bool
+	}{
+		"ed25519 and secp256k1": {
+			src: []abci.ValidatorUpdate{
+				{PubKey: crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: ed25519pubkeybz}}, Power: 1},
+				{PubKey: crypto.PublicKey{Sum: &crypto.PublicKey_Secp256K1{Secp256K1: secp256k1pubkeybz}}, Power: 0},
+			},
+			exp: []ValidatorUpdate{
+				{PubKey: ValidatorPubkey{Ed25519: ed25519pubkeybz}, Power: 1},
+				{PubKey: ValidatorPubkey{Secp256k1: secp256k1pubkeybz}, Power: 0},
+			},
+		},
+		"empty": {
+			src: []abci.ValidatorUpdate{},
+			exp: []ValidatorUpdate{},
+		},
+		"unsupported pubkey": {
+			src:    []abci.ValidatorUpdate{{Power: 1}},
+			expErr: true,
+		},
+		"negative power": {
+			src:    []abci.ValidatorUpdate{{PubKey: crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: ed25519pubkeybz}}, Power: -1}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := NewValidatorUpdates(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotRes)
+		})
+	}
+}
@@ -65,7 +65,7 @@ type TG4MemberResponse struct {
 }
 
 type TG4TotalPointsResponse struct {
-	Points int `json:"total_points"`
+	Points int `json:"points"`
 }
 
 func QueryTG4MembersByWeight(ctx sdk.Context, k types.SmartQuerier, tg4Addr sdk.AccAddress, pagination *Paginator) ([]TG4Member, error) {
@@ -134,3 +134,15 @@ func QueryTG4Admin(ctx sdk.Context, k types.SmartQuerier, tg4Addr sdk.AccAddress
 type TG4UpdateAdminMsg struct {
 	NewAdmin *string `json:"admin,omitempty"`
 }
+
+// TG4SlasherExecute slasher messages supported by the tg4 contracts
+// See https://github.com/confio/poe-contracts/blob/v0.14.0/packages/utils/src/slashers.rs
+type TG4SlasherExecute struct {
+	Slash *SlashMsg `json:"slash,omitempty"`
+}
+
+// SlashMsg slashes the given portion of the member's points
+type SlashMsg struct {
+	Addr    string  `json:"addr"`
+	Portion sdk.Dec `json:"portion"`
+}
@@ -98,6 +98,22 @@ func (a EngagementContractAdapter) UpdateAdmin(ctx sdk.Context, newAdmin, sender
 	return a.doExecute(ctx, msg, sender)
 }
 
+// WithdrawRewards claims the rewards of the sender
+func (a EngagementContractAdapter) WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error {
+	msg := TG4EngagementExecute{
+		WithdrawRewards: &WithdrawRewardsMsg{},
+	}
+	return a.doExecute(ctx, msg, sender)
+}
+
+// DelegateWithdrawal sets the address that is allowed to withdraw the rewards of the sender
+func (a EngagementContractAdapter) DelegateWithdrawal(ctx sdk.Context, sender, delegated sdk.AccAddress) error {
+	msg := TG4EngagementExecute{
+		DelegateWithdrawal: &DelegateWithdrawalMsg{Delegated: delegated.String()},
+	}
+	return a.doExecute(ctx, msg, sender)
+}
+
 // EngagementQuery will create many queries for the engagement contract
 // See https://github.com/confio/poe-contracts/blob/v0.5.3-2/contracts/tg4-engagement/src/msg.rs#L77-L123
 type EngagementQuery struct {
@@ -166,3 +182,52 @@ func (a EngagementContractAdapter) QueryWithdrawableRewards(ctx sdk.Context, add
 	}
 	return resp.Rewards, err
 }
+
+// HalflifeResponse response to a halflife query
+type HalflifeResponse struct {
+	// HalflifeInfo is nil when the halflife is disabled
+	HalflifeInfo *HalflifeInfo `json:"halflife_info,omitempty"`
+}
+
+type HalflifeInfo struct {
+	// LastHalflife block time in nanoseconds
+	LastHalflife uint64 `json:"last_halflife,string"`
+	// Halflife is measured in seconds
+	Halflife uint64 `json:"halflife"`
+	// NextHalflife block time in nanoseconds
+	NextHalflife uint64 `json:"next_halflife,string"`
+}
+
+// QueryMember returns the points of the member. nil means not a member
+func (a EngagementContractAdapter) QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+	if err := a.addressLookupErr; err != nil {
+		return nil, err
+	}
+	return QueryTG4Member(ctx, a.twasmKeeper, a.contractAddr, addr)
+}
+
+// ListMembers returns a page of members ordered by address and the cursor to the next page
+func (a EngagementContractAdapter) ListMembers(ctx sdk.Context, pagination *Paginator) ([]TG4Member, PaginationCursor, error) {
+	if err := a.addressLookupErr; err != nil {
+		return nil, nil, err
+	}
+	members, err := QueryTG4Members(ctx, a.twasmKeeper, a.contractAddr, pagination)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "contract query")
+	}
+	if len(members) == 0 {
+		return members, nil, nil
+	}
+	// always return the cursor and let the client figure out if they want to do another call
+	return members, PaginationCursor(members[len(members)-1].Addr), nil
+}
+
+// QueryHalflife returns the halflife settings
+func (a EngagementContractAdapter) QueryHalflife(ctx sdk.Context) (*HalflifeResponse, error) {
+	query := EngagementQuery{Halflife: &struct{}{}}
+	var rsp HalflifeResponse
+	if err := a.doQuery(ctx, query, &rsp); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract query")
+	}
+	return &rsp, nil
+}
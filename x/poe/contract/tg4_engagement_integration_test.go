@@ -3,7 +3,9 @@ package contract_test
 import (
 	_ "embed"
 	"encoding/json"
+	"sort"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -161,3 +163,63 @@ func TestQueryWithdrawableRewards(t *testing.T) {
 		})
 	}
 }
+
+func TestEngagementQueryMembers(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, engagements := setupPoEContracts(t)
+
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+	adapter := contract.NewEngagementContractAdapter(contractAddr, example.TWasmKeeper, nil)
+
+	sort.Slice(engagements, func(i, j int) bool {
+		return engagements[i].Address < engagements[j].Address
+	})
+	// when
+	myAddr, err := sdk.AccAddressFromBech32(engagements[0].Address)
+	require.NoError(t, err)
+	gotPoints, err := adapter.QueryMember(ctx, myAddr)
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, gotPoints)
+	assert.Equal(t, int(engagements[0].Points), *gotPoints)
+
+	// and unknown address
+	gotPoints, err = adapter.QueryMember(ctx, rand.Bytes(address.Len))
+	require.NoError(t, err)
+	assert.Nil(t, gotPoints)
+
+	// when paginated
+	var got []types.TG4Member
+	var cursor contract.PaginationCursor
+	for i := 0; i < len(engagements); i++ {
+		members, next, err := adapter.ListMembers(ctx, &contract.Paginator{StartAfter: cursor, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		got = append(got, types.TG4Member{Address: members[0].Addr, Points: members[0].Points})
+		cursor = next
+	}
+	// then
+	assert.Equal(t, engagements, got)
+	members, _, err := adapter.ListMembers(ctx, &contract.Paginator{StartAfter: cursor, Limit: 1})
+	require.NoError(t, err)
+	assert.Empty(t, members)
+}
+
+func TestEngagementQueryHalflife(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t)
+
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+
+	// when
+	gotRsp, err := contract.NewEngagementContractAdapter(contractAddr, example.TWasmKeeper, nil).QueryHalflife(ctx)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, gotRsp.HalflifeInfo)
+	expHalflife := types.DefaultGenesisState().GetSeedContracts().EngagementContractConfig.Halflife
+	assert.Equal(t, uint64(expHalflife/time.Second), gotRsp.HalflifeInfo.Halflife)
+	assert.Equal(t, uint64(expHalflife.Nanoseconds()), gotRsp.HalflifeInfo.NextHalflife-gotRsp.HalflifeInfo.LastHalflife)
+}
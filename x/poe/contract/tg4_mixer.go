@@ -1,7 +1,12 @@
 package contract
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/poe/types"
 )
 
 // TG4MixerInitMsg contract init message
@@ -14,6 +19,9 @@ type TG4MixerInitMsg struct {
 	FunctionType     MixerFunction `json:"function_type"`
 }
 
+// MixerFunctionKey is the raw state key of the mixer function in the mixer contract
+const MixerFunctionKey = "poe-function-type"
+
 type MixerFunction struct {
 	GeometricMean    *struct{}         `json:"geometric_mean,omitempty"`
 	Sigmoid          *Sigmoid          `json:"sigmoid,omitempty"`
@@ -21,6 +29,22 @@ type MixerFunction struct {
 	AlgebraicSigmoid *AlgebraicSigmoid `json:"algebraic_sigmoid,omitempty"`
 }
 
+// Name returns the name of the function type
+func (f MixerFunction) Name() string {
+	switch {
+	case f.GeometricMean != nil:
+		return "geometric_mean"
+	case f.Sigmoid != nil:
+		return "sigmoid"
+	case f.SigmoidSqrt != nil:
+		return "sigmoid_sqrt"
+	case f.AlgebraicSigmoid != nil:
+		return "algebraic_sigmoid"
+	default:
+		return ""
+	}
+}
+
 type Sigmoid struct {
 	MaxPoints uint64  `json:"max_points,string"`
 	P         sdk.Dec `json:"p"`
@@ -38,3 +62,74 @@ type AlgebraicSigmoid struct {
 	P         sdk.Dec `json:"p"`
 	S         sdk.Dec `json:"s"`
 }
+
+// TG4MixerQuery mixer specific queries
+// See https://github.com/confio/poe-contracts/blob/v0.14.0/contracts/tg4-mixer/src/msg.rs
+type TG4MixerQuery struct {
+	Groups *struct{} `json:"groups,omitempty"`
+}
+
+// MixerGroupsResponse response to a groups query
+type MixerGroupsResponse struct {
+	// Left is the engagement contract address
+	Left string `json:"left"`
+	// Right is the staking contract address
+	Right string `json:"right"`
+}
+
+type MixerContractAdapter struct {
+	BaseContractAdapter
+}
+
+// NewMixerContractAdapter constructor
+func NewMixerContractAdapter(contractAddr sdk.AccAddress, twasmKeeper types.TWasmKeeper, addressLookupErr error) *MixerContractAdapter {
+	return &MixerContractAdapter{
+		BaseContractAdapter: NewBaseContractAdapter(
+			contractAddr,
+			twasmKeeper,
+			addressLookupErr,
+		),
+	}
+}
+
+// QueryMember returns the mixed points of the member. nil means not a member
+func (a MixerContractAdapter) QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+	if err := a.addressLookupErr; err != nil {
+		return nil, err
+	}
+	return QueryTG4Member(ctx, a.twasmKeeper, a.contractAddr, addr)
+}
+
+// QueryTotalPoints returns the sum of the mixed points of all members
+func (a MixerContractAdapter) QueryTotalPoints(ctx sdk.Context) (int, error) {
+	if err := a.addressLookupErr; err != nil {
+		return 0, err
+	}
+	return QueryTG4TotalPoints(ctx, a.twasmKeeper, a.contractAddr)
+}
+
+// QueryGroups returns the addresses of the mixed groups
+func (a MixerContractAdapter) QueryGroups(ctx sdk.Context) (*MixerGroupsResponse, error) {
+	query := TG4MixerQuery{Groups: &struct{}{}}
+	var rsp MixerGroupsResponse
+	if err := a.doQuery(ctx, query, &rsp); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract query")
+	}
+	return &rsp, nil
+}
+
+// QueryFunction returns the function that mixes the stake and engagement points
+func (a MixerContractAdapter) QueryFunction(ctx sdk.Context) (*MixerFunction, error) {
+	if err := a.addressLookupErr; err != nil {
+		return nil, err
+	}
+	bz := a.twasmKeeper.QueryRaw(ctx, a.contractAddr, []byte(MixerFunctionKey))
+	if bz == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "mixer function")
+	}
+	var rsp MixerFunction
+	if err := json.Unmarshal(bz, &rsp); err != nil {
+		return nil, sdkerrors.Wrap(err, "unmarshal result")
+	}
+	return &rsp, nil
+}
@@ -0,0 +1,93 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestMixerQueries(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	require.NoError(t, err)
+	stakingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	require.NoError(t, err)
+	engagementAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+	adapter := contract.NewMixerContractAdapter(contractAddr, example.TWasmKeeper, nil)
+
+	// when
+	gotGroups, err := adapter.QueryGroups(ctx)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &contract.MixerGroupsResponse{Left: engagementAddr.String(), Right: stakingAddr.String()}, gotGroups)
+
+	// when
+	var expTotal int
+	for _, v := range vals {
+		opAddr, err := sdk.AccAddressFromBech32(v.OperatorAddress)
+		require.NoError(t, err)
+		gotPoints, err := adapter.QueryMember(ctx, opAddr)
+		require.NoError(t, err)
+		require.NotNil(t, gotPoints)
+		assert.Greater(t, *gotPoints, 0)
+		expTotal += *gotPoints
+	}
+	gotTotal, err := adapter.QueryTotalPoints(ctx)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expTotal, gotTotal)
+
+	// and unknown address
+	gotPoints, err := adapter.QueryMember(ctx, rand.Bytes(address.Len))
+	require.NoError(t, err)
+	assert.Nil(t, gotPoints)
+}
+
+func TestMixerFunctionMatchesSigmoid(t *testing.T) {
+	ctx, example, _, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	require.NoError(t, err)
+	sigmoid := types.DefaultGenesisState().GetSeedContracts().MixerContractConfig.Sigmoid
+
+	for _, v := range [][2]uint64{{1, 1}, {100, 1000}, {1000, 100}, {5000, 2500}, {123456, 7890}, {1_000_000, 1_000_000}} {
+		stake, engagement := v[0], v[1]
+		// when
+		query := fmt.Sprintf(`{"mixer_function":{"stake":"%d","engagement":"%d"}}`, stake, engagement)
+		bz, err := example.TWasmKeeper.QuerySmart(ctx, contractAddr, []byte(query))
+		require.NoError(t, err)
+		var rsp contract.TG4TotalPointsResponse
+		require.NoError(t, json.Unmarshal(bz, &rsp))
+		// then
+		assert.Equal(t, uint64(rsp.Points), sigmoid.MixedPoints(stake, engagement), "stake %d, engagement %d", stake, engagement)
+	}
+}
+
+func TestQueryMixerFunction(t *testing.T) {
+	ctx, example, _, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	require.NoError(t, err)
+	sigmoid := types.DefaultGenesisState().GetSeedContracts().MixerContractConfig.Sigmoid
+
+	// when
+	got, err := contract.NewMixerContractAdapter(contractAddr, example.TWasmKeeper, nil).QueryFunction(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "sigmoid", got.Name())
+	require.NotNil(t, got.Sigmoid)
+	assert.Equal(t, sigmoid.MaxPoints, got.Sigmoid.MaxPoints)
+	assert.True(t, sigmoid.P.Equal(got.Sigmoid.P))
+	assert.True(t, sigmoid.S.Equal(got.Sigmoid.S))
+}
@@ -17,30 +17,40 @@ type RewardsResponse struct {
 	Rewards sdk.Coin
 }
 
+// DistributionExecute distribution contract execute messages
+type DistributionExecute struct {
+	WithdrawRewards *WithdrawRewardsMsg `json:"withdraw_rewards,omitempty"`
+}
+
 type DistributionContractAdapter struct {
-	contractAddr     sdk.AccAddress
-	contractQuerier  types.SmartQuerier
-	addressLookupErr error
+	BaseContractAdapter
 }
 
 // NewDistributionContractAdapter constructor
-func NewDistributionContractAdapter(contractAddr sdk.AccAddress, contractQuerier types.SmartQuerier, addressLookupErr error) *DistributionContractAdapter {
-	return &DistributionContractAdapter{contractAddr: contractAddr, contractQuerier: contractQuerier, addressLookupErr: addressLookupErr}
+func NewDistributionContractAdapter(contractAddr sdk.AccAddress, twasmKeeper types.TWasmKeeper, addressLookupErr error) *DistributionContractAdapter {
+	return &DistributionContractAdapter{
+		BaseContractAdapter: NewBaseContractAdapter(
+			contractAddr,
+			twasmKeeper,
+			addressLookupErr,
+		),
+	}
 }
 
 func (d DistributionContractAdapter) ValidatorOutstandingReward(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
-	if d.addressLookupErr != nil {
-		return sdk.Coin{}, d.addressLookupErr
-	}
 	query := DistributionQuery{WithdrawableRewards: &WithdrawableRewardsQuery{Owner: addr.String()}}
 	var resp RewardsResponse
-	err := doQuery(ctx, d.contractQuerier, d.contractAddr, query, &resp)
+	err := d.doQuery(ctx, query, &resp)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 	return resp.Rewards, err
 }
 
-func (d DistributionContractAdapter) Address() (sdk.AccAddress, error) {
-	return d.contractAddr, d.addressLookupErr
+// WithdrawRewards claims the rewards of the sender
+func (d DistributionContractAdapter) WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error {
+	msg := DistributionExecute{
+		WithdrawRewards: &WithdrawRewardsMsg{},
+	}
+	return d.doExecute(ctx, msg, sender)
 }
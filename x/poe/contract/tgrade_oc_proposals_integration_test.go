@@ -62,4 +62,13 @@ func TestSlashValidator(t *testing.T) {
 	// this is not always the same as half due to rounding
 	expected := *points - (*points / 2)
 	assert.Equal(t, expected, *slashed)
+
+	// and the slashing was recorded
+	slashings, _, err := example.PoEKeeper.PaginatedSlashings(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, slashings, 1)
+	assert.Equal(t, opAddr.String(), slashings[0].OperatorAddress)
+	assert.Equal(t, ctx.BlockHeight(), slashings[0].Height)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), slashings[0].Portion)
+	assert.Empty(t, slashings[0].EvidenceType)
 }
@@ -448,6 +448,34 @@ func (v ValsetContractAdapter) QueryConfig(ctx sdk.Context) (*ValsetConfigRespon
 	return &rsp, err
 }
 
+// QueryEpoch query the current epoch
+func (v ValsetContractAdapter) QueryEpoch(ctx sdk.Context) (*ValsetEpochResponse, error) {
+	if v.addressLookupErr != nil {
+		return nil, v.addressLookupErr
+	}
+	query := ValsetQuery{Epoch: &struct{}{}}
+	var rsp ValsetEpochResponse
+	err := v.doQuery(ctx, query, &rsp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract query")
+	}
+	return &rsp, err
+}
+
+// SimulateActiveValidators query the active validator set that would be applied with the next epoch
+func (v ValsetContractAdapter) SimulateActiveValidators(ctx sdk.Context) ([]ValidatorInfo, error) {
+	if v.addressLookupErr != nil {
+		return nil, v.addressLookupErr
+	}
+	query := ValsetQuery{SimulateActiveValidators: &struct{}{}}
+	var rsp ListActiveValidatorsResponse
+	err := v.doQuery(ctx, query, &rsp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract query")
+	}
+	return rsp.Validators, err
+}
+
 // UpdateAdmin sets a new admin address
 func (v ValsetContractAdapter) UpdateAdmin(ctx sdk.Context, newAdmin sdk.AccAddress, sender sdk.AccAddress) error {
 	bech32AdminAddr := newAdmin.String()
@@ -11,10 +11,13 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/rand"
 
+	"github.com/confio/tgrade/x/poe"
 	"github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
+	"github.com/confio/tgrade/x/twasm"
 )
 
 func TestQueryValidator(t *testing.T) {
@@ -215,6 +218,45 @@ func TestQueryValsetConfig(t *testing.T) {
 	assert.Equal(t, expConfig, res)
 }
 
+func TestQueryValsetEpoch(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	require.NoError(t, err)
+
+	// when
+	adapter := contract.NewValsetContractAdapter(contractAddr, example.TWasmKeeper, nil)
+	res, gotErr := adapter.QueryEpoch(ctx)
+
+	// then
+	require.NoError(t, gotErr)
+	exp, err := contract.QueryValsetEpoch(ctx, example.TWasmKeeper, contractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, exp, res)
+	assert.NotZero(t, res.EpochLength)
+}
+
+func TestSimulateActiveValidators(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	require.NoError(t, err)
+
+	// when
+	adapter := contract.NewValsetContractAdapter(contractAddr, example.TWasmKeeper, nil)
+	gotVals, gotErr := adapter.SimulateActiveValidators(ctx)
+
+	// then
+	require.NoError(t, gotErr)
+	var expVals []contract.ValidatorInfo
+	err = adapter.IterateActiveValidators(ctx, func(c contract.ValidatorInfo) bool {
+		expVals = append(expVals, c)
+		return false
+	}, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expVals, gotVals)
+}
+
 func TestJailUnjail(t *testing.T) {
 	// setup contracts and seed some data
 	ctx, example, vals, _ := setupPoEContracts(t)
@@ -330,3 +372,34 @@ func TestIterateActiveValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestSlashValidatorByEvidence(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+	opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	consAddr, err := vals[0].GetConsAddr()
+	require.NoError(t, err)
+
+	// evidence must be for a height after the validator start height
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	b := abci.RequestBeginBlock{ByzantineValidators: []abci.Evidence{{
+		Type:             abci.EvidenceType_DUPLICATE_VOTE,
+		Validator:        abci.Validator{Address: consAddr, Power: 1},
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		TotalVotingPower: 10,
+	}}}
+	// when
+	poe.BeginBlocker(ctx, example.PoEKeeper, b)
+	twasm.BeginBlocker(ctx, example.TWasmKeeper, b)
+
+	// then
+	slashings, _, err := example.PoEKeeper.PaginatedSlashings(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, slashings, 1)
+	assert.Equal(t, opAddr.String(), slashings[0].OperatorAddress)
+	assert.Equal(t, ctx.BlockHeight(), slashings[0].Height)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), slashings[0].Portion)
+	assert.Equal(t, "duplicate_vote", slashings[0].EvidenceType)
+}
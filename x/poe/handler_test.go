@@ -109,6 +109,42 @@ func TestHandler(t *testing.T) {
 			},
 			expErr: types.ErrInvalid,
 		},
+		"MsgClaimRewards": {
+			src: &types.MsgClaimRewards{},
+			mock: MsgServerMock{
+				ClaimRewardsFn: func(ctx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+					return &types.MsgClaimRewardsResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgSetWithdrawAddress": {
+			src: &types.MsgSetWithdrawAddress{},
+			mock: MsgServerMock{
+				SetWithdrawAddressFn: func(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+					return &types.MsgSetWithdrawAddressResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgUnjail": {
+			src: &types.MsgUnjail{},
+			mock: MsgServerMock{
+				UnjailFn: func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+					return &types.MsgUnjailResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgUnjail error returned": {
+			src: &types.MsgUnjail{},
+			mock: MsgServerMock{
+				UnjailFn: func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+					return nil, types.ErrInvalid
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
 		"unknown message": {
 			src:    &banktypes.MsgSend{},
 			expErr: sdkerrors.ErrUnknownRequest,
@@ -141,10 +177,13 @@ func mustMarshalProto(m *types.MsgUndelegateResponse) []byte {
 var _ types.MsgServer = MsgServerMock{}
 
 type MsgServerMock struct {
-	CreateValidatorFn func(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error)
-	UpdateValidatorFn func(ctx context.Context, msg *types.MsgUpdateValidator) (*types.MsgUpdateValidatorResponse, error)
-	DelegateFn        func(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error)
-	UndelegateFn      func(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error)
+	CreateValidatorFn    func(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error)
+	UpdateValidatorFn    func(ctx context.Context, msg *types.MsgUpdateValidator) (*types.MsgUpdateValidatorResponse, error)
+	DelegateFn           func(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error)
+	UndelegateFn         func(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error)
+	ClaimRewardsFn       func(ctx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error)
+	SetWithdrawAddressFn func(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error)
+	UnjailFn             func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error)
 }
 
 func (m MsgServerMock) CreateValidator(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
@@ -174,3 +213,24 @@ func (m MsgServerMock) Undelegate(ctx context.Context, msg *types.MsgUndelegate)
 	}
 	return m.UndelegateFn(ctx, msg)
 }
+
+func (m MsgServerMock) ClaimRewards(ctx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	if m.ClaimRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ClaimRewardsFn(ctx, msg)
+}
+
+func (m MsgServerMock) SetWithdrawAddress(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	if m.SetWithdrawAddressFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetWithdrawAddressFn(ctx, msg)
+}
+
+func (m MsgServerMock) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	if m.UnjailFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnjailFn(ctx, msg)
+}
@@ -0,0 +1,87 @@
+package poe_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestIntegrationTrackHistoricalValset(t *testing.T) {
+	specs := map[string]struct {
+		mode           types.HistoricalValsetMode
+		expStoredFirst bool
+		expStoredNext  bool
+		expResolved    bool
+	}{
+		"every block": {
+			mode:           types.HistoricalValsetModeEveryBlock,
+			expStoredFirst: true,
+			expStoredNext:  true,
+			expResolved:    true,
+		},
+		"epoch": {
+			mode:           types.HistoricalValsetModeEpoch,
+			expStoredFirst: true,
+			expResolved:    true,
+		},
+		"none": {
+			mode: types.HistoricalValsetModeNone,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example, _ := setupPoEContracts(t, func(m *types.GenesisState) {
+				m.Params.HistoricalValsetMode = spec.mode
+			})
+			k := example.PoEKeeper
+
+			valsetAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+			require.NoError(t, err)
+			epoch, err := contract.QueryValsetEpoch(ctx, example.TWasmKeeper, valsetAddr)
+			require.NoError(t, err)
+			var expPowers []int64
+			err = k.ValsetContract(ctx).IterateActiveValidators(ctx, func(c contract.ValidatorInfo) bool {
+				expPowers = append(expPowers, int64(c.Power))
+				return false
+			}, nil)
+			require.NoError(t, err)
+			require.NotEmpty(t, expPowers)
+
+			// when first entry tracked after the last valset update
+			height := int64(epoch.LastUpdateHeight) + 10
+			k.TrackHistoricalInfo(ctx.WithBlockHeight(height))
+			// and the next one
+			k.TrackHistoricalInfo(ctx.WithBlockHeight(height + 1))
+
+			// then
+			first, found := k.GetHistoricalInfo(ctx, height)
+			require.True(t, found)
+			assert.Equal(t, spec.expStoredFirst, len(first.Valset) != 0)
+			next, found := k.GetHistoricalInfo(ctx, height+1)
+			require.True(t, found)
+			assert.Equal(t, spec.expStoredNext, len(next.Valset) != 0)
+
+			resolved, found := k.GetHistoricalInfoWithValset(ctx, height+1)
+			require.True(t, found)
+			assert.Equal(t, height+1, resolved.Header.Height)
+			if !spec.expResolved {
+				assert.Empty(t, resolved.Valset)
+				return
+			}
+			require.Len(t, resolved.Valset, len(expPowers))
+			var gotPowers []int64
+			for _, v := range resolved.Valset {
+				assert.Equal(t, stakingtypes.Bonded, v.Status)
+				assert.NotEmpty(t, v.Description.Moniker)
+				gotPowers = append(gotPowers, v.ConsensusPower(sdk.DefaultPowerReduction))
+			}
+			assert.ElementsMatch(t, expPowers, gotPowers)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// StakingMessageKeeper is the subset of the PoE keeper to handle the staking and distribution messages of contracts
+type StakingMessageKeeper interface {
+	GetPoEContractAddress(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error)
+	DistributionContract(ctx sdk.Context) DistributionContract
+	EngagementContract(ctx sdk.Context) EngagementContract
+	StakeContract(ctx sdk.Context) StakeContract
+}
+
+// ContractKeeperSource provides the contract keeper. It is resolved when a message is handled as the wasm keeper
+// is not set up when the message handlers are created.
+type ContractKeeperSource interface {
+	GetContractKeeper() wasmtypes.ContractOpsKeeper
+}
+
+var _ wasmkeeper.Messenger = StakingMessageHandler{}
+
+// StakingMessageHandler maps the staking and distribution messages of contracts to the PoE contracts. As there is
+// no delegation to others in PoE, contracts can only bond to and unbond from their own address. Unbonded tokens are
+// held as claims by the staking contract; matured claims are released on a reward withdrawal.
+type StakingMessageHandler struct {
+	keeper         StakingMessageKeeper
+	contractKeeper ContractKeeperSource
+}
+
+// NewStakingMessageHandler constructor
+func NewStakingMessageHandler(keeper StakingMessageKeeper, contractKeeper ContractKeeperSource) StakingMessageHandler {
+	return StakingMessageHandler{keeper: keeper, contractKeeper: contractKeeper}
+}
+
+// DispatchMsg handles the staking and distribution messages. Other messages are rejected with ErrUnknownMsg
+func (h StakingMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	em := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(em)
+	var err error
+	switch {
+	case msg.Staking != nil && msg.Staking.Delegate != nil:
+		err = h.handleDelegate(ctx, contractAddr, msg.Staking.Delegate)
+	case msg.Staking != nil && msg.Staking.Undelegate != nil:
+		err = h.handleUndelegate(ctx, contractAddr, msg.Staking.Undelegate)
+	case msg.Staking != nil:
+		err = sdkerrors.Wrap(wasmtypes.ErrExecuteFailed, "redelegation not supported")
+	case msg.Distribution != nil && msg.Distribution.WithdrawDelegatorReward != nil:
+		err = h.handleWithdrawDelegatorReward(ctx, contractAddr, msg.Distribution.WithdrawDelegatorReward)
+	case msg.Distribution != nil && msg.Distribution.SetWithdrawAddress != nil:
+		err = h.handleSetWithdrawAddress(ctx, contractAddr, msg.Distribution.SetWithdrawAddress)
+	default:
+		return nil, nil, wasmtypes.ErrUnknownMsg
+	}
+	return em.Events(), nil, err
+}
+
+// bond tokens to the staking contract for the contract itself
+func (h StakingMessageHandler) handleDelegate(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.DelegateMsg) error {
+	if err := assertSelfDelegation(contractAddr, msg.Validator); err != nil {
+		return err
+	}
+	amount, err := wasmkeeper.ConvertWasmCoinToSdkCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	stakingContractAddr, err := h.keeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	if err != nil {
+		return sdkerrors.Wrap(err, "staking contract")
+	}
+	err = contract.BondDelegation(ctx, stakingContractAddr, contractAddr, sdk.NewCoins(amount), nil, h.contractKeeper.GetContractKeeper())
+	if err != nil {
+		return sdkerrors.Wrap(err, "bond delegation")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDelegate,
+		sdk.NewAttribute(types.AttributeKeyDelegator, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// unbond tokens of the contract from the staking contract
+func (h StakingMessageHandler) handleUndelegate(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.UndelegateMsg) error {
+	if err := assertSelfDelegation(contractAddr, msg.Validator); err != nil {
+		return err
+	}
+	amount, err := wasmkeeper.ConvertWasmCoinToSdkCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	stakingContractAddr, err := h.keeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	if err != nil {
+		return sdkerrors.Wrap(err, "staking contract")
+	}
+	if _, err := contract.UnbondDelegation(ctx, stakingContractAddr, contractAddr, amount, h.contractKeeper.GetContractKeeper()); err != nil {
+		return sdkerrors.Wrap(err, "unbond delegation")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUndelegate,
+		sdk.NewAttribute(types.AttributeKeyDelegator, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// withdraw the distribution and engagement rewards of the contract and release its matured unbonding claims
+func (h StakingMessageHandler) handleWithdrawDelegatorReward(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.WithdrawDelegatorRewardMsg) error {
+	if err := assertSelfDelegation(contractAddr, msg.Validator); err != nil {
+		return err
+	}
+	if err := h.keeper.DistributionContract(ctx).WithdrawRewards(ctx, contractAddr); err != nil {
+		return sdkerrors.Wrap(err, "withdraw distribution rewards")
+	}
+	if err := h.keeper.EngagementContract(ctx).WithdrawRewards(ctx, contractAddr); err != nil {
+		return sdkerrors.Wrap(err, "withdraw engagement rewards")
+	}
+	if err := h.releaseMaturedClaims(ctx, contractAddr); err != nil {
+		return sdkerrors.Wrap(err, "release claims")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaimRewards,
+		sdk.NewAttribute(sdk.AttributeKeySender, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, contractAddr.String()),
+	))
+	return nil
+}
+
+// claim the unbonded tokens of the contract that are past their release time. The staking contract rejects a
+// claim without any matured tokens so that it is only executed when there is something to release.
+func (h StakingMessageHandler) releaseMaturedClaims(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	stakeContract := h.keeper.StakeContract(ctx)
+	unbondings, err := stakeContract.QueryStakingUnbonding(ctx, contractAddr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "query unbondings")
+	}
+	released := sdk.ZeroInt()
+	for _, u := range unbondings {
+		if !u.CompletionTime.After(ctx.BlockTime()) {
+			released = released.Add(u.Balance)
+		}
+	}
+	if released.IsZero() {
+		return nil
+	}
+	stakingContractAddr, err := stakeContract.Address()
+	if err != nil {
+		return sdkerrors.Wrap(err, "staking contract")
+	}
+	if err := contract.ClaimUnbondedTokens(ctx, stakingContractAddr, contractAddr, h.contractKeeper.GetContractKeeper()); err != nil {
+		return sdkerrors.Wrap(err, "claim unbonded tokens")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCompleteUnbonding,
+		sdk.NewAttribute(types.AttributeKeyDelegator, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, released.String()),
+	))
+	return nil
+}
+
+// set the address that receives the engagement rewards of the contract
+func (h StakingMessageHandler) handleSetWithdrawAddress(ctx sdk.Context, contractAddr sdk.AccAddress, msg *wasmvmtypes.SetWithdrawAddressMsg) error {
+	withdrawAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "withdraw address")
+	}
+	if err := h.keeper.EngagementContract(ctx).DelegateWithdrawal(ctx, contractAddr, withdrawAddr); err != nil {
+		return sdkerrors.Wrap(err, "delegate withdrawal")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetWithdrawAddress,
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.Address),
+	))
+	return nil
+}
+
+// assertSelfDelegation returns an error when the validator is not the contract itself
+func assertSelfDelegation(contractAddr sdk.AccAddress, validator string) error {
+	valAddr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator")
+	}
+	if !valAddr.Equals(contractAddr) {
+		return sdkerrors.Wrap(types.ErrInvalid, "only self delegation supported: validator must be the contract address")
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/poe/keeper/poetesting"
+	"github.com/confio/tgrade/x/poe/types"
+	wasmtesting "github.com/confio/tgrade/x/twasm/testing"
+)
+
+func TestStakingMessageHandler(t *testing.T) {
+	var (
+		myStakingContract sdk.AccAddress = rand.Bytes(address.Len)
+		myContractAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		otherAddr         sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	myCoin := wasmvmtypes.NewCoin(1, types.DefaultBondDenom)
+	now := time.Now().UTC()
+	type capturedCalls struct {
+		distributionWithdrawals []sdk.AccAddress
+		engagementWithdrawals   []sdk.AccAddress
+		withdrawalDelegations   []sdk.AccAddress
+	}
+	specs := map[string]struct {
+		src          wasmvmtypes.CosmosMsg
+		unbondings   []stakingtypes.UnbondingDelegationEntry
+		expExecMsg   string
+		expExecCoins sdk.Coins
+		expCalls     capturedCalls
+		expEvent     string
+		expErr       *sdkerrors.Error
+	}{
+		"delegate": {
+			src:          wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{Validator: myContractAddr.String(), Amount: myCoin}}},
+			expExecMsg:   `{"bond":{}}`,
+			expExecCoins: sdk.NewCoins(sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt())),
+			expEvent:     types.EventTypeDelegate,
+		},
+		"delegate to other validator": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Delegate: &wasmvmtypes.DelegateMsg{Validator: otherAddr.String(), Amount: myCoin}}},
+			expErr: types.ErrInvalid,
+		},
+		"undelegate": {
+			src:        wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Undelegate: &wasmvmtypes.UndelegateMsg{Validator: myContractAddr.String(), Amount: myCoin}}},
+			expExecMsg: `{"unbond":{"tokens":{"denom":"utgd","amount":"1"}}}`,
+			expEvent:   types.EventTypeUndelegate,
+		},
+		"undelegate from other validator": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Undelegate: &wasmvmtypes.UndelegateMsg{Validator: otherAddr.String(), Amount: myCoin}}},
+			expErr: types.ErrInvalid,
+		},
+		"redelegate": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{Redelegate: &wasmvmtypes.RedelegateMsg{SrcValidator: myContractAddr.String(), DstValidator: otherAddr.String(), Amount: myCoin}}},
+			expErr: wasmtypes.ErrExecuteFailed,
+		},
+		"withdraw rewards": {
+			src: wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{WithdrawDelegatorReward: &wasmvmtypes.WithdrawDelegatorRewardMsg{Validator: myContractAddr.String()}}},
+			expCalls: capturedCalls{
+				distributionWithdrawals: []sdk.AccAddress{myContractAddr},
+				engagementWithdrawals:   []sdk.AccAddress{myContractAddr},
+			},
+			expEvent: types.EventTypeClaimRewards,
+		},
+		"withdraw rewards with matured claims": {
+			src: wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{WithdrawDelegatorReward: &wasmvmtypes.WithdrawDelegatorRewardMsg{Validator: myContractAddr.String()}}},
+			unbondings: []stakingtypes.UnbondingDelegationEntry{
+				{CompletionTime: now, Balance: sdk.OneInt()},
+				{CompletionTime: now.Add(time.Second), Balance: sdk.NewInt(2)},
+			},
+			expExecMsg: `{"claim":{}}`,
+			expCalls: capturedCalls{
+				distributionWithdrawals: []sdk.AccAddress{myContractAddr},
+				engagementWithdrawals:   []sdk.AccAddress{myContractAddr},
+			},
+			expEvent: types.EventTypeClaimRewards,
+		},
+		"withdraw rewards with pending claims only": {
+			src: wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{WithdrawDelegatorReward: &wasmvmtypes.WithdrawDelegatorRewardMsg{Validator: myContractAddr.String()}}},
+			unbondings: []stakingtypes.UnbondingDelegationEntry{
+				{CompletionTime: now.Add(time.Second), Balance: sdk.OneInt()},
+			},
+			expCalls: capturedCalls{
+				distributionWithdrawals: []sdk.AccAddress{myContractAddr},
+				engagementWithdrawals:   []sdk.AccAddress{myContractAddr},
+			},
+			expEvent: types.EventTypeClaimRewards,
+		},
+		"withdraw rewards from other validator": {
+			src:    wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{WithdrawDelegatorReward: &wasmvmtypes.WithdrawDelegatorRewardMsg{Validator: otherAddr.String()}}},
+			expErr: types.ErrInvalid,
+		},
+		"set withdraw address": {
+			src: wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{SetWithdrawAddress: &wasmvmtypes.SetWithdrawAddressMsg{Address: otherAddr.String()}}},
+			expCalls: capturedCalls{
+				withdrawalDelegations: []sdk.AccAddress{otherAddr},
+			},
+			expEvent: types.EventTypeSetWithdrawAddress,
+		},
+		"set invalid withdraw address": {
+			src:    wasmvmtypes.CosmosMsg{Distribution: &wasmvmtypes.DistributionMsg{SetWithdrawAddress: &wasmvmtypes.SetWithdrawAddressMsg{Address: "invalid"}}},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"other message": {
+			src:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotCalls capturedCalls
+			captureFn, execs := wasmtesting.CaptureExecuteFn()
+			contractKeeperMock := &wasmtesting.ContractOpsKeeperMock{
+				ExecuteFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+					// unbond returns the completion time in an event
+					ctx.EventManager().EmitEvent(sdk.NewEvent("wasm",
+						sdk.NewAttribute("_contract_address", myStakingContract.String()),
+						sdk.NewAttribute("completion_time", strconv.Itoa(int(time.Now().UnixNano())))))
+					return captureFn(ctx, contractAddress, caller, msg, coins)
+				},
+			}
+			poeKeeperMock := PoEKeeperMock{
+				GetPoEContractAddressFn: SwitchPoEContractAddressFn(t, nil, myStakingContract),
+				DistributionContractFn: func(ctx sdk.Context) DistributionContract {
+					return poetesting.DistributionContractMock{WithdrawRewardsFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+						gotCalls.distributionWithdrawals = append(gotCalls.distributionWithdrawals, sender)
+						return nil
+					}}
+				},
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{
+						WithdrawRewardsFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+							gotCalls.engagementWithdrawals = append(gotCalls.engagementWithdrawals, sender)
+							return nil
+						},
+						DelegateWithdrawalFn: func(ctx sdk.Context, sender, delegated sdk.AccAddress) error {
+							require.Equal(t, myContractAddr, sender)
+							gotCalls.withdrawalDelegations = append(gotCalls.withdrawalDelegations, delegated)
+							return nil
+						},
+					}
+				},
+				StakeContractFn: func(ctx sdk.Context) StakeContract {
+					return poetesting.StakeContractMock{
+						QueryStakingUnbondingFn: func(ctx sdk.Context, opAddr sdk.AccAddress) ([]stakingtypes.UnbondingDelegationEntry, error) {
+							require.Equal(t, myContractAddr, opAddr)
+							return spec.unbondings, nil
+						},
+						AddressFn: func() (sdk.AccAddress, error) {
+							return myStakingContract, nil
+						},
+					}
+				},
+			}
+			twasmKeeperMock := TwasmKeeperMock{GetContractKeeperFn: func() wasmtypes.ContractOpsKeeper {
+				return contractKeeperMock
+			}}
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager()).WithBlockTime(now)
+
+			// when
+			h := NewStakingMessageHandler(poeKeeperMock, twasmKeeperMock)
+			gotEvents, _, gotErr := h.DispatchMsg(ctx, myContractAddr, "", spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Empty(t, *execs)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCalls, gotCalls)
+			if spec.expExecMsg != "" {
+				require.Len(t, *execs, 1)
+				assert.Equal(t, myStakingContract, (*execs)[0].ContractAddress)
+				assert.Equal(t, myContractAddr, (*execs)[0].Caller)
+				assert.JSONEq(t, spec.expExecMsg, string((*execs)[0].Msg))
+				assert.Equal(t, spec.expExecCoins, (*execs)[0].Coins)
+			} else {
+				assert.Empty(t, *execs)
+			}
+			require.NotEmpty(t, gotEvents)
+			gotEvent := gotEvents[len(gotEvents)-1]
+			assert.Equal(t, spec.expEvent, gotEvent.Type)
+			if spec.expEvent == types.EventTypeSetWithdrawAddress {
+				return
+			}
+			assert.Contains(t, gotEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyDelegator), Value: []byte(myContractAddr.String())})
+			assert.Contains(t, gotEvent.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyValidator), Value: []byte(myContractAddr.String())})
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 type DistributionContract interface {
 	// ValidatorOutstandingReward returns amount or 0 for an unknown address
 	ValidatorOutstandingReward(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	// WithdrawRewards claims the rewards of the sender
+	WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error
 	Address() (sdk.AccAddress, error)
 }
 
@@ -26,8 +28,11 @@ type ValsetContract interface {
 	QueryValidator(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error)
 	ListValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
 	QueryConfig(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
+	QueryEpoch(ctx sdk.Context) (*contract.ValsetEpochResponse, error)
+	SimulateActiveValidators(ctx sdk.Context) ([]contract.ValidatorInfo, error)
 	UpdateAdmin(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
 	IterateActiveValidators(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
+	UnjailValidator(ctx sdk.Context, sender sdk.AccAddress) error
 	Address() (sdk.AccAddress, error)
 }
 
@@ -55,6 +60,14 @@ type EngagementContract interface {
 	// QueryDelegated returns withdrawal address when set
 	QueryDelegated(ctx sdk.Context, ownerAddr sdk.AccAddress) (*contract.DelegatedResponse, error)
 	QueryWithdrawableRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	// WithdrawRewards claims the rewards of the sender
+	WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error
+	// DelegateWithdrawal sets the address that is allowed to withdraw the rewards of the sender
+	DelegateWithdrawal(ctx sdk.Context, sender, delegated sdk.AccAddress) error
+	// QueryMember returns the points of the member. nil means not a member
+	QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+	ListMembers(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error)
+	QueryHalflife(ctx sdk.Context) (*contract.HalflifeResponse, error)
 	Address() (sdk.AccAddress, error)
 }
 
@@ -62,3 +75,18 @@ func (k *Keeper) EngagementContract(ctx sdk.Context) EngagementContract {
 	engContractAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
 	return contract.NewEngagementContractAdapter(engContractAddr, k.twasmKeeper, err)
 }
+
+type MixerContract interface {
+	// QueryMember returns the mixed points of the member. nil means not a member
+	QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+	QueryTotalPoints(ctx sdk.Context) (int, error)
+	QueryGroups(ctx sdk.Context) (*contract.MixerGroupsResponse, error)
+	// QueryFunction returns the function that mixes the stake and engagement points
+	QueryFunction(ctx sdk.Context) (*contract.MixerFunction, error)
+	Address() (sdk.AccAddress, error)
+}
+
+func (k *Keeper) MixerContract(ctx sdk.Context) MixerContract {
+	mixerContractAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	return contract.NewMixerContractAdapter(mixerContractAddr, k.twasmKeeper, err)
+}
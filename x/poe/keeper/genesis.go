@@ -17,6 +17,8 @@ type DeliverTxFn func(abci.RequestDeliverTx) abci.ResponseDeliverTx
 type initer interface {
 	SetPoEContractAddress(ctx sdk.Context, ctype types.PoEContractType, contractAddr sdk.AccAddress)
 	setParams(ctx sdk.Context, params types.Params)
+	importValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) error
+	importSlashing(ctx sdk.Context, s types.Slashing) error
 }
 
 // InitGenesis - initialize accounts and deliver genesis transactions
@@ -36,6 +38,16 @@ func InitGenesis(
 			}
 			keeper.SetPoEContractAddress(ctx, v.ContractType, addr)
 		}
+		for _, v := range genesisState.GetImportDump().SigningInfos {
+			if err := keeper.importValidatorSigningInfo(ctx, v); err != nil {
+				return sdkerrors.Wrapf(err, "signing info: %s", v.Address)
+			}
+		}
+		for _, v := range genesisState.GetImportDump().Slashings {
+			if err := keeper.importSlashing(ctx, v); err != nil {
+				return sdkerrors.Wrapf(err, "slashing: %s at height %d", v.OperatorAddress, v.Height)
+			}
+		}
 	} else if genesisState.GetSeedContracts() != nil {
 		// seed mode
 		if err := DeliverGenTxs(genesisState.GetSeedContracts().GenTxs, deliverTx, txEncodingConfig); err != nil {
@@ -82,5 +94,13 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		})
 		return false
 	})
+	keeper.IterateValidatorSigningInfos(ctx, func(info types.ValidatorSigningInfo) bool {
+		genState.GetImportDump().SigningInfos = append(genState.GetImportDump().SigningInfos, info)
+		return false
+	})
+	keeper.IterateSlashings(ctx, func(s types.Slashing) bool {
+		genState.GetImportDump().Slashings = append(genState.GetImportDump().Slashings, s)
+		return false
+	})
 	return &genState
 }
@@ -1,12 +1,17 @@
 package keeper
 
 import (
-	"strconv"
+	"bytes"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibccoretypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
+	"github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
 )
 
@@ -31,6 +36,11 @@ func (k *Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *stakingtyp
 	key := getHistoricalInfoKey(height)
 	value := k.codec.MustMarshal(hi)
 	store.Set(key, value)
+	if len(hi.Valset) != 0 {
+		store.Set(getHistoricalValsetHeightKey(height), []byte{})
+	} else {
+		store.Delete(getHistoricalValsetHeightKey(height))
+	}
 }
 
 // DeleteHistoricalInfo deletes the historical info at a given height
@@ -39,13 +49,11 @@ func (k *Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
 	key := getHistoricalInfoKey(height)
 
 	store.Delete(key)
+	store.Delete(getHistoricalValsetHeightKey(height))
 }
 
-// iterateHistoricalInfo provides an interator over all stored HistoricalInfo
-//
-//	objects. For each HistoricalInfo object, cb will be called. If the cb returns
-//
-// true, the iterator will close and stop.
+// iterateHistoricalInfo provides an iterator over all stored HistoricalInfo objects. For each HistoricalInfo object,
+// cb will be called. If the cb returns true, the iterator will close and stop.
 func (k *Keeper) iterateHistoricalInfo(ctx sdk.Context, cb func(stakingtypes.HistoricalInfo) bool) { //nolint:unused
 	store := ctx.KVStore(k.storeKey)
 
@@ -72,6 +80,60 @@ func (k *Keeper) getAllHistoricalInfo(ctx sdk.Context) []stakingtypes.Historical
 	return infos
 }
 
+// PaginatedHistoricalInfos returns a page of the stored HistoricalInfo objects within the given height range.
+// A 0 value for min or max height is unbounded.
+func (k *Keeper) PaginatedHistoricalInfos(ctx sdk.Context, minHeight, maxHeight int64, pagination *query.PageRequest) ([]stakingtypes.HistoricalInfo, *query.PageResponse, error) {
+	rangeStore := keyRangeStore{KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoricalInfoKey)}
+	if minHeight > 0 {
+		rangeStore.start = sdk.Uint64ToBigEndian(uint64(minHeight))
+	}
+	if maxHeight > 0 {
+		rangeStore.end = sdk.Uint64ToBigEndian(uint64(maxHeight) + 1)
+	}
+	var result []stakingtypes.HistoricalInfo
+	pageRes, err := query.Paginate(rangeStore, pagination, func(key, value []byte) error {
+		var hi stakingtypes.HistoricalInfo
+		if err := k.codec.Unmarshal(value, &hi); err != nil {
+			return err
+		}
+		result = append(result, hi)
+		return nil
+	})
+	return result, pageRes, err
+}
+
+// keyRangeStore limits all iterators to the range from start (inclusive) to end (exclusive) so that a pagination
+// does not scan the keys outside. Nil bounds are unbounded.
+type keyRangeStore struct {
+	sdk.KVStore
+	start, end []byte
+}
+
+// Iterator returns an iterator over the intersection of the given and the store range
+func (s keyRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator returns a reverse iterator over the intersection of the given and the store range
+func (s keyRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s keyRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		start = end // empty range
+	}
+	return start, end
+}
+
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
 // heights that are below pruning height
 func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
@@ -81,16 +143,10 @@ func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	// In most cases, this will involve removing a single historical entry.
 	// In the rare scenario when the historical entries gets reduced to a lower value k'
 	// from the original value k. k - k' entries must be deleted from the store.
-	// Since the entries to be deleted are always in a continuous range, we can iterate
-	// over the historical entries starting from the most recent version to be pruned
-	// and then return at the first empty entry.
-	for i := ctx.BlockHeight() - int64(entryNum); i >= 0; i-- {
-		_, found := k.GetHistoricalInfo(ctx, i)
-		if found {
-			k.DeleteHistoricalInfo(ctx, i)
-		} else {
-			break
-		}
+	// Since the keys are ordered by height, all entries below the pruning height are
+	// within a single range.
+	if pruneHeight := ctx.BlockHeight() - int64(entryNum); pruneHeight >= 0 {
+		k.pruneHistoricalInfo(ctx, pruneHeight)
 	}
 
 	// if there is no need to persist historicalInfo, return
@@ -99,14 +155,185 @@ func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	}
 
 	// Create HistoricalInfo struct
-	var valSet stakingtypes.Validators // not used by IBC so we keep it empty
+	var valSet stakingtypes.Validators // not used by IBC but for queries
+	if k.isHistoricalValsetHeight(ctx) {
+		var err error
+		if valSet, err = k.activeValidatorSet(ctx); err != nil {
+			ModuleLogger(ctx).Error("failed to load active validator set for historical info", "cause", err)
+		}
+	}
 	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), valSet, sdk.DefaultPowerReduction)
 
 	// Set latest HistoricalInfo at current height
 	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
 }
 
+// GetHistoricalInfoWithValset gets the historical info at a given height. With the epoch mode, an entry without
+// validators is completed with the set of the most recent previous entry that has one.
+func (k *Keeper) GetHistoricalInfoWithValset(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool) {
+	hi, found := k.GetHistoricalInfo(ctx, height)
+	if !found || len(hi.Valset) != 0 || k.HistoricalValsetMode(ctx) != types.HistoricalValsetModeEpoch {
+		return hi, found
+	}
+	if valsetHeight, ok := k.lastHistoricalValsetHeight(ctx, height); ok {
+		if prev, ok := k.GetHistoricalInfo(ctx, valsetHeight); ok {
+			hi.Valset = prev.Valset
+		}
+	}
+	return hi, true
+}
+
+// lastHistoricalValsetHeight returns the most recent height below the given one with a validator set stored
+// in the historical info
+func (k *Keeper) lastHistoricalValsetHeight(ctx sdk.Context, height int64) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.HistoricalValsetHeightKey, getHistoricalValsetHeightKey(height))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(iter.Key()[len(types.HistoricalValsetHeightKey):])), true
+}
+
+// isHistoricalValsetHeight returns true when the active validator set should be stored with the historical info
+// for the current height
+func (k *Keeper) isHistoricalValsetHeight(ctx sdk.Context) bool {
+	switch k.HistoricalValsetMode(ctx) {
+	case types.HistoricalValsetModeEveryBlock:
+		return true
+	case types.HistoricalValsetModeEpoch:
+		prevHeight := ctx.BlockHeight() - 1
+		prev, found := k.GetHistoricalInfo(ctx, prevHeight)
+		if !found {
+			return true // no reference entry
+		}
+		// the valset contract updates the set in the end block of the first block within a new epoch
+		if epochLength, nextEpoch, ok := k.getValsetEpoch(ctx); ok && epochLength != 0 &&
+			uint64(prev.Header.Time.Unix())/epochLength < nextEpoch {
+			return false
+		}
+		valsetAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+		if err != nil {
+			ModuleLogger(ctx).Error("failed to get valset contract address", "cause", err)
+			return false
+		}
+		epoch, err := contract.QueryValsetEpoch(ctx, k.twasmKeeper, valsetAddr)
+		if err != nil {
+			ModuleLogger(ctx).Error("failed to query valset epoch", "cause", err)
+			return false
+		}
+		k.setValsetEpoch(ctx, epoch.EpochLength, epoch.CurrentEpoch+1)
+		// a new set is applied in the end block of the previous height
+		return epoch.LastUpdateHeight >= uint64(prevHeight)
+	default:
+		return false
+	}
+}
+
+// getValsetEpoch returns the cached epoch length in seconds and the next epoch of the valset contract
+func (k *Keeper) getValsetEpoch(ctx sdk.Context) (uint64, uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ValsetEpochKey)
+	if len(bz) != 16 {
+		return 0, 0, false
+	}
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:]), true
+}
+
+// setValsetEpoch caches the epoch length in seconds and the next epoch of the valset contract so that the contract
+// is queried only when a new epoch is reached
+// `<epoch length as 8 bytes big endian><next epoch as 8 bytes big endian>`
+func (k *Keeper) setValsetEpoch(ctx sdk.Context, epochLength, nextEpoch uint64) {
+	bz := append(sdk.Uint64ToBigEndian(epochLength), sdk.Uint64ToBigEndian(nextEpoch)...)
+	ctx.KVStore(k.storeKey).Set(types.ValsetEpochKey, bz)
+}
+
+// activeValidatorSet returns the active validators from the valset contract with their bonded tokens
+// derived from the consensus power
+func (k *Keeper) activeValidatorSet(ctx sdk.Context) (stakingtypes.Validators, error) {
+	var result stakingtypes.Validators
+	valset := k.ValsetContract(ctx)
+	var err error
+	xerr := valset.IterateActiveValidators(ctx, func(c contract.ValidatorInfo) bool {
+		var opAddr sdk.AccAddress
+		opAddr, err = sdk.AccAddressFromBech32(c.Operator)
+		if err != nil {
+			return true
+		}
+		var val *stakingtypes.Validator
+		val, err = valset.QueryValidator(ctx, opAddr)
+		if err != nil {
+			return true
+		}
+		if val == nil {
+			err = sdkerrors.Wrapf(wasmtypes.ErrNotFound, "validator %s", c.Operator)
+			return true
+		}
+		val.Status = stakingtypes.Bonded
+		val.Tokens = sdk.TokensFromConsensusPower(int64(c.Power), sdk.DefaultPowerReduction)
+		result = append(result, *val)
+		return false
+	}, nil)
+	if xerr != nil {
+		return nil, xerr
+	}
+	return result, err
+}
+
+// pruneHistoricalInfo deletes all entries up to and including the given height. The most recent pruned validator
+// set is copied to the oldest remaining entry when it has none, so that the set stays resolvable in epoch mode.
+func (k *Keeper) pruneHistoricalInfo(ctx sdk.Context, height int64) {
+	var prunedValset stakingtypes.Validators
+	if k.HistoricalValsetMode(ctx) == types.HistoricalValsetModeEpoch {
+		if valsetHeight, ok := k.lastHistoricalValsetHeight(ctx, height+1); ok {
+			if hi, ok := k.GetHistoricalInfo(ctx, valsetHeight); ok {
+				prunedValset = hi.Valset
+			}
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	deleteRange(store, types.HistoricalInfoKey, getHistoricalInfoKey(height+1))
+	deleteRange(store, types.HistoricalValsetHeightKey, getHistoricalValsetHeightKey(height+1))
+	if len(prunedValset) == 0 {
+		return
+	}
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	if !iter.Valid() {
+		iter.Close()
+		return
+	}
+	oldestHeight := int64(sdk.BigEndianToUint64(iter.Key()[len(types.HistoricalInfoKey):]))
+	oldest := stakingtypes.MustUnmarshalHistoricalInfo(k.codec, iter.Value())
+	iter.Close()
+	if len(oldest.Valset) == 0 {
+		oldest.Valset = prunedValset
+		k.SetHistoricalInfo(ctx, oldestHeight, &oldest)
+	}
+}
+
+// deleteRange deletes all keys within the given range
+func deleteRange(store sdk.KVStore, start, end []byte) {
+	iter := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getHistoricalValsetHeightKey returns the key for the index of historical info entries with a validator set
+// `<prefix><height as 8 bytes big endian>`
+func getHistoricalValsetHeightKey(height int64) []byte {
+	r := append([]byte{}, types.HistoricalValsetHeightKey...)
+	return append(r, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // getHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
+// `<prefix><height as 8 bytes big endian>`
 func getHistoricalInfoKey(height int64) []byte {
-	return append(types.HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
+	r := append([]byte{}, types.HistoricalInfoKey...)
+	return append(r, sdk.Uint64ToBigEndian(uint64(height))...)
 }
@@ -1,16 +1,19 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
 )
 
@@ -65,3 +68,194 @@ func TestTrackHistoricalInfo(t *testing.T) {
 	expEntries = append(expEntries, stakingtypes.NewHistoricalInfo(header, nil, sdk.DefaultPowerReduction))
 	assert.Equal(t, expEntries[1:], keeper.getAllHistoricalInfo(ctx))
 }
+
+func TestTrackHistoricalInfoPrunesReducedEntries(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	for i := int64(1); i <= 20; i++ {
+		hi := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: i}, nil, sdk.DefaultPowerReduction)
+		keeper.SetHistoricalInfo(ctx, i, &hi)
+	}
+	// when entries reduced
+	keeper.setParams(ctx, types.Params{HistoricalEntries: 3})
+	keeper.TrackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: 21, Time: time.Now().UTC()}))
+
+	// then
+	var gotHeights []int64
+	for _, v := range keeper.getAllHistoricalInfo(ctx) {
+		gotHeights = append(gotHeights, v.Header.Height)
+	}
+	assert.Equal(t, []int64{19, 20, 21}, gotHeights)
+}
+
+func TestPaginatedHistoricalInfos(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	for _, h := range []int64{1, 2, 9, 10, 11, 100} {
+		hi := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: h}, nil, sdk.DefaultPowerReduction)
+		keeper.SetHistoricalInfo(ctx, h, &hi)
+	}
+	specs := map[string]struct {
+		min, max   int64
+		pagination *query.PageRequest
+		expHeights []int64
+	}{
+		"unbounded": {
+			expHeights: []int64{1, 2, 9, 10, 11, 100},
+		},
+		"min height": {
+			min:        10,
+			expHeights: []int64{10, 11, 100},
+		},
+		"max height": {
+			max:        10,
+			expHeights: []int64{1, 2, 9, 10},
+		},
+		"min and max height": {
+			min:        2,
+			max:        11,
+			expHeights: []int64{2, 9, 10, 11},
+		},
+		"paginated": {
+			min:        2,
+			pagination: &query.PageRequest{Limit: 2},
+			expHeights: []int64{2, 9},
+		},
+		"paginated with offset": {
+			min:        2,
+			pagination: &query.PageRequest{Offset: 2, Limit: 2},
+			expHeights: []int64{10, 11},
+		},
+		"empty range": {
+			min: 12,
+			max: 99,
+		},
+		"min above max": {
+			min: 11,
+			max: 10,
+		},
+		"paginated by key": {
+			min:        2,
+			max:        11,
+			pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(10)},
+			expHeights: []int64{10, 11},
+		},
+		"paginated by key below min": {
+			min:        9,
+			max:        11,
+			pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(1)},
+			expHeights: []int64{9, 10, 11},
+		},
+		"paginated reverse": {
+			min:        2,
+			max:        11,
+			pagination: &query.PageRequest{Reverse: true, Limit: 3},
+			expHeights: []int64{11, 10, 9},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := keeper.PaginatedHistoricalInfos(ctx, spec.min, spec.max, spec.pagination)
+			require.NoError(t, err)
+			var gotHeights []int64
+			for _, v := range got {
+				gotHeights = append(gotHeights, v.Header.Height)
+			}
+			assert.Equal(t, spec.expHeights, gotHeights)
+		})
+	}
+}
+
+func TestGetHistoricalInfoWithValsetAfterPruning(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+	keeper.setParams(ctx, types.Params{HistoricalEntries: 2, HistoricalValsetMode: types.HistoricalValsetModeEpoch})
+	myValset := stakingtypes.Validators{{OperatorAddress: "myOperator", Tokens: sdk.OneInt(), DelegatorShares: sdk.OneDec()}}
+	hi := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: 1}, myValset, sdk.DefaultPowerReduction)
+	keeper.SetHistoricalInfo(ctx, 1, &hi)
+	hi = stakingtypes.NewHistoricalInfo(tmproto.Header{Height: 2}, nil, sdk.DefaultPowerReduction)
+	keeper.SetHistoricalInfo(ctx, 2, &hi)
+
+	for height := int64(3); height <= 5; height++ {
+		// when the entry with the set is pruned
+		keeper.TrackHistoricalInfo(ctx.WithBlockHeader(tmproto.Header{Height: height, Time: time.Now().UTC()}))
+
+		// then the set is resolved from the oldest remaining entry
+		_, exists := keeper.GetHistoricalInfo(ctx, height-2)
+		require.False(t, exists)
+		got, found := keeper.GetHistoricalInfoWithValset(ctx, height)
+		require.True(t, found)
+		require.Len(t, got.Valset, 1)
+		assert.Equal(t, "myOperator", got.Valset[0].OperatorAddress)
+		oldest, found := keeper.GetHistoricalInfo(ctx, height-1)
+		require.True(t, found)
+		assert.Len(t, oldest.Valset, 1)
+		valsetHeight, found := keeper.lastHistoricalValsetHeight(ctx, height)
+		require.True(t, found)
+		assert.Equal(t, height-1, valsetHeight)
+	}
+}
+
+func TestIsHistoricalValsetHeightEpochCache(t *testing.T) {
+	const epochLength = 60
+	prevTime := time.Unix(1_000_000_020, 0).UTC()
+	prevEpoch := uint64(prevTime.Unix()) / epochLength
+	specs := map[string]struct {
+		cached       bool
+		cacheNext    uint64
+		queryEpoch   *contract.ValsetEpochResponse
+		exp          bool
+		expCacheNext uint64
+	}{
+		"no cache - updated": {
+			queryEpoch:   &contract.ValsetEpochResponse{EpochLength: epochLength, CurrentEpoch: prevEpoch, LastUpdateHeight: 99},
+			exp:          true,
+			expCacheNext: prevEpoch + 1,
+		},
+		"no cache - not updated": {
+			queryEpoch:   &contract.ValsetEpochResponse{EpochLength: epochLength, CurrentEpoch: prevEpoch, LastUpdateHeight: 98},
+			expCacheNext: prevEpoch + 1,
+		},
+		"cached - same epoch not queried": {
+			cached:       true,
+			cacheNext:    prevEpoch + 1,
+			expCacheNext: prevEpoch + 1,
+		},
+		"cached - new epoch reached": {
+			cached:       true,
+			cacheNext:    prevEpoch,
+			queryEpoch:   &contract.ValsetEpochResponse{EpochLength: epochLength, CurrentEpoch: prevEpoch, LastUpdateHeight: 99},
+			exp:          true,
+			expCacheNext: prevEpoch + 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, k := createMinTestInput(t)
+			k.setParams(ctx, types.Params{HistoricalEntries: 10, HistoricalValsetMode: types.HistoricalValsetModeEpoch})
+			k.SetPoEContractAddress(ctx, types.PoEContractTypeValset, RandomAddress(t))
+			hi := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: 99, Time: prevTime}, nil, sdk.DefaultPowerReduction)
+			k.SetHistoricalInfo(ctx, 99, &hi)
+			if spec.cached {
+				k.setValsetEpoch(ctx, epochLength, spec.cacheNext)
+			}
+			var mock TwasmKeeperMock
+			if spec.queryEpoch != nil {
+				mock.QuerySmartFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+					return json.Marshal(spec.queryEpoch)
+				}
+			}
+			k.twasmKeeper = mock
+
+			// when
+			got := k.isHistoricalValsetHeight(ctx.WithBlockHeight(100))
+
+			// then
+			assert.Equal(t, spec.exp, got)
+			gotLength, gotNext, ok := k.getValsetEpoch(ctx)
+			require.True(t, ok)
+			assert.Equal(t, uint64(epochLength), gotLength)
+			assert.Equal(t, spec.expCacheNext, gotNext)
+		})
+	}
+}
@@ -4,18 +4,27 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/confio/tgrade/x/poe/types"
 )
 
 var _ slashingtypes.QueryServer = &LegacySlashingGRPCQuerier{}
 
+type slashingQuerierKeeper interface {
+	SignedBlocksWindow(ctx sdk.Context) uint32
+	GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool)
+	PaginatedValidatorSigningInfos(ctx sdk.Context, pagination *query.PageRequest) ([]types.ValidatorSigningInfo, *query.PageResponse, error)
+}
+
 type LegacySlashingGRPCQuerier struct {
-	keeper ContractSource
+	keeper slashingQuerierKeeper
 }
 
-func NewLegacySlashingGRPCQuerier(keeper ContractSource) *LegacySlashingGRPCQuerier { //nolint:golint
+func NewLegacySlashingGRPCQuerier(keeper slashingQuerierKeeper) *LegacySlashingGRPCQuerier { //nolint:golint
 	return &LegacySlashingGRPCQuerier{keeper: keeper}
 }
 
@@ -24,32 +33,38 @@ func (g LegacySlashingGRPCQuerier) SigningInfo(c context.Context, req *slashingt
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	valAddr, err := sdk.AccAddressFromBech32(req.ConsAddress)
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "validator address")
 	}
-	return &slashingtypes.QuerySigningInfoResponse{
-		ValSigningInfo: slashingtypes.ValidatorSigningInfo{
-			Address:             valAddr.String(),
-			StartHeight:         0,
-			IndexOffset:         0,
-			Tombstoned:          false,
-			MissedBlocksCounter: 0,
-		},
-	}, nil
+	info, found := g.keeper.GetValidatorSigningInfo(sdk.UnwrapSDKContext(c), consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+	return &slashingtypes.QuerySigningInfoResponse{ValSigningInfo: toLegacySigningInfo(info)}, nil
 }
 
-// SigningInfos is not supported and will return unimplemented error
+// SigningInfos legacy support for cosmos-sdk signing infos. Note that not all field are available on tgrade
 func (g LegacySlashingGRPCQuerier) SigningInfos(c context.Context, req *slashingtypes.QuerySigningInfosRequest) (*slashingtypes.QuerySigningInfosResponse, error) {
-	logNotImplemented(sdk.UnwrapSDKContext(c), "SigningInfos")
-	return nil, status.Error(codes.Unimplemented, "not available, yet")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	infos, pageRes, err := g.keeper.PaginatedValidatorSigningInfos(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]slashingtypes.ValidatorSigningInfo, len(infos))
+	for i, v := range infos {
+		result[i] = toLegacySigningInfo(v)
+	}
+	return &slashingtypes.QuerySigningInfosResponse{Info: result, Pagination: pageRes}, nil
 }
 
-// Params is not supported. Method returns default slashing module params.
+// Params is not supported. Method returns default slashing module params with the signed blocks window set.
 func (g LegacySlashingGRPCQuerier) Params(c context.Context, req *slashingtypes.QueryParamsRequest) (*slashingtypes.QueryParamsResponse, error) {
 	return &slashingtypes.QueryParamsResponse{
 		Params: slashingtypes.Params{
-			SignedBlocksWindow:      0,
+			SignedBlocksWindow:      int64(g.keeper.SignedBlocksWindow(sdk.UnwrapSDKContext(c))),
 			MinSignedPerWindow:      sdk.ZeroDec(),
 			DowntimeJailDuration:    0,
 			SlashFractionDoubleSign: sdk.ZeroDec(),
@@ -57,3 +72,13 @@ func (g LegacySlashingGRPCQuerier) Params(c context.Context, req *slashingtypes.
 		},
 	}, nil
 }
+
+func toLegacySigningInfo(info types.ValidatorSigningInfo) slashingtypes.ValidatorSigningInfo {
+	return slashingtypes.ValidatorSigningInfo{
+		Address:             info.Address,
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		Tombstoned:          false,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}
+}
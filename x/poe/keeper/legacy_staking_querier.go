@@ -240,7 +240,7 @@ func (q LegacyStakingGRPCQuerier) HistoricalInfo(c context.Context, req *staking
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	hi, found := q.keeper.GetHistoricalInfo(sdk.UnwrapSDKContext(c), req.Height)
+	hi, found := q.keeper.GetHistoricalInfoWithValset(sdk.UnwrapSDKContext(c), req.Height)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "historical info for height %d not found", req.Height)
 	}
@@ -23,6 +23,8 @@ type PoEKeeper interface {
 	SetValidatorInitialEngagementPoints(ctx sdk.Context, address sdk.AccAddress, value sdk.Coin) error
 	GetBondDenom(ctx sdk.Context) string
 	ValsetContract(ctx sdk.Context) ValsetContract
+	DistributionContract(ctx sdk.Context) DistributionContract
+	EngagementContract(ctx sdk.Context) EngagementContract
 }
 
 type msgServer struct {
@@ -211,3 +213,89 @@ func (m msgServer) Undelegate(c context.Context, msg *types.MsgUndelegate) (*typ
 	})
 	return &types.MsgUndelegateResponse{CompletionTime: *completionTime}, nil
 }
+
+func (m msgServer) ClaimRewards(c context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender address")
+	}
+
+	if msg.ClaimDistribution() {
+		if err := m.keeper.DistributionContract(ctx).WithdrawRewards(ctx, senderAddress); err != nil {
+			return nil, sdkerrors.Wrap(err, "withdraw distribution rewards")
+		}
+	}
+	if msg.ClaimEngagement() {
+		if err := m.keeper.EngagementContract(ctx).WithdrawRewards(ctx, senderAddress); err != nil {
+			return nil, sdkerrors.Wrap(err, "withdraw engagement rewards")
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgClaimRewardsResponse{}, nil
+}
+
+func (m msgServer) SetWithdrawAddress(c context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	senderAddress, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender address")
+	}
+	withdrawAddress, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "withdraw address")
+	}
+
+	if err := m.keeper.EngagementContract(ctx).DelegateWithdrawal(ctx, senderAddress, withdrawAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "delegate withdrawal")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.WithdrawAddress),
+		),
+	})
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
+
+func (m msgServer) Unjail(c context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	operatorAddress, err := sdk.AccAddressFromBech32(msg.OperatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator address")
+	}
+
+	if err := m.keeper.ValsetContract(ctx).UnjailValidator(ctx, operatorAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "unjail validator")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OperatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyValOperator, msg.OperatorAddress),
+		),
+	})
+	return &types.MsgUnjailResponse{}, nil
+}
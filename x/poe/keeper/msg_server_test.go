@@ -511,3 +511,183 @@ func TestUndelegate(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimRewards(t *testing.T) {
+	var mySenderAddr sdk.AccAddress = rand.Bytes(address.Len)
+	specs := map[string]struct {
+		src              *types.MsgClaimRewards
+		distrErr, engErr error
+		expDistribution  bool
+		expEngagement    bool
+		expErr           *sdkerrors.Error
+	}{
+		"both by default": {
+			src:             types.NewMsgClaimRewards(mySenderAddr, false, false),
+			expDistribution: true,
+			expEngagement:   true,
+		},
+		"both selected": {
+			src:             types.NewMsgClaimRewards(mySenderAddr, true, true),
+			expDistribution: true,
+			expEngagement:   true,
+		},
+		"distribution only": {
+			src:             types.NewMsgClaimRewards(mySenderAddr, true, false),
+			expDistribution: true,
+		},
+		"engagement only": {
+			src:           types.NewMsgClaimRewards(mySenderAddr, false, true),
+			expEngagement: true,
+		},
+		"distribution contract error": {
+			src:      types.NewMsgClaimRewards(mySenderAddr, true, false),
+			distrErr: types.ErrInvalid,
+			expErr:   types.ErrInvalid,
+		},
+		"engagement contract error": {
+			src:    types.NewMsgClaimRewards(mySenderAddr, false, true),
+			engErr: types.ErrInvalid,
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var distrClaimed, engClaimed []sdk.AccAddress
+			poeKeeperMock := PoEKeeperMock{
+				DistributionContractFn: func(ctx sdk.Context) DistributionContract {
+					return poetesting.DistributionContractMock{WithdrawRewardsFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+						distrClaimed = append(distrClaimed, sender)
+						return spec.distrErr
+					}}
+				},
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{WithdrawRewardsFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+						engClaimed = append(engClaimed, sender)
+						return spec.engErr
+					}}
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, nil, nil)
+			gotRes, gotErr := s.ClaimRewards(ctx, spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expDistribution, len(distrClaimed) == 1)
+			assert.Equal(t, spec.expEngagement, len(engClaimed) == 1)
+			for _, v := range append(distrClaimed, engClaimed...) {
+				assert.Equal(t, mySenderAddr, v)
+			}
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeClaimRewards, em.Events()[1].Type)
+		})
+	}
+}
+
+func TestSetWithdrawAddress(t *testing.T) {
+	var (
+		mySenderAddr   sdk.AccAddress = rand.Bytes(address.Len)
+		myWithdrawAddr sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	specs := map[string]struct {
+		src    *types.MsgSetWithdrawAddress
+		engErr error
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			src: types.NewMsgSetWithdrawAddress(mySenderAddr, myWithdrawAddr),
+		},
+		"contract error": {
+			src:    types.NewMsgSetWithdrawAddress(mySenderAddr, myWithdrawAddr),
+			engErr: types.ErrInvalid,
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedSender, capturedDelegated sdk.AccAddress
+			poeKeeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{DelegateWithdrawalFn: func(ctx sdk.Context, sender, delegated sdk.AccAddress) error {
+						capturedSender, capturedDelegated = sender, delegated
+						return spec.engErr
+					}}
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, nil, nil)
+			gotRes, gotErr := s.SetWithdrawAddress(ctx, spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, mySenderAddr, capturedSender)
+			assert.Equal(t, myWithdrawAddr, capturedDelegated)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeSetWithdrawAddress, em.Events()[1].Type)
+		})
+	}
+}
+
+func TestUnjail(t *testing.T) {
+	var myOperatorAddr sdk.AccAddress = rand.Bytes(address.Len)
+	specs := map[string]struct {
+		src       *types.MsgUnjail
+		valsetErr error
+		expErr    *sdkerrors.Error
+	}{
+		"all good": {
+			src: types.NewMsgUnjail(myOperatorAddr),
+		},
+		"contract error": {
+			src:       types.NewMsgUnjail(myOperatorAddr),
+			valsetErr: types.ErrInvalid,
+			expErr:    types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedSender sdk.AccAddress
+			poeKeeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract {
+					return poetesting.ValsetContractMock{UnjailValidatorFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+						capturedSender = sender
+						return spec.valsetErr
+					}}
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, nil, nil)
+			gotRes, gotErr := s.Unjail(ctx, spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, myOperatorAddr, capturedSender)
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, types.EventTypeUnjail, em.Events()[1].Type)
+		})
+	}
+}
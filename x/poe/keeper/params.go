@@ -24,12 +24,39 @@ func (k *Keeper) MinimumDelegationAmounts(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// SignedBlocksWindow returns the number of blocks in the sliding window to track missed blocks.
+// Returns 0 (disabled) when the param was not set.
+func (k *Keeper) SignedBlocksWindow(ctx sdk.Context) (res uint32) {
+	k.paramStore.GetIfExists(ctx, types.KeySignedBlocksWindow, &res)
+	return
+}
+
+// HistoricalValsetMode returns when the active validator set is stored with the historical info.
+// Returns HistoricalValsetModeNone when the param was not set.
+func (k *Keeper) HistoricalValsetMode(ctx sdk.Context) (res types.HistoricalValsetMode) {
+	k.paramStore.GetIfExists(ctx, types.KeyHistoricalValsetMode, &res)
+	return
+}
+
+// FeeSplit returns how the tx fees are split.
+// Returns the default split with all fees to the valset contract when the param was not set.
+func (k *Keeper) FeeSplit(ctx sdk.Context) (res types.FeeSplit) {
+	k.paramStore.GetIfExists(ctx, types.KeyFeeSplit, &res)
+	if res.IsEmpty() {
+		return types.DefaultFeeSplit()
+	}
+	return
+}
+
 // GetParams returns all parameters as types.Params
 func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.HistoricalEntries(ctx),
 		k.GetInitialValidatorEngagementPoints(ctx),
 		k.MinimumDelegationAmounts(ctx),
+		k.SignedBlocksWindow(ctx),
+		k.HistoricalValsetMode(ctx),
+		k.FeeSplit(ctx),
 	)
 }
 
@@ -13,9 +13,17 @@ import (
 
 type DistributionContractMock struct {
 	ValidatorOutstandingRewardFn func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	WithdrawRewardsFn            func(ctx sdk.Context, sender sdk.AccAddress) error
 	AddressFn                    func() (sdk.AccAddress, error)
 }
 
+func (m DistributionContractMock) WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error {
+	if m.WithdrawRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.WithdrawRewardsFn(ctx, sender)
+}
+
 func (m DistributionContractMock) ValidatorOutstandingReward(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
 	if m.ValidatorOutstandingRewardFn == nil {
 		panic("not expected to be called")
@@ -33,13 +41,23 @@ func (m DistributionContractMock) Address() (sdk.AccAddress, error) {
 // var _ keeper.ValsetContract = ValsetContractMock{}
 
 type ValsetContractMock struct {
-	QueryValidatorFn          func(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error)
-	ListValidatorsFn          func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error)
-	QueryConfigFn             func(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
-	ListValidatorSlashingFn   func(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
-	UpdateAdminFn             func(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
-	IterateActiveValidatorsFn func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
-	AddressFn                 func() (sdk.AccAddress, error)
+	QueryValidatorFn           func(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error)
+	ListValidatorsFn           func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error)
+	QueryConfigFn              func(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
+	QueryEpochFn               func(ctx sdk.Context) (*contract.ValsetEpochResponse, error)
+	SimulateActiveValidatorsFn func(ctx sdk.Context) ([]contract.ValidatorInfo, error)
+	ListValidatorSlashingFn    func(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
+	UpdateAdminFn              func(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
+	IterateActiveValidatorsFn  func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
+	UnjailValidatorFn          func(ctx sdk.Context, sender sdk.AccAddress) error
+	AddressFn                  func() (sdk.AccAddress, error)
+}
+
+func (m ValsetContractMock) UnjailValidator(ctx sdk.Context, sender sdk.AccAddress) error {
+	if m.UnjailValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnjailValidatorFn(ctx, sender)
 }
 
 func (m ValsetContractMock) IterateActiveValidators(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error {
@@ -77,6 +95,20 @@ func (m ValsetContractMock) QueryConfig(ctx sdk.Context) (*contract.ValsetConfig
 	return m.QueryConfigFn(ctx)
 }
 
+func (m ValsetContractMock) QueryEpoch(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+	if m.QueryEpochFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryEpochFn(ctx)
+}
+
+func (m ValsetContractMock) SimulateActiveValidators(ctx sdk.Context) ([]contract.ValidatorInfo, error) {
+	if m.SimulateActiveValidatorsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SimulateActiveValidatorsFn(ctx)
+}
+
 func (m ValsetContractMock) ListValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error) {
 	if m.ListValidatorSlashingFn == nil {
 		panic("not expected to be called")
@@ -134,9 +166,28 @@ type EngagementContractMock struct {
 	UpdateAdminFn              func(ctx sdk.Context, newAdmin, sender sdk.AccAddress) error
 	QueryDelegatedFn           func(ctx sdk.Context, ownerAddr sdk.AccAddress) (*contract.DelegatedResponse, error)
 	QueryWithdrawableRewardsFn func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	WithdrawRewardsFn          func(ctx sdk.Context, sender sdk.AccAddress) error
+	DelegateWithdrawalFn       func(ctx sdk.Context, sender, delegated sdk.AccAddress) error
+	QueryMemberFn              func(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+	ListMembersFn              func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error)
+	QueryHalflifeFn            func(ctx sdk.Context) (*contract.HalflifeResponse, error)
 	AddressFn                  func() (sdk.AccAddress, error)
 }
 
+func (m EngagementContractMock) WithdrawRewards(ctx sdk.Context, sender sdk.AccAddress) error {
+	if m.WithdrawRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.WithdrawRewardsFn(ctx, sender)
+}
+
+func (m EngagementContractMock) DelegateWithdrawal(ctx sdk.Context, sender, delegated sdk.AccAddress) error {
+	if m.DelegateWithdrawalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DelegateWithdrawalFn(ctx, sender, delegated)
+}
+
 func (m EngagementContractMock) UpdateAdmin(ctx sdk.Context, newAdmin, sender sdk.AccAddress) error {
 	if m.UpdateAdminFn == nil {
 		panic("not expected to be called")
@@ -164,3 +215,69 @@ func (m EngagementContractMock) QueryWithdrawableRewards(ctx sdk.Context, addr s
 	}
 	return m.QueryWithdrawableRewardsFn(ctx, addr)
 }
+
+func (m EngagementContractMock) QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+	if m.QueryMemberFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryMemberFn(ctx, addr)
+}
+
+func (m EngagementContractMock) ListMembers(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+	if m.ListMembersFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ListMembersFn(ctx, pagination)
+}
+
+func (m EngagementContractMock) QueryHalflife(ctx sdk.Context) (*contract.HalflifeResponse, error) {
+	if m.QueryHalflifeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryHalflifeFn(ctx)
+}
+
+// var _ keeper.MixerContract = MixerContractMock{}
+
+type MixerContractMock struct {
+	QueryMemberFn      func(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+	QueryTotalPointsFn func(ctx sdk.Context) (int, error)
+	QueryGroupsFn      func(ctx sdk.Context) (*contract.MixerGroupsResponse, error)
+	QueryFunctionFn    func(ctx sdk.Context) (*contract.MixerFunction, error)
+	AddressFn          func() (sdk.AccAddress, error)
+}
+
+func (m MixerContractMock) QueryMember(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+	if m.QueryMemberFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryMemberFn(ctx, addr)
+}
+
+func (m MixerContractMock) QueryTotalPoints(ctx sdk.Context) (int, error) {
+	if m.QueryTotalPointsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryTotalPointsFn(ctx)
+}
+
+func (m MixerContractMock) QueryGroups(ctx sdk.Context) (*contract.MixerGroupsResponse, error) {
+	if m.QueryGroupsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryGroupsFn(ctx)
+}
+
+func (m MixerContractMock) QueryFunction(ctx sdk.Context) (*contract.MixerFunction, error) {
+	if m.QueryFunctionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryFunctionFn(ctx)
+}
+
+func (m MixerContractMock) Address() (sdk.AccAddress, error) {
+	if m.AddressFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AddressFn()
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/confio/tgrade/x/poe/contract"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
@@ -25,12 +27,17 @@ type ContractSource interface {
 
 type ViewKeeper interface {
 	ContractSource
-	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+	GetHistoricalInfoWithValset(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetBondDenom(ctx sdk.Context) string
 	DistributionContract(ctx sdk.Context) DistributionContract
 	ValsetContract(ctx sdk.Context) ValsetContract
 	StakeContract(ctx sdk.Context) StakeContract
 	EngagementContract(ctx sdk.Context) EngagementContract
+	MixerContract(ctx sdk.Context) MixerContract
+	SignedBlocksWindow(ctx sdk.Context) uint32
+	GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool)
+	PaginatedHistoricalInfos(ctx sdk.Context, minHeight, maxHeight int64, pagination *query.PageRequest) ([]stakingtypes.HistoricalInfo, *query.PageResponse, error)
+	PaginatedSlashings(ctx sdk.Context, pagination *query.PageRequest) ([]types.Slashing, *query.PageResponse, error)
 }
 
 type Querier struct {
@@ -176,7 +183,7 @@ func (q Querier) HistoricalInfo(c context.Context, req *stakingtypes.QueryHistor
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	hi, found := q.keeper.GetHistoricalInfo(sdk.UnwrapSDKContext(c), req.Height)
+	hi, found := q.keeper.GetHistoricalInfoWithValset(sdk.UnwrapSDKContext(c), req.Height)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "historical info for height %d not found", req.Height)
 	}
@@ -232,3 +239,254 @@ func (q Querier) ValidatorEngagementReward(c context.Context, req *types.QueryVa
 		Reward: sdk.NewDecCoin(reward.Denom, reward.Amount),
 	}, nil
 }
+
+// SigningInfo query the liveness data of a validator within the signed blocks window
+func (q Querier) SigningInfo(c context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ConsAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := q.keeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "signing info for %s not found", req.ConsAddress)
+	}
+	return &types.QuerySigningInfoResponse{
+		ValSigningInfo:     info,
+		SignedBlocksWindow: q.keeper.SignedBlocksWindow(ctx),
+	}, nil
+}
+
+// HistoricalInfos queries the stored historical infos within a range of heights
+func (q Querier) HistoricalInfos(c context.Context, req *types.QueryHistoricalInfosRequest) (*types.QueryHistoricalInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height must not be greater than max height")
+	}
+	hists, pageRes, err := q.keeper.PaginatedHistoricalInfos(sdk.UnwrapSDKContext(c), req.MinHeight, req.MaxHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryHistoricalInfosResponse{Hists: hists, Pagination: pageRes}, nil
+}
+
+// ValsetEpoch queries the current epoch of the valset contract
+func (q Querier) ValsetEpoch(c context.Context, req *types.QueryValsetEpochRequest) (*types.QueryValsetEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch, err := q.keeper.ValsetContract(ctx).QueryEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValsetEpochResponse{
+		EpochLength:      time.Duration(epoch.EpochLength) * time.Second,
+		CurrentEpoch:     epoch.CurrentEpoch,
+		LastUpdateTime:   time.Unix(int64(epoch.LastUpdateTime), 0).UTC(),
+		LastUpdateHeight: epoch.LastUpdateHeight,
+		NextUpdateTime:   time.Unix(int64((epoch.CurrentEpoch+1)*epoch.EpochLength), 0).UTC(),
+	}, nil
+}
+
+// ValsetConfig queries the configuration of the valset contract
+func (q Querier) ValsetConfig(c context.Context, req *types.QueryValsetConfigRequest) (*types.QueryValsetConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	config, err := q.keeper.ValsetContract(ctx).QueryConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	distContracts := make([]types.RewardDistributionContract, len(config.DistributionContracts))
+	for i, v := range config.DistributionContracts {
+		distContracts[i] = types.RewardDistributionContract{Address: v.Address, Ratio: v.Ratio}
+	}
+	return &types.QueryValsetConfigResponse{
+		Membership:            config.Membership,
+		MinPoints:             config.MinPoints,
+		MaxValidators:         config.MaxValidators,
+		Scaling:               config.Scaling,
+		EpochReward:           config.EpochReward,
+		FeePercentage:         config.FeePercentage,
+		DistributionContracts: distContracts,
+		ValidatorGroup:        config.ValidatorGroup,
+		AutoUnjail:            config.AutoUnjail,
+	}, nil
+}
+
+// SimulateNextValidatorSet queries the active validator set that would be applied with the next epoch
+func (q Querier) SimulateNextValidatorSet(c context.Context, req *types.QuerySimulateNextValidatorSetRequest) (*types.QuerySimulateNextValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	vals, err := q.keeper.ValsetContract(ctx).SimulateActiveValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]types.ValidatorPower, len(vals))
+	for i, v := range vals {
+		tmPk, err := contract.ConvertToTendermintPubKey(v.ValidatorPubkey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pk, err := cryptocodec.FromTmProtoPublicKey(tmPk)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		result[i] = types.ValidatorPower{
+			OperatorAddress: v.Operator,
+			ConsAddress:     sdk.ConsAddress(pk.Address()).String(),
+			Power:           v.Power,
+		}
+	}
+	return &types.QuerySimulateNextValidatorSetResponse{Validators: result}, nil
+}
+
+// Slashings queries the recorded slashings of all validators ordered by height
+func (q Querier) Slashings(c context.Context, req *types.QuerySlashingsRequest) (*types.QuerySlashingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	slashings, pageRes, err := q.keeper.PaginatedSlashings(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySlashingsResponse{Slashings: slashings, Pagination: pageRes}, nil
+}
+
+// EngagementPoints queries the engagement points of an address.
+// returns NotFound error code when the address is not a member
+func (q Querier) EngagementPoints(c context.Context, req *types.QueryEngagementPointsRequest) (*types.QueryEngagementPointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	points, err := q.keeper.EngagementContract(ctx).QueryMember(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if points == nil {
+		return nil, status.Error(codes.NotFound, "member")
+	}
+	return &types.QueryEngagementPointsResponse{Points: uint64(*points)}, nil
+}
+
+// ListEngagementMembers queries the members of the engagement contract ordered by address
+func (q Querier) ListEngagementMembers(c context.Context, req *types.QueryListEngagementMembersRequest) (*types.QueryListEngagementMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	pagination, err := contract.NewPaginator(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	members, cursor, err := q.keeper.EngagementContract(ctx).ListMembers(ctx, pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]types.TG4Member, len(members))
+	for i, m := range members {
+		result[i] = types.TG4Member{Address: m.Addr, Points: m.Points}
+	}
+	var pageResp *query.PageResponse
+	if len(cursor) != 0 {
+		pageResp = &query.PageResponse{
+			NextKey: cursor,
+		}
+	}
+	return &types.QueryListEngagementMembersResponse{
+		Members:    result,
+		Pagination: pageResp,
+	}, nil
+}
+
+// EngagementHalflife queries the halflife settings of the engagement contract
+func (q Querier) EngagementHalflife(c context.Context, req *types.QueryEngagementHalflifeRequest) (*types.QueryEngagementHalflifeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	rsp, err := q.keeper.EngagementContract(ctx).QueryHalflife(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if rsp.HalflifeInfo == nil {
+		return &types.QueryEngagementHalflifeResponse{}, nil
+	}
+	return &types.QueryEngagementHalflifeResponse{
+		Halflife:     time.Duration(rsp.HalflifeInfo.Halflife) * time.Second,
+		LastHalflife: time.Unix(0, int64(rsp.HalflifeInfo.LastHalflife)).UTC(),
+		NextHalflife: time.Unix(0, int64(rsp.HalflifeInfo.NextHalflife)).UTC(),
+	}, nil
+}
+
+// MixedPoints queries the mixed PoE points of an address that decide the validator power.
+// returns NotFound error code when the address is not a member
+func (q Querier) MixedPoints(c context.Context, req *types.QueryMixedPointsRequest) (*types.QueryMixedPointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	points, err := q.keeper.MixerContract(ctx).QueryMember(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if points == nil {
+		return nil, status.Error(codes.NotFound, "member")
+	}
+	return &types.QueryMixedPointsResponse{Points: uint64(*points)}, nil
+}
+
+// MixerConfig queries the configuration of the mixer contract
+func (q Querier) MixerConfig(c context.Context, req *types.QueryMixerConfigRequest) (*types.QueryMixerConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mixer := q.keeper.MixerContract(ctx)
+	groups, err := mixer.QueryGroups(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	total, err := mixer.QueryTotalPoints(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	function, err := mixer.QueryFunction(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rsp := &types.QueryMixerConfigResponse{
+		StakingContractAddress:    groups.Right,
+		EngagementContractAddress: groups.Left,
+		TotalPoints:               uint64(total),
+		FunctionType:              function.Name(),
+	}
+	if s := function.Sigmoid; s != nil {
+		rsp.Sigmoid = &types.MixerContractConfig_Sigmoid{MaxPoints: s.MaxPoints, P: s.P, S: s.S}
+	}
+	return rsp, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -519,3 +520,695 @@ func TestValidatorEngagementReward(t *testing.T) {
 		})
 	}
 }
+
+func TestQuerySigningInfo(t *testing.T) {
+	var myConsAddr sdk.ConsAddress = rand.Bytes(address.Len)
+	myInfo := types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 2, MissedBlocksCounter: 1, MissedBlocks: []byte{1}}
+	specs := map[string]struct {
+		src    *types.QuerySigningInfoRequest
+		exp    *types.QuerySigningInfoResponse
+		expErr error
+	}{
+		"found": {
+			src: &types.QuerySigningInfoRequest{ConsAddress: myConsAddr.String()},
+			exp: &types.QuerySigningInfoResponse{ValSigningInfo: myInfo, SignedBlocksWindow: 8},
+		},
+		"not found": {
+			src:    &types.QuerySigningInfoRequest{ConsAddress: sdk.ConsAddress(rand.Bytes(address.Len)).String()},
+			expErr: status.Error(codes.NotFound, "not found"),
+		},
+		"empty address": {
+			src:    &types.QuerySigningInfoRequest{},
+			expErr: status.Error(codes.InvalidArgument, "address cannot be empty"),
+		},
+		"invalid address": {
+			src:    &types.QuerySigningInfoRequest{ConsAddress: "invalid"},
+			expErr: status.Error(codes.InvalidArgument, "address invalid"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				GetValidatorSigningInfoFn: func(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool) {
+					if consAddr.Equals(myConsAddr) {
+						return myInfo, true
+					}
+					return types.ValidatorSigningInfo{}, false
+				},
+				SignedBlocksWindowFn: func(ctx sdk.Context) uint32 { return 8 },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.SigningInfo(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryHistoricalInfos(t *testing.T) {
+	myHist := stakingtypes.HistoricalInfo{Header: tmproto.Header{Height: 2}}
+	specs := map[string]struct {
+		src    *types.QueryHistoricalInfosRequest
+		exp    *types.QueryHistoricalInfosResponse
+		expErr error
+	}{
+		"range": {
+			src: &types.QueryHistoricalInfosRequest{MinHeight: 1, MaxHeight: 3},
+			exp: &types.QueryHistoricalInfosResponse{Hists: []stakingtypes.HistoricalInfo{myHist}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		"unbounded": {
+			src: &types.QueryHistoricalInfosRequest{},
+			exp: &types.QueryHistoricalInfosResponse{Hists: []stakingtypes.HistoricalInfo{myHist}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		"min greater than max": {
+			src:    &types.QueryHistoricalInfosRequest{MinHeight: 3, MaxHeight: 1},
+			expErr: status.Error(codes.InvalidArgument, "min height must not be greater than max height"),
+		},
+		"negative height": {
+			src:    &types.QueryHistoricalInfosRequest{MinHeight: -1},
+			expErr: status.Error(codes.InvalidArgument, "height must not be negative"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				PaginatedHistoricalInfosFn: func(ctx sdk.Context, minHeight, maxHeight int64, pagination *query.PageRequest) ([]stakingtypes.HistoricalInfo, *query.PageResponse, error) {
+					require.Equal(t, spec.src.MinHeight, minHeight)
+					require.Equal(t, spec.src.MaxHeight, maxHeight)
+					return []stakingtypes.HistoricalInfo{myHist}, &query.PageResponse{Total: 1}, nil
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.HistoricalInfos(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryValsetEpoch(t *testing.T) {
+	specs := map[string]struct {
+		src    *types.QueryValsetEpochRequest
+		mock   func(ctx sdk.Context) (*contract.ValsetEpochResponse, error)
+		exp    *types.QueryValsetEpochResponse
+		expErr error
+	}{
+		"all good": {
+			src: &types.QueryValsetEpochRequest{},
+			mock: func(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+				return &contract.ValsetEpochResponse{EpochLength: 60, CurrentEpoch: 10, LastUpdateTime: 600, LastUpdateHeight: 5}, nil
+			},
+			exp: &types.QueryValsetEpochResponse{
+				EpochLength:      time.Minute,
+				CurrentEpoch:     10,
+				LastUpdateTime:   time.Unix(600, 0).UTC(),
+				LastUpdateHeight: 5,
+				NextUpdateTime:   time.Unix(660, 0).UTC(),
+			},
+		},
+		"contract error": {
+			src: &types.QueryValsetEpochRequest{},
+			mock: func(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract {
+					return poetesting.ValsetContractMock{QueryEpochFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.ValsetEpoch(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryValsetConfig(t *testing.T) {
+	var myAddr sdk.AccAddress = rand.Bytes(address.Len)
+	specs := map[string]struct {
+		src    *types.QueryValsetConfigRequest
+		mock   func(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
+		exp    *types.QueryValsetConfigResponse
+		expErr error
+	}{
+		"all good": {
+			src: &types.QueryValsetConfigRequest{},
+			mock: func(ctx sdk.Context) (*contract.ValsetConfigResponse, error) {
+				return &contract.ValsetConfigResponse{
+					Membership:            myAddr.String(),
+					MinPoints:             1,
+					MaxValidators:         2,
+					Scaling:               3,
+					EpochReward:           sdk.NewInt64Coin("utgd", 4),
+					FeePercentage:         sdk.MustNewDecFromStr("0.5"),
+					DistributionContracts: []contract.DistributionContract{{Address: myAddr.String(), Ratio: sdk.MustNewDecFromStr("0.1")}},
+					ValidatorGroup:        myAddr.String(),
+					AutoUnjail:            true,
+				}, nil
+			},
+			exp: &types.QueryValsetConfigResponse{
+				Membership:            myAddr.String(),
+				MinPoints:             1,
+				MaxValidators:         2,
+				Scaling:               3,
+				EpochReward:           sdk.NewInt64Coin("utgd", 4),
+				FeePercentage:         sdk.MustNewDecFromStr("0.5"),
+				DistributionContracts: []types.RewardDistributionContract{{Address: myAddr.String(), Ratio: sdk.MustNewDecFromStr("0.1")}},
+				ValidatorGroup:        myAddr.String(),
+				AutoUnjail:            true,
+			},
+		},
+		"contract error": {
+			src: &types.QueryValsetConfigRequest{},
+			mock: func(ctx sdk.Context) (*contract.ValsetConfigResponse, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract {
+					return poetesting.ValsetContractMock{QueryConfigFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.ValsetConfig(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQuerySimulateNextValidatorSet(t *testing.T) {
+	var myOpAddr sdk.AccAddress = rand.Bytes(address.Len)
+	pubKey := ed25519.GenPrivKey().PubKey()
+	specs := map[string]struct {
+		src    *types.QuerySimulateNextValidatorSetRequest
+		mock   func(ctx sdk.Context) ([]contract.ValidatorInfo, error)
+		exp    *types.QuerySimulateNextValidatorSetResponse
+		expErr error
+	}{
+		"all good": {
+			src: &types.QuerySimulateNextValidatorSetRequest{},
+			mock: func(ctx sdk.Context) ([]contract.ValidatorInfo, error) {
+				return []contract.ValidatorInfo{{Operator: myOpAddr.String(), ValidatorPubkey: contract.ValidatorPubkey{Ed25519: pubKey.Bytes()}, Power: 7}}, nil
+			},
+			exp: &types.QuerySimulateNextValidatorSetResponse{Validators: []types.ValidatorPower{
+				{OperatorAddress: myOpAddr.String(), ConsAddress: sdk.ConsAddress(pubKey.Address()).String(), Power: 7},
+			}},
+		},
+		"empty set": {
+			src: &types.QuerySimulateNextValidatorSetRequest{},
+			mock: func(ctx sdk.Context) ([]contract.ValidatorInfo, error) {
+				return nil, nil
+			},
+			exp: &types.QuerySimulateNextValidatorSetResponse{Validators: []types.ValidatorPower{}},
+		},
+		"unsupported pubkey": {
+			src: &types.QuerySimulateNextValidatorSetRequest{},
+			mock: func(ctx sdk.Context) ([]contract.ValidatorInfo, error) {
+				return []contract.ValidatorInfo{{Operator: myOpAddr.String(), Power: 7}}, nil
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"contract error": {
+			src: &types.QuerySimulateNextValidatorSetRequest{},
+			mock: func(ctx sdk.Context) ([]contract.ValidatorInfo, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract {
+					return poetesting.ValsetContractMock{SimulateActiveValidatorsFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.SimulateNextValidatorSet(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQuerySlashings(t *testing.T) {
+	mySlashing := types.Slashing{
+		OperatorAddress: RandomAddress(t).String(),
+		Height:          2,
+		Portion:         sdk.NewDecWithPrec(1, 1),
+		EvidenceType:    "duplicate_vote",
+	}
+	specs := map[string]struct {
+		src     *types.QuerySlashingsRequest
+		mockErr error
+		exp     *types.QuerySlashingsResponse
+		expErr  error
+	}{
+		"all good": {
+			src: &types.QuerySlashingsRequest{Pagination: &query.PageRequest{Limit: 1}},
+			exp: &types.QuerySlashingsResponse{Slashings: []types.Slashing{mySlashing}, Pagination: &query.PageResponse{Total: 1}},
+		},
+		"keeper error": {
+			src:     &types.QuerySlashingsRequest{},
+			mockErr: errors.New("testing"),
+			expErr:  status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				PaginatedSlashingsFn: func(ctx sdk.Context, pagination *query.PageRequest) ([]types.Slashing, *query.PageResponse, error) {
+					require.Equal(t, spec.src.Pagination, pagination)
+					if spec.mockErr != nil {
+						return nil, nil, spec.mockErr
+					}
+					return []types.Slashing{mySlashing}, &query.PageResponse{Total: 1}, nil
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.Slashings(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryEngagementPoints(t *testing.T) {
+	myAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src    *types.QueryEngagementPointsRequest
+		mock   func(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+		exp    *types.QueryEngagementPointsResponse
+		expErr error
+	}{
+		"member": {
+			src: &types.QueryEngagementPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				require.Equal(t, myAddr, addr)
+				points := 10
+				return &points, nil
+			},
+			exp: &types.QueryEngagementPointsResponse{Points: 10},
+		},
+		"not a member": {
+			src: &types.QueryEngagementPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				return nil, nil
+			},
+			expErr: status.Error(codes.NotFound, "member"),
+		},
+		"contract error": {
+			src: &types.QueryEngagementPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"invalid address": {
+			src:    &types.QueryEngagementPointsRequest{Address: "invalid"},
+			expErr: status.Error(codes.InvalidArgument, "address invalid"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{QueryMemberFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.EngagementPoints(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryListEngagementMembers(t *testing.T) {
+	myAddr, otherAddr := RandomAddress(t), RandomAddress(t)
+	specs := map[string]struct {
+		src    *types.QueryListEngagementMembersRequest
+		mock   func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error)
+		exp    *types.QueryListEngagementMembersResponse
+		expErr error
+	}{
+		"all members": {
+			src: &types.QueryListEngagementMembersRequest{},
+			mock: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+				assert.Nil(t, pagination)
+				return []contract.TG4Member{{Addr: myAddr.String(), Points: 1}, {Addr: otherAddr.String(), Points: 2, StartHeight: 3}}, nil, nil
+			},
+			exp: &types.QueryListEngagementMembersResponse{
+				Members: []types.TG4Member{{Address: myAddr.String(), Points: 1}, {Address: otherAddr.String(), Points: 2}},
+			},
+		},
+		"paginated": {
+			src: &types.QueryListEngagementMembersRequest{Pagination: &query.PageRequest{Key: []byte("my-key"), Limit: 1}},
+			mock: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+				assert.Equal(t, &contract.Paginator{StartAfter: []byte("my-key"), Limit: 1}, pagination)
+				return []contract.TG4Member{{Addr: otherAddr.String(), Points: 2}}, contract.PaginationCursor(otherAddr.String()), nil
+			},
+			exp: &types.QueryListEngagementMembersResponse{
+				Members:    []types.TG4Member{{Address: otherAddr.String(), Points: 2}},
+				Pagination: &query.PageResponse{NextKey: []byte(otherAddr.String())},
+			},
+		},
+		"contract error": {
+			src: &types.QueryListEngagementMembersRequest{},
+			mock: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+				return nil, nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{ListMembersFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.ListEngagementMembers(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryEngagementHalflife(t *testing.T) {
+	specs := map[string]struct {
+		src    *types.QueryEngagementHalflifeRequest
+		mock   func(ctx sdk.Context) (*contract.HalflifeResponse, error)
+		exp    *types.QueryEngagementHalflifeResponse
+		expErr error
+	}{
+		"halflife set": {
+			src: &types.QueryEngagementHalflifeRequest{},
+			mock: func(ctx sdk.Context) (*contract.HalflifeResponse, error) {
+				return &contract.HalflifeResponse{HalflifeInfo: &contract.HalflifeInfo{
+					LastHalflife: uint64(time.Unix(1000, 0).UnixNano()),
+					Halflife:     60,
+					NextHalflife: uint64(time.Unix(1060, 0).UnixNano()),
+				}}, nil
+			},
+			exp: &types.QueryEngagementHalflifeResponse{
+				Halflife:     time.Minute,
+				LastHalflife: time.Unix(1000, 0).UTC(),
+				NextHalflife: time.Unix(1060, 0).UTC(),
+			},
+		},
+		"no halflife": {
+			src: &types.QueryEngagementHalflifeRequest{},
+			mock: func(ctx sdk.Context) (*contract.HalflifeResponse, error) {
+				return &contract.HalflifeResponse{}, nil
+			},
+			exp: &types.QueryEngagementHalflifeResponse{},
+		},
+		"contract error": {
+			src: &types.QueryEngagementHalflifeRequest{},
+			mock: func(ctx sdk.Context) (*contract.HalflifeResponse, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{QueryHalflifeFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.EngagementHalflife(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryMixedPoints(t *testing.T) {
+	myAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src    *types.QueryMixedPointsRequest
+		mock   func(ctx sdk.Context, addr sdk.AccAddress) (*int, error)
+		exp    *types.QueryMixedPointsResponse
+		expErr error
+	}{
+		"member": {
+			src: &types.QueryMixedPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				require.Equal(t, myAddr, addr)
+				points := 316
+				return &points, nil
+			},
+			exp: &types.QueryMixedPointsResponse{Points: 316},
+		},
+		"not a member": {
+			src: &types.QueryMixedPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				return nil, nil
+			},
+			expErr: status.Error(codes.NotFound, "member"),
+		},
+		"contract error": {
+			src: &types.QueryMixedPointsRequest{Address: myAddr.String()},
+			mock: func(ctx sdk.Context, addr sdk.AccAddress) (*int, error) {
+				return nil, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"invalid address": {
+			src:    &types.QueryMixedPointsRequest{Address: "invalid"},
+			expErr: status.Error(codes.InvalidArgument, "address invalid"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				MixerContractFn: func(ctx sdk.Context) MixerContract {
+					return poetesting.MixerContractMock{QueryMemberFn: spec.mock}
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.MixedPoints(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryMixerConfig(t *testing.T) {
+	stakingAddr, engagementAddr := RandomAddress(t), RandomAddress(t)
+	groupsMock := func(ctx sdk.Context) (*contract.MixerGroupsResponse, error) {
+		return &contract.MixerGroupsResponse{Left: engagementAddr.String(), Right: stakingAddr.String()}, nil
+	}
+	totalMock := func(ctx sdk.Context) (int, error) {
+		return 1234, nil
+	}
+	mySigmoid := contract.Sigmoid{MaxPoints: 1000, P: sdk.MustNewDecFromStr("0.68"), S: sdk.MustNewDecFromStr("0.00003")}
+	functionMock := func(ctx sdk.Context) (*contract.MixerFunction, error) {
+		return &contract.MixerFunction{Sigmoid: &mySigmoid}, nil
+	}
+	specs := map[string]struct {
+		src    *types.QueryMixerConfigRequest
+		mock   poetesting.MixerContractMock
+		exp    *types.QueryMixerConfigResponse
+		expErr error
+	}{
+		"all good": {
+			src:  &types.QueryMixerConfigRequest{},
+			mock: poetesting.MixerContractMock{QueryGroupsFn: groupsMock, QueryTotalPointsFn: totalMock, QueryFunctionFn: functionMock},
+			exp: &types.QueryMixerConfigResponse{
+				StakingContractAddress:    stakingAddr.String(),
+				EngagementContractAddress: engagementAddr.String(),
+				TotalPoints:               1234,
+				FunctionType:              "sigmoid",
+				Sigmoid: &types.MixerContractConfig_Sigmoid{
+					MaxPoints: 1000,
+					P:         sdk.MustNewDecFromStr("0.68"),
+					S:         sdk.MustNewDecFromStr("0.00003"),
+				},
+			},
+		},
+		"other function type": {
+			src: &types.QueryMixerConfigRequest{},
+			mock: poetesting.MixerContractMock{QueryGroupsFn: groupsMock, QueryTotalPointsFn: totalMock, QueryFunctionFn: func(ctx sdk.Context) (*contract.MixerFunction, error) {
+				return &contract.MixerFunction{GeometricMean: &struct{}{}}, nil
+			}},
+			exp: &types.QueryMixerConfigResponse{
+				StakingContractAddress:    stakingAddr.String(),
+				EngagementContractAddress: engagementAddr.String(),
+				TotalPoints:               1234,
+				FunctionType:              "geometric_mean",
+			},
+		},
+		"function query fails": {
+			src: &types.QueryMixerConfigRequest{},
+			mock: poetesting.MixerContractMock{QueryGroupsFn: groupsMock, QueryTotalPointsFn: totalMock, QueryFunctionFn: func(ctx sdk.Context) (*contract.MixerFunction, error) {
+				return nil, errors.New("testing")
+			}},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"groups query fails": {
+			src: &types.QueryMixerConfigRequest{},
+			mock: poetesting.MixerContractMock{QueryGroupsFn: func(ctx sdk.Context) (*contract.MixerGroupsResponse, error) {
+				return nil, errors.New("testing")
+			}},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"total points query fails": {
+			src: &types.QueryMixerConfigRequest{},
+			mock: poetesting.MixerContractMock{QueryGroupsFn: groupsMock, QueryTotalPointsFn: func(ctx sdk.Context) (int, error) {
+				return 0, errors.New("testing")
+			}},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				MixerContractFn: func(ctx sdk.Context) MixerContract {
+					return spec.mock
+				},
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.MixerConfig(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, status.Code(spec.expErr), status.Code(gotErr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"math/bits"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// GetValidatorSigningInfo returns the liveness data for the given consensus address
+func (k *Keeper) GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(getSigningInfoKey(consAddr))
+	if bz == nil {
+		return types.ValidatorSigningInfo{}, false
+	}
+	var info types.ValidatorSigningInfo
+	k.codec.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// IterateValidatorSigningInfos iterates over the liveness data of all validators. The callback returns true to stop early
+func (k *Keeper) IterateValidatorSigningInfos(ctx sdk.Context, cb func(types.ValidatorSigningInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SigningInfoKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.ValidatorSigningInfo
+		k.codec.MustUnmarshal(iter.Value(), &info)
+		// cb returns true to stop early
+		if cb(info) {
+			return
+		}
+	}
+}
+
+// PaginatedValidatorSigningInfos returns a page of liveness data
+func (k *Keeper) PaginatedValidatorSigningInfos(ctx sdk.Context, pagination *query.PageRequest) ([]types.ValidatorSigningInfo, *query.PageResponse, error) {
+	var result []types.ValidatorSigningInfo
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SigningInfoKey)
+	pageRes, err := query.Paginate(prefixStore, pagination, func(_, value []byte) error {
+		var info types.ValidatorSigningInfo
+		if err := k.codec.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		result = append(result, info)
+		return nil
+	})
+	return result, pageRes, err
+}
+
+// TrackValidatorSignatures updates the missed blocks bitmap of each validator in the last commit.
+// Nothing is tracked when the signed blocks window param is 0.
+func (k *Keeper) TrackValidatorSignatures(ctx sdk.Context, votes []abcitypes.VoteInfo) {
+	window := k.SignedBlocksWindow(ctx)
+	if window == 0 {
+		return
+	}
+	for _, v := range votes {
+		consAddr := sdk.ConsAddress(v.Validator.Address)
+		info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			info = types.ValidatorSigningInfo{
+				Address:     consAddr.String(),
+				StartHeight: ctx.BlockHeight(),
+			}
+		}
+		if info.WindowSize != window {
+			// window param has changed, start over
+			info.WindowSize, info.IndexOffset, info.MissedBlocks = window, 0, make([]byte, bitmapLen(window))
+		}
+		index := info.IndexOffset % int64(window)
+		setBit(info.MissedBlocks, index, !v.SignedLastBlock)
+		info.MissedBlocksCounter = countBits(info.MissedBlocks)
+		info.IndexOffset++
+		k.setValidatorSigningInfo(ctx, consAddr, info)
+	}
+}
+
+// importValidatorSigningInfo stores the liveness data from genesis
+func (k *Keeper) importValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) error {
+	if err := info.ValidateBasic(); err != nil {
+		return err
+	}
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if ctx.KVStore(k.storeKey).Has(getSigningInfoKey(consAddr)) {
+		return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "signing info for %s", info.Address)
+	}
+	k.setValidatorSigningInfo(ctx, consAddr, info)
+	return nil
+}
+
+func (k *Keeper) setValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) {
+	ctx.KVStore(k.storeKey).Set(getSigningInfoKey(consAddr), k.codec.MustMarshal(&info))
+}
+
+// getSigningInfoKey returns the key for the liveness data
+// `<prefix><len(consAddr)><consAddr>`
+func getSigningInfoKey(consAddr sdk.ConsAddress) []byte {
+	r := append([]byte{}, types.SigningInfoKey...)
+	return append(r, address.MustLengthPrefix(consAddr)...)
+}
+
+// bitmapLen returns the number of bytes to store a bit for each block in the window
+func bitmapLen(window uint32) int {
+	return int((window + 7) / 8)
+}
+
+func setBit(bitmap []byte, pos int64, v bool) {
+	if v {
+		bitmap[pos/8] |= 1 << (pos % 8)
+	} else {
+		bitmap[pos/8] &^= 1 << (pos % 8)
+	}
+}
+
+func countBits(bitmap []byte) int64 {
+	var r int64
+	for _, b := range bitmap {
+		r += int64(bits.OnesCount8(b))
+	}
+	return r
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestTrackValidatorSignatures(t *testing.T) {
+	var myConsAddr sdk.ConsAddress = rand.Bytes(address.Len)
+	vote := func(signed bool) []abcitypes.VoteInfo {
+		return []abcitypes.VoteInfo{{Validator: abcitypes.Validator{Address: myConsAddr, Power: 1}, SignedLastBlock: signed}}
+	}
+	specs := map[string]struct {
+		window   uint32
+		existing *types.ValidatorSigningInfo
+		signed   bool
+		exp      *types.ValidatorSigningInfo
+	}{
+		"first block signed": {
+			window: 10,
+			signed: true,
+			exp:    &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 100, IndexOffset: 1, MissedBlocks: []byte{0, 0}, WindowSize: 10},
+		},
+		"first block missed": {
+			window: 10,
+			exp:    &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 100, IndexOffset: 1, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0}, WindowSize: 10},
+		},
+		"missed block added": {
+			window:   10,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 9, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0}, WindowSize: 10},
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 10, MissedBlocksCounter: 2, MissedBlocks: []byte{1, 2}, WindowSize: 10},
+		},
+		"window wraps around": {
+			window:   10,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 10, MissedBlocksCounter: 2, MissedBlocks: []byte{1, 2}, WindowSize: 10},
+			signed:   true,
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 11, MissedBlocksCounter: 1, MissedBlocks: []byte{0, 2}, WindowSize: 10},
+		},
+		"window shrunk within same bitmap length": {
+			window:   9,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 10, MissedBlocksCounter: 2, MissedBlocks: []byte{1, 2}, WindowSize: 10},
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 1, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0}, WindowSize: 9},
+		},
+		"window grown within same bitmap length": {
+			window:   16,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 10, MissedBlocksCounter: 2, MissedBlocks: []byte{1, 2}, WindowSize: 10},
+			signed:   true,
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 1, MissedBlocks: []byte{0, 0}, WindowSize: 16},
+		},
+		"window changed": {
+			window:   20,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 10, MissedBlocksCounter: 2, MissedBlocks: []byte{1, 2}, WindowSize: 10},
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 1, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0, 0}, WindowSize: 20},
+		},
+		"window size not stored before": {
+			window:   10,
+			existing: &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 9, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0}},
+			exp:      &types.ValidatorSigningInfo{Address: myConsAddr.String(), StartHeight: 1, IndexOffset: 1, MissedBlocksCounter: 1, MissedBlocks: []byte{1, 0}, WindowSize: 10},
+		},
+		"tracking disabled": {
+			window: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example := CreateDefaultTestInput(t)
+			k := example.PoEKeeper
+			ctx = ctx.WithBlockHeight(100)
+			params := types.DefaultParams()
+			params.SignedBlocksWindow = spec.window
+			k.setParams(ctx, params)
+			if spec.existing != nil {
+				require.NoError(t, k.importValidatorSigningInfo(ctx, *spec.existing))
+			}
+			// when
+			k.TrackValidatorSignatures(ctx, vote(spec.signed))
+			// then
+			got, found := k.GetValidatorSigningInfo(ctx, myConsAddr)
+			if spec.exp == nil {
+				assert.False(t, found)
+				return
+			}
+			require.True(t, found)
+			assert.Equal(t, *spec.exp, got)
+		})
+	}
+}
+
+func TestValidatorSigningInfoGenesisRoundtrip(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	ctx = ctx.WithBlockHeight(1)
+	var myConsAddr, otherConsAddr sdk.ConsAddress = rand.Bytes(address.Len), rand.Bytes(address.Len)
+	k.TrackValidatorSignatures(ctx, []abcitypes.VoteInfo{
+		{Validator: abcitypes.Validator{Address: myConsAddr, Power: 1}, SignedLastBlock: true},
+		{Validator: abcitypes.Validator{Address: otherConsAddr, Power: 1}, SignedLastBlock: false},
+	})
+	var all []types.ValidatorSigningInfo
+	k.IterateValidatorSigningInfos(ctx, func(info types.ValidatorSigningInfo) bool {
+		all = append(all, info)
+		return false
+	})
+	require.Len(t, all, 2)
+
+	// when
+	page, pageRes, err := k.PaginatedValidatorSigningInfos(ctx, &query.PageRequest{Limit: 1})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, all[:1], page)
+	assert.NotEmpty(t, pageRes.NextKey)
+
+	// and import into a fresh store
+	ctx, example = CreateDefaultTestInput(t)
+	k = example.PoEKeeper
+	for _, v := range all {
+		require.NoError(t, k.importValidatorSigningInfo(ctx, v))
+	}
+	got, found := k.GetValidatorSigningInfo(ctx, otherConsAddr)
+	require.True(t, found)
+	assert.Equal(t, int64(1), got.MissedBlocksCounter)
+	// and duplicates rejected
+	err = k.importValidatorSigningInfo(ctx, all[0])
+	assert.True(t, wasmtypes.ErrDuplicate.Is(err))
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+	twasmcontract "github.com/confio/tgrade/x/twasm/contract"
+)
+
+// SetBlockEvidence stores the evidence types of the byzantine validators reported for the current block so that
+// slashings within this block can be attributed to them. Entries of previous blocks are removed.
+func (k *Keeper) SetBlockEvidence(ctx sdk.Context, evidence []abcitypes.Evidence) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockEvidenceKey)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, e := range evidence {
+		var et twasmcontract.EvidenceType
+		switch e.Type {
+		case abcitypes.EvidenceType_DUPLICATE_VOTE:
+			et = twasmcontract.EvidenceDuplicateVote
+		case abcitypes.EvidenceType_LIGHT_CLIENT_ATTACK:
+			et = twasmcontract.EvidenceLightClientAttack
+		default:
+			continue
+		}
+		store.Set(address.MustLengthPrefix(e.Validator.Address), []byte(et))
+	}
+}
+
+// RecordSlashing stores a slashing of the given validator at the current height. The evidence type is set when the
+// validator was reported byzantine for the current block. Multiple slashings of a validator within a block are
+// stored as separate entries in the order they are recorded.
+func (k *Keeper) RecordSlashing(ctx sdk.Context, opAddr sdk.AccAddress, portion sdk.Dec) {
+	k.setSlashing(ctx, types.Slashing{
+		OperatorAddress: opAddr.String(),
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime().UTC(),
+		Portion:         portion,
+		EvidenceType:    k.blockEvidenceType(ctx, opAddr),
+	})
+}
+
+// blockEvidenceType returns the evidence type reported for the validator in the current block or empty string
+func (k *Keeper) blockEvidenceType(ctx sdk.Context, opAddr sdk.AccAddress) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockEvidenceKey)
+	iter := store.Iterator(nil, nil)
+	hasEvidence := iter.Valid()
+	iter.Close()
+	if !hasEvidence {
+		return ""
+	}
+	val, err := k.ValsetContract(ctx).QueryValidator(ctx, opAddr)
+	if err != nil || val == nil {
+		ModuleLogger(ctx).Error("failed to query slashed validator", "operator", opAddr.String(), "cause", err)
+		return ""
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		ModuleLogger(ctx).Error("failed to get slashed validator consensus address", "operator", opAddr.String(), "cause", err)
+		return ""
+	}
+	return string(store.Get(address.MustLengthPrefix(consAddr)))
+}
+
+// IterateSlashings iterates over all recorded slashings ordered by height. The callback returns true to stop early
+func (k *Keeper) IterateSlashings(ctx sdk.Context, cb func(types.Slashing) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashingKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var s types.Slashing
+		k.codec.MustUnmarshal(iter.Value(), &s)
+		// cb returns true to stop early
+		if cb(s) {
+			return
+		}
+	}
+}
+
+// PaginatedSlashings returns a page of the recorded slashings ordered by height
+func (k *Keeper) PaginatedSlashings(ctx sdk.Context, pagination *query.PageRequest) ([]types.Slashing, *query.PageResponse, error) {
+	var result []types.Slashing
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashingKey)
+	pageRes, err := query.Paginate(prefixStore, pagination, func(_, value []byte) error {
+		var s types.Slashing
+		if err := k.codec.Unmarshal(value, &s); err != nil {
+			return err
+		}
+		result = append(result, s)
+		return nil
+	})
+	return result, pageRes, err
+}
+
+// importSlashing stores a slashing from genesis
+func (k *Keeper) importSlashing(ctx sdk.Context, s types.Slashing) error {
+	if err := s.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(s.OperatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "operator address")
+	}
+	k.setSlashing(ctx, s)
+	return nil
+}
+
+// setSlashing stores the slashing with the next sequence for the validator and height
+func (k *Keeper) setSlashing(ctx sdk.Context, s types.Slashing) {
+	opAddr := sdk.MustAccAddressFromBech32(s.OperatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	iter := sdk.KVStorePrefixIterator(store, getSlashingsPrefix(s.Height, opAddr))
+	for ; iter.Valid(); iter.Next() {
+		seq++
+	}
+	iter.Close()
+	store.Set(getSlashingKey(s.Height, opAddr, seq), k.codec.MustMarshal(&s))
+}
+
+// getSlashingsPrefix returns the prefix for all slashings of the validator at the height
+// `<prefix><height><len(opAddr)><opAddr>`
+func getSlashingsPrefix(height int64, opAddr sdk.AccAddress) []byte {
+	r := append([]byte{}, types.SlashingKey...)
+	r = append(r, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(r, address.MustLengthPrefix(opAddr)...)
+}
+
+// getSlashingKey returns the key for a slashing. The big endian height keeps the entries ordered by height and
+// the sequence separates multiple slashings of a validator at the same height.
+// `<prefix><height><len(opAddr)><opAddr><seq>`
+func getSlashingKey(height int64, opAddr sdk.AccAddress, seq uint64) []byte {
+	return append(getSlashingsPrefix(height, opAddr), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// SlashingRecorder records the validator slashings
+type SlashingRecorder interface {
+	GetPoEContractAddress(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error)
+	RecordSlashing(ctx sdk.Context, opAddr sdk.AccAddress, portion sdk.Dec)
+}
+
+var _ wasmkeeper.Messenger = SlashingRecorderMessenger{}
+
+// SlashingRecorderMessenger decorates a messenger to record the slash messages that the valset contract sends to
+// the membership contracts. This covers slashings for byzantine evidence as well as punishments.
+type SlashingRecorderMessenger struct {
+	nested   wasmkeeper.Messenger
+	recorder SlashingRecorder
+}
+
+// NewSlashingRecorderMessenger constructor
+func NewSlashingRecorderMessenger(nested wasmkeeper.Messenger, recorder SlashingRecorder) SlashingRecorderMessenger {
+	return SlashingRecorderMessenger{nested: nested, recorder: recorder}
+}
+
+// DispatchMsg dispatches the message to the nested messenger and records a slashing when the valset contract
+// has successfully sent a slash message
+func (h SlashingRecorderMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	events, data, err := h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	if err != nil || msg.Wasm == nil || msg.Wasm.Execute == nil {
+		return events, data, err
+	}
+	valsetAddr, addrErr := h.recorder.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	if addrErr != nil || !valsetAddr.Equals(contractAddr) {
+		return events, data, nil
+	}
+	var exec contract.TG4SlasherExecute
+	if json.Unmarshal(msg.Wasm.Execute.Msg, &exec) != nil || exec.Slash == nil {
+		return events, data, nil
+	}
+	// the valset contract sends the same slash message to the mixer and to the distribution contracts.
+	// Only the one to the mixer is recorded so that a slashing is not stored multiple times.
+	mixerAddr, addrErr := h.recorder.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	if addrErr != nil || mixerAddr.String() != msg.Wasm.Execute.ContractAddr {
+		return events, data, nil
+	}
+	opAddr, addrErr := sdk.AccAddressFromBech32(exec.Slash.Addr)
+	if addrErr != nil {
+		ModuleLogger(ctx).Error("ignored slash with invalid address", "addr", exec.Slash.Addr, "cause", addrErr)
+		return events, data, nil
+	}
+	h.recorder.RecordSlashing(ctx, opAddr, exec.Slash.Portion)
+	return events, data, nil
+}
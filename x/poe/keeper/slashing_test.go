@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestSlashingRecorderMessenger(t *testing.T) {
+	var valsetAddr, mixerAddr, otherAddr, myOpAddr sdk.AccAddress = rand.Bytes(address.Len), rand.Bytes(address.Len), rand.Bytes(address.Len), rand.Bytes(address.Len)
+	execMsgTo := func(contractAddr sdk.AccAddress, msg string) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+			ContractAddr: contractAddr.String(),
+			Msg:          []byte(msg),
+			Funds:        wasmvmtypes.Coins{},
+		}}}
+	}
+	execMsg := func(msg string) wasmvmtypes.CosmosMsg {
+		return execMsgTo(mixerAddr, msg)
+	}
+	slashMsg := execMsg(`{"slash":{"addr":"` + myOpAddr.String() + `","portion":"0.1"}}`)
+	specs := map[string]struct {
+		sender    sdk.AccAddress
+		msg       wasmvmtypes.CosmosMsg
+		nestedErr error
+		expErr    bool
+		exp       []types.Slashing
+	}{
+		"slash from valset": {
+			sender: valsetAddr,
+			msg:    slashMsg,
+			exp: []types.Slashing{{
+				OperatorAddress: myOpAddr.String(),
+				Height:          100,
+				Time:            time.Unix(1000, 0).UTC(),
+				Portion:         sdk.NewDecWithPrec(1, 1),
+			}},
+		},
+		"slash from other contract": {
+			sender: otherAddr,
+			msg:    slashMsg,
+		},
+		"slash to other contract than the mixer": {
+			sender: valsetAddr,
+			msg:    execMsgTo(otherAddr, `{"slash":{"addr":"`+myOpAddr.String()+`","portion":"0.1"}}`),
+		},
+		"other execute msg from valset": {
+			sender: valsetAddr,
+			msg:    execMsg(`{"distribute_rewards":{}}`),
+		},
+		"non wasm msg from valset": {
+			sender: valsetAddr,
+			msg:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}},
+		},
+		"invalid operator address": {
+			sender: valsetAddr,
+			msg:    execMsg(`{"slash":{"addr":"invalid","portion":"0.1"}}`),
+		},
+		"nested messenger fails": {
+			sender:    valsetAddr,
+			msg:       slashMsg,
+			nestedErr: errors.New("testing"),
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example := CreateDefaultTestInput(t)
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+			k := example.PoEKeeper
+			k.SetPoEContractAddress(ctx, types.PoEContractTypeValset, valsetAddr)
+			k.SetPoEContractAddress(ctx, types.PoEContractTypeMixer, mixerAddr)
+			nested := wasmkeeper.MessageHandlerFunc(func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+				return nil, nil, spec.nestedErr
+			})
+			// when
+			_, _, gotErr := NewSlashingRecorderMessenger(nested, k).DispatchMsg(ctx, spec.sender, "", spec.msg)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+			}
+			got, _, err := k.PaginatedSlashings(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestSetBlockEvidence(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	var myConsAddr, otherConsAddr sdk.ConsAddress = rand.Bytes(address.Len), rand.Bytes(address.Len)
+	storedEvidence := func(ctx sdk.Context) map[string]string {
+		r := make(map[string]string)
+		iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockEvidenceKey).Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			r[sdk.ConsAddress(iter.Key()[1:]).String()] = string(iter.Value())
+		}
+		return r
+	}
+	// when
+	k.SetBlockEvidence(ctx, []abcitypes.Evidence{
+		{Type: abcitypes.EvidenceType_DUPLICATE_VOTE, Validator: abcitypes.Validator{Address: myConsAddr}},
+		{Type: abcitypes.EvidenceType_LIGHT_CLIENT_ATTACK, Validator: abcitypes.Validator{Address: otherConsAddr}},
+		{Type: abcitypes.EvidenceType_UNKNOWN, Validator: abcitypes.Validator{Address: rand.Bytes(address.Len)}},
+	})
+	// then
+	exp := map[string]string{
+		myConsAddr.String():    "duplicate_vote",
+		otherConsAddr.String(): "light_client_attack",
+	}
+	assert.Equal(t, exp, storedEvidence(ctx))
+
+	// and cleared with the next block
+	k.SetBlockEvidence(ctx, nil)
+	assert.Empty(t, storedEvidence(ctx))
+}
+
+func TestSlashingsGenesisRoundtrip(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	var myOpAddr, otherOpAddr sdk.AccAddress = rand.Bytes(address.Len), rand.Bytes(address.Len)
+	k.RecordSlashing(ctx.WithBlockHeight(256), myOpAddr, sdk.NewDecWithPrec(1, 1))
+	k.RecordSlashing(ctx.WithBlockHeight(2), otherOpAddr, sdk.NewDecWithPrec(5, 1))
+	var all []types.Slashing
+	k.IterateSlashings(ctx, func(s types.Slashing) bool {
+		all = append(all, s)
+		return false
+	})
+	require.Len(t, all, 2)
+	// ordered by height
+	assert.Equal(t, int64(2), all[0].Height)
+	assert.Equal(t, otherOpAddr.String(), all[0].OperatorAddress)
+	assert.Equal(t, int64(256), all[1].Height)
+
+	// when
+	page, pageRes, err := k.PaginatedSlashings(ctx, &query.PageRequest{Limit: 1})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, all[:1], page)
+	assert.NotEmpty(t, pageRes.NextKey)
+
+	// and import into a fresh store
+	ctx, example = CreateDefaultTestInput(t)
+	k = example.PoEKeeper
+	for _, v := range all {
+		require.NoError(t, k.importSlashing(ctx, v))
+	}
+	got, _, err := k.PaginatedSlashings(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, all, got)
+}
+
+func TestRecordMultipleSlashingsAtSameHeight(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	ctx = ctx.WithBlockHeight(100)
+	var myOpAddr, otherOpAddr sdk.AccAddress = rand.Bytes(address.Len), rand.Bytes(address.Len)
+
+	// when
+	k.RecordSlashing(ctx, myOpAddr, sdk.NewDecWithPrec(5, 1))
+	k.RecordSlashing(ctx, otherOpAddr, sdk.NewDecWithPrec(2, 1))
+	k.RecordSlashing(ctx, myOpAddr, sdk.NewDecWithPrec(1, 2))
+
+	// then
+	got, _, err := k.PaginatedSlashings(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	var gotMine []sdk.Dec
+	for _, s := range got {
+		assert.Equal(t, int64(100), s.Height)
+		if s.OperatorAddress == myOpAddr.String() {
+			gotMine = append(gotMine, s.Portion)
+		}
+	}
+	assert.Equal(t, []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 2)}, gotMine)
+
+	// and both survive a genesis roundtrip
+	ctx2, example2 := CreateDefaultTestInput(t)
+	for _, v := range got {
+		require.NoError(t, example2.PoEKeeper.importSlashing(ctx2, v))
+	}
+	imported, _, err := example2.PoEKeeper.PaginatedSlashings(ctx2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, got, imported)
+}
@@ -7,6 +7,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/confio/tgrade/x/poe/types"
@@ -23,14 +24,22 @@ type PoEKeeperMock struct {
 	SetValidatorInitialEngagementPointsFn func(ctx sdk.Context, address sdk.AccAddress, value sdk.Coin) error
 	SetPoEContractAddressFn               func(ctx sdk.Context, ctype types.PoEContractType, contractAddr sdk.AccAddress)
 	setParamsFn                           func(ctx sdk.Context, params types.Params)
+	importValidatorSigningInfoFn          func(ctx sdk.Context, info types.ValidatorSigningInfo) error
+	importSlashingFn                      func(ctx sdk.Context, s types.Slashing) error
 	GetBondDenomFn                        func(ctx sdk.Context) string
 	HistoricalEntriesFn                   func(ctx sdk.Context) uint32
 	UnbondingTimeFn                       func(ctx sdk.Context) time.Duration
-	GetHistoricalInfoFn                   func(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+	GetHistoricalInfoWithValsetFn         func(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	DistributionContractFn                func(ctx sdk.Context) DistributionContract
 	ValsetContractFn                      func(ctx sdk.Context) ValsetContract
 	StakeContractFn                       func(ctx sdk.Context) StakeContract
 	EngagementContractFn                  func(ctx sdk.Context) EngagementContract
+	MixerContractFn                       func(ctx sdk.Context) MixerContract
+	SignedBlocksWindowFn                  func(ctx sdk.Context) uint32
+	FeeSplitFn                            func(ctx sdk.Context) types.FeeSplit
+	GetValidatorSigningInfoFn             func(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool)
+	PaginatedHistoricalInfosFn            func(ctx sdk.Context, minHeight, maxHeight int64, pagination *query.PageRequest) ([]stakingtypes.HistoricalInfo, *query.PageResponse, error)
+	PaginatedSlashingsFn                  func(ctx sdk.Context, pagination *query.PageRequest) ([]types.Slashing, *query.PageResponse, error)
 }
 
 func (m PoEKeeperMock) setParams(ctx sdk.Context, params types.Params) {
@@ -40,6 +49,20 @@ func (m PoEKeeperMock) setParams(ctx sdk.Context, params types.Params) {
 	m.setParamsFn(ctx, params)
 }
 
+func (m PoEKeeperMock) importValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) error {
+	if m.importValidatorSigningInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.importValidatorSigningInfoFn(ctx, info)
+}
+
+func (m PoEKeeperMock) importSlashing(ctx sdk.Context, s types.Slashing) error {
+	if m.importSlashingFn == nil {
+		panic("not expected to be called")
+	}
+	return m.importSlashingFn(ctx, s)
+}
+
 func (m PoEKeeperMock) GetPoEContractAddress(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
 	if m.GetPoEContractAddressFn == nil {
 		panic("not expected to be called")
@@ -68,11 +91,46 @@ func (m PoEKeeperMock) HistoricalEntries(ctx sdk.Context) uint32 {
 	return m.HistoricalEntriesFn(ctx)
 }
 
-func (m PoEKeeperMock) GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool) {
-	if m.GetHistoricalInfoFn == nil {
+func (m PoEKeeperMock) GetHistoricalInfoWithValset(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool) {
+	if m.GetHistoricalInfoWithValsetFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetHistoricalInfoWithValsetFn(ctx, height)
+}
+
+func (m PoEKeeperMock) SignedBlocksWindow(ctx sdk.Context) uint32 {
+	if m.SignedBlocksWindowFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SignedBlocksWindowFn(ctx)
+}
+
+func (m PoEKeeperMock) FeeSplit(ctx sdk.Context) types.FeeSplit {
+	if m.FeeSplitFn == nil {
+		panic("not expected to be called")
+	}
+	return m.FeeSplitFn(ctx)
+}
+
+func (m PoEKeeperMock) GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool) {
+	if m.GetValidatorSigningInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetValidatorSigningInfoFn(ctx, consAddr)
+}
+
+func (m PoEKeeperMock) PaginatedHistoricalInfos(ctx sdk.Context, minHeight, maxHeight int64, pagination *query.PageRequest) ([]stakingtypes.HistoricalInfo, *query.PageResponse, error) {
+	if m.PaginatedHistoricalInfosFn == nil {
+		panic("not expected to be called")
+	}
+	return m.PaginatedHistoricalInfosFn(ctx, minHeight, maxHeight, pagination)
+}
+
+func (m PoEKeeperMock) PaginatedSlashings(ctx sdk.Context, pagination *query.PageRequest) ([]types.Slashing, *query.PageResponse, error) {
+	if m.PaginatedSlashingsFn == nil {
 		panic("not expected to be called")
 	}
-	return m.GetHistoricalInfoFn(ctx, height)
+	return m.PaginatedSlashingsFn(ctx, pagination)
 }
 
 func (m PoEKeeperMock) SetValidatorInitialEngagementPoints(ctx sdk.Context, opAddr sdk.AccAddress, points sdk.Coin) error {
@@ -110,6 +168,13 @@ func (m PoEKeeperMock) EngagementContract(ctx sdk.Context) EngagementContract {
 	return m.EngagementContractFn(ctx)
 }
 
+func (m PoEKeeperMock) MixerContract(ctx sdk.Context) MixerContract {
+	if m.MixerContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.MixerContractFn(ctx)
+}
+
 // CapturedPoEContractAddress data type
 type CapturedPoEContractAddress struct {
 	Ctype        types.PoEContractType
@@ -172,6 +237,7 @@ type TwasmKeeperMock struct {
 	QuerySmartFn        func(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	SudoFn              func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	GetContractKeeperFn func() wasmtypes.ContractOpsKeeper
+	QueryRawFn          func(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 }
 
 func (m TwasmKeeperMock) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
@@ -188,6 +254,13 @@ func (m TwasmKeeperMock) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, m
 	return m.SudoFn(ctx, contractAddress, msg)
 }
 
+func (m TwasmKeeperMock) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	if m.QueryRawFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryRawFn(ctx, contractAddress, key)
+}
+
 func (m TwasmKeeperMock) GetContractKeeper() wasmtypes.ContractOpsKeeper {
 	if m.GetContractKeeperFn == nil {
 		panic("not expected to be called")
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The historical info keys are migrated from decimal string heights to 8 bytes big endian heights
+// so that they are ordered by height.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.HistoricalInfoKey)
+	type entry struct {
+		oldKey []byte
+		height uint64
+		value  []byte
+	}
+	var entries []entry
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		height, err := strconv.ParseUint(string(iter.Key()), 10, 64)
+		if err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(types.ErrInvalid, "historical info key %X: %s", iter.Key(), err)
+		}
+		entries = append(entries, entry{oldKey: iter.Key(), height: height, value: iter.Value()})
+	}
+	iter.Close()
+
+	for _, e := range entries {
+		store.Delete(e.oldKey)
+	}
+	for _, e := range entries {
+		store.Set(sdk.Uint64ToBigEndian(e.height), e.value)
+	}
+	return nil
+}
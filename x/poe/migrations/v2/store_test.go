@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/confio/tgrade/x/poe/migrations/v2"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	heights := []int64{1, 9, 10, 11, 100}
+	for _, h := range heights {
+		store.Set(append(types.HistoricalInfoKey, []byte(strconv.FormatInt(h, 10))...), []byte{byte(h)})
+	}
+	otherKey := append(types.ContractPrefix, []byte("other")...)
+	store.Set(otherKey, []byte("other"))
+
+	// when
+	err := v2.MigrateStore(ctx, storeKey)
+
+	// then
+	require.NoError(t, err)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iter.Close()
+	var gotHeights []int64
+	for ; iter.Valid(); iter.Next() {
+		h := int64(sdk.BigEndianToUint64(iter.Key()[len(types.HistoricalInfoKey):]))
+		assert.Equal(t, []byte{byte(h)}, iter.Value())
+		gotHeights = append(gotHeights, h)
+	}
+	assert.Equal(t, heights, gotHeights)
+	assert.Equal(t, []byte("other"), store.Get(otherKey))
+}
+
+func TestMigrateStoreInvalidKey(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	ctx.KVStore(storeKey).Set(append(types.HistoricalInfoKey, []byte("foo")...), []byte{1})
+
+	err := v2.MigrateStore(ctx, storeKey)
+	require.Error(t, err)
+}
@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateValidator{}, "tgrade/MsgUpdateValidator", nil)
 	cdc.RegisterConcrete(&MsgDelegate{}, "tgrade/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "tgrade/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "tgrade/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "tgrade/MsgSetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgUnjail{}, "tgrade/MsgUnjail", nil)
 }
 
 // RegisterInterfaces registers the x/poe interfaces types with the interface registry
@@ -27,6 +30,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateValidator{},
 		&MsgDelegate{},
 		&MsgUndelegate{},
+		&MsgClaimRewards{},
+		&MsgSetWithdrawAddress{},
+		&MsgUnjail{},
 	)
 	stakingtypes.RegisterInterfaces(registry)
 	slashingtypes.RegisterInterfaces(registry)
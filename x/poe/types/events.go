@@ -2,13 +2,23 @@ package types
 
 // staking module event types
 const (
-	EventTypeCreateValidator = "create_validator"
-	EventTypeUpdateValidator = "update_validator"
-	EventTypeDelegate        = "delegate"
-	EventTypeUndelegate      = "undelegate"
+	EventTypeCreateValidator    = "create_validator"
+	EventTypeUpdateValidator    = "update_validator"
+	EventTypeDelegate           = "delegate"
+	EventTypeUndelegate         = "undelegate"
+	EventTypeClaimRewards       = "claim_rewards"
+	EventTypeCompleteUnbonding  = "complete_unbonding"
+	EventTypeSetWithdrawAddress = "set_withdraw_address"
+	EventTypeUnjail             = "unjail"
+	EventTypeFeeSplit           = "fee_split"
 
-	AttributeKeyValOperator = "operator"
-	AttributeKeyMoniker     = "moniker"
-	AttributeKeyPubKeyHex   = "pubkey"
-	AttributeValueCategory  = ModuleName
+	AttributeKeyValOperator     = "operator"
+	AttributeKeyMoniker         = "moniker"
+	AttributeKeyPubKeyHex       = "pubkey"
+	AttributeKeyWithdrawAddress = "withdraw_address"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyFeeRecipient    = "recipient"
+	AttributeValueFeeBurn       = "burn"
+	AttributeValueCategory      = ModuleName
 )
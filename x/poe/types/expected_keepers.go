@@ -25,6 +25,7 @@ type TWasmKeeper interface {
 	SmartQuerier
 	Sudoer
 	GetContractKeeper() wasmtypes.ContractOpsKeeper
+	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 }
 
 // BankKeeper is a subset of the SDK bank keeper
@@ -34,6 +35,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, s string, addr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // AccountKeeper is a subset of the SDK account keeper
@@ -469,5 +469,20 @@ func (g ImportDump) ValidateBasic() error {
 	if len(uniqueContractTypes) != len(PoEContractType_name)-1 {
 		return sdkerrors.Wrap(wasmtypes.ErrInvalidGenesis, "PoE contract(s) missing")
 	}
+	uniqueSigningInfos := make(map[string]struct{}, len(g.SigningInfos))
+	for i, v := range g.SigningInfos {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "signing info %d", i)
+		}
+		if _, exists := uniqueSigningInfos[v.Address]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "signing info %s", v.Address)
+		}
+		uniqueSigningInfos[v.Address] = struct{}{}
+	}
+	for i, v := range g.Slashings {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "slashing %d", i)
+		}
+	}
 	return nil
 }
@@ -143,6 +143,10 @@ func (*GenesisState) XXX_OneofWrappers() []interface{} {
 type ImportDump struct {
 	// Contracts PoE contract addresses and types
 	Contracts []PoEContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// SigningInfos liveness data of the validators
+	SigningInfos []ValidatorSigningInfo `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	// Slashings history of the validator slashings
+	Slashings []Slashing `protobuf:"bytes,3,rep,name=slashings,proto3" json:"slashings,omitempty"`
 }
 
 func (m *ImportDump) Reset()         { *m = ImportDump{} }
@@ -190,6 +194,20 @@ func (m *ImportDump) GetContracts() []PoEContract {
 	return nil
 }
 
+func (m *ImportDump) GetSigningInfos() []ValidatorSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *ImportDump) GetSlashings() []Slashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
 // SeedContracts contains the contract configuration and group members to setup
 // all PoE contracts on chain.
 type SeedContracts struct {
@@ -1200,117 +1218,121 @@ func init() {
 func init() { proto.RegisterFile("confio/poe/v1beta1/genesis.proto", fileDescriptor_a165193bab811d9d) }
 
 var fileDescriptor_a165193bab811d9d = []byte{
-	// 1757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xbd, 0x6f, 0x1b, 0xc9,
-	0x15, 0xd7, 0x5a, 0x8a, 0x24, 0x3e, 0x92, 0x3a, 0xdf, 0x48, 0x3e, 0x53, 0x92, 0xcd, 0x55, 0xd6,
-	0x1f, 0x51, 0x2e, 0x36, 0x09, 0x3b, 0x06, 0x12, 0x5c, 0x82, 0x04, 0xa2, 0xe4, 0x3b, 0xc7, 0xb0,
-	0x73, 0xc2, 0x4a, 0x76, 0x71, 0x57, 0x2c, 0x86, 0xdc, 0xe1, 0x72, 0xce, 0xbb, 0x33, 0x7b, 0x3b,
-	0x43, 0x4a, 0xea, 0x02, 0x24, 0x65, 0x02, 0xa4, 0x48, 0x11, 0xa4, 0x3c, 0xa4, 0xca, 0x1f, 0x90,
-	0x2e, 0xfd, 0x95, 0x57, 0x06, 0x29, 0x98, 0x83, 0x8d, 0x34, 0xac, 0x02, 0x97, 0xa9, 0x82, 0x99,
-	0x1d, 0x92, 0x4b, 0x72, 0xf5, 0x61, 0x21, 0x55, 0x1a, 0x89, 0xf3, 0xf1, 0xfb, 0xbd, 0xdf, 0x9b,
-	0x79, 0x7c, 0xef, 0x0d, 0x61, 0xab, 0xc5, 0x59, 0x9b, 0xf2, 0x7a, 0xcc, 0x49, 0xbd, 0xf7, 0xa0,
-	0x49, 0x24, 0x7e, 0x50, 0x0f, 0x08, 0x23, 0x82, 0x8a, 0x5a, 0x9c, 0x70, 0xc9, 0x11, 0x4a, 0x77,
-	0xd4, 0x62, 0x4e, 0x6a, 0x66, 0xc7, 0xc6, 0x5a, 0xc0, 0x03, 0xae, 0x97, 0xeb, 0xea, 0x53, 0xba,
-	0x73, 0xa3, 0xda, 0xe2, 0x22, 0xe2, 0xa2, 0xde, 0xc4, 0x62, 0x4c, 0xd6, 0xe2, 0x94, 0x65, 0xd7,
-	0x8f, 0xb0, 0x88, 0xea, 0xfa, 0x4f, 0x6f, 0xca, 0xd2, 0xc6, 0x8d, 0x1c, 0x2d, 0xca, 0xaa, 0x41,
-	0x07, 0x9c, 0x07, 0x21, 0xa9, 0xeb, 0x51, 0xb3, 0xdb, 0xae, 0xfb, 0xdd, 0x04, 0x4b, 0xca, 0x0d,
-	0xbb, 0xf3, 0x2f, 0x0b, 0x4a, 0x9f, 0xa4, 0x7c, 0x07, 0x12, 0x4b, 0x82, 0x7e, 0x0c, 0x8b, 0x31,
-	0x4e, 0x70, 0x24, 0x2a, 0xd6, 0x96, 0xb5, 0x5d, 0x7c, 0xb8, 0x51, 0x9b, 0xf5, 0xa4, 0xb6, 0xaf,
-	0x77, 0x34, 0x16, 0xbe, 0xee, 0xdb, 0x73, 0xae, 0xd9, 0x8f, 0x9e, 0xc2, 0x8a, 0x20, 0xc4, 0xf7,
-	0x5a, 0x9c, 0xc9, 0x04, 0xb7, 0xa4, 0xa8, 0x5c, 0xd1, 0x0c, 0xdf, 0xcd, 0x63, 0x38, 0x20, 0xc4,
-	0xdf, 0x1d, 0x6e, 0x7c, 0x32, 0xe7, 0x96, 0x45, 0x76, 0x02, 0xed, 0x40, 0x91, 0x46, 0x31, 0x4f,
-	0xa4, 0xe7, 0x77, 0xa3, 0xb8, 0x32, 0xaf, 0x89, 0xaa, 0x79, 0x44, 0xbf, 0xd0, 0xdb, 0xf6, 0xba,
-	0x51, 0xfc, 0x64, 0xce, 0x05, 0x3a, 0x1a, 0x35, 0x4a, 0x00, 0x82, 0xc8, 0x6e, 0xec, 0x45, 0xdc,
-	0x27, 0x4e, 0x07, 0x60, 0xbc, 0x13, 0x7d, 0x06, 0x85, 0xb1, 0x4a, 0x6b, 0x6b, 0x7e, 0xbb, 0xf8,
-	0xd0, 0xce, 0xf5, 0x93, 0x3f, 0x1e, 0x6a, 0x6a, 0x6c, 0x2a, 0x67, 0x07, 0x7d, 0x7b, 0x75, 0x84,
-	0xbc, 0xc7, 0x23, 0x2a, 0x49, 0x14, 0xcb, 0x13, 0x77, 0x4c, 0xe7, 0xfc, 0xad, 0x0c, 0xe5, 0x09,
-	0xef, 0xd0, 0x3e, 0x2c, 0x05, 0x84, 0x79, 0xf2, 0x38, 0xb5, 0x55, 0x6a, 0xfc, 0x68, 0xd0, 0xb7,
-	0x17, 0x03, 0xc2, 0xe4, 0xb1, 0x78, 0xdb, 0xb7, 0xcb, 0x27, 0x38, 0x0a, 0x3f, 0x72, 0xd2, 0xb1,
-	0xf3, 0x9f, 0xbe, 0x5d, 0x21, 0xac, 0xc5, 0x7d, 0xca, 0x82, 0xfa, 0x17, 0x82, 0xb3, 0x9a, 0x8b,
-	0x8f, 0x9e, 0x13, 0x21, 0x70, 0x40, 0x5c, 0x05, 0x3a, 0x3c, 0x16, 0xe8, 0x23, 0x58, 0x6f, 0x72,
-	0x2e, 0x85, 0x4c, 0x70, 0xec, 0xe1, 0x56, 0x8b, 0x77, 0x99, 0xf4, 0xb0, 0xef, 0x27, 0x44, 0xa4,
-	0xa7, 0x5e, 0x70, 0xaf, 0x8f, 0x36, 0xec, 0xa4, 0xeb, 0x3b, 0xe9, 0x32, 0xfa, 0x1c, 0x80, 0xb0,
-	0x00, 0x07, 0x24, 0x22, 0x4c, 0x56, 0xe6, 0xb5, 0xf3, 0x37, 0xf3, 0x9c, 0x3f, 0xfc, 0xe4, 0xd1,
-	0x73, 0x12, 0x35, 0x49, 0xd2, 0xb8, 0x61, 0x5c, 0x5f, 0x1b, 0x03, 0x33, 0xbe, 0x67, 0xe8, 0xd0,
-	0xaf, 0x2c, 0xb8, 0x26, 0x24, 0x7e, 0x45, 0x46, 0x51, 0xe0, 0x69, 0xe6, 0xa0, 0xb2, 0xa0, 0xaf,
-	0xf0, 0x7b, 0xb9, 0xb1, 0xa0, 0x00, 0xc3, 0xe3, 0xda, 0xd5, 0xdb, 0x1b, 0xb7, 0x06, 0x7d, 0xdb,
-	0xce, 0x65, 0xca, 0x58, 0x5e, 0x15, 0xb3, 0x48, 0xf4, 0x1b, 0x0b, 0x3e, 0xe8, 0xe1, 0x50, 0x10,
-	0x39, 0xa3, 0xe1, 0x3b, 0x5a, 0xc3, 0x76, 0x9e, 0x86, 0x97, 0x1a, 0x31, 0x25, 0xe2, 0xf6, 0xa0,
-	0x6f, 0x6f, 0xe5, 0x73, 0x65, 0x54, 0xac, 0xf5, 0x72, 0xb0, 0xe8, 0x0f, 0x16, 0x6c, 0x8c, 0x0f,
-	0x66, 0x46, 0xca, 0xa2, 0x96, 0x72, 0x2f, 0x4f, 0xca, 0xe3, 0x11, 0x6a, 0x4a, 0xce, 0xf6, 0xa0,
-	0x6f, 0xdf, 0x3e, 0x9d, 0x33, 0x23, 0xa9, 0x42, 0x4e, 0xe1, 0x40, 0x8f, 0x00, 0x9a, 0x9c, 0xf9,
-	0x9e, 0x4f, 0x18, 0x8f, 0x2a, 0x4b, 0x2a, 0x54, 0x1a, 0xd7, 0xde, 0xf6, 0xed, 0xf7, 0xd3, 0x20,
-	0x1c, 0xaf, 0x39, 0x6e, 0x41, 0x0d, 0xf6, 0xd4, 0x67, 0xf4, 0x57, 0x0b, 0x6e, 0xf1, 0x1e, 0x49,
-	0x04, 0x0d, 0x3a, 0xca, 0x5c, 0x14, 0x51, 0x29, 0xc9, 0xec, 0x25, 0x2f, 0x6b, 0xaf, 0x1e, 0xe5,
-	0x79, 0xf5, 0xe9, 0x10, 0xbe, 0x3b, 0x44, 0x4f, 0x79, 0xf7, 0x60, 0xd0, 0xb7, 0xef, 0x5f, 0xc0,
-	0x48, 0xc6, 0xcd, 0x2d, 0x7e, 0x0e, 0x29, 0xfa, 0xca, 0x82, 0xaa, 0x62, 0xea, 0x32, 0x2a, 0x4f,
-	0xbc, 0x98, 0xf3, 0x70, 0x46, 0x73, 0x41, 0x6b, 0xae, 0xe7, 0x69, 0xde, 0x1d, 0x22, 0xf7, 0x39,
-	0x0f, 0xa7, 0xe4, 0xde, 0x1b, 0xf4, 0xed, 0xed, 0xb3, 0xa9, 0x33, 0x4a, 0x37, 0x5b, 0xa7, 0x53,
-	0xa1, 0xbf, 0x58, 0xa0, 0xa2, 0x8c, 0xfa, 0x58, 0xf2, 0xc4, 0xeb, 0x71, 0x49, 0x59, 0x30, 0x23,
-	0x13, 0xb4, 0xcc, 0x07, 0xa7, 0xc4, 0x6e, 0x8a, 0x7d, 0xa9, 0xa1, 0x53, 0x42, 0x6b, 0x83, 0xbe,
-	0xfd, 0xe1, 0x79, 0xf4, 0x19, 0xa9, 0x37, 0x7b, 0x67, 0xd1, 0x21, 0x0a, 0x9b, 0x93, 0x97, 0x94,
-	0xfa, 0x1f, 0xe9, 0x4c, 0x21, 0x2a, 0xc5, 0xad, 0xf9, 0xed, 0x42, 0xe3, 0xfb, 0x83, 0xbe, 0x7d,
-	0xe7, 0x8c, 0x6d, 0x19, 0x73, 0xeb, 0x13, 0x77, 0xa8, 0x77, 0xa5, 0x59, 0x47, 0xa0, 0x43, 0x58,
-	0xc3, 0x49, 0x93, 0x4a, 0x92, 0xa4, 0xc7, 0x3b, 0xb4, 0x51, 0xd2, 0x36, 0x9c, 0x41, 0xdf, 0xae,
-	0xe6, 0xad, 0x67, 0xc8, 0x91, 0x59, 0x57, 0x87, 0x3e, 0x64, 0xfd, 0x93, 0x05, 0x37, 0x26, 0x60,
-	0xd3, 0x27, 0x5d, 0xd6, 0x27, 0x7d, 0x3f, 0xef, 0xa4, 0x77, 0xc6, 0x74, 0x53, 0xa7, 0xfc, 0xe1,
-	0xa0, 0x6f, 0xdf, 0x3d, 0x8b, 0x36, 0xeb, 0x32, 0x3e, 0x8d, 0x46, 0xe7, 0xcf, 0x88, 0x1e, 0x93,
-	0x64, 0x46, 0xd5, 0xca, 0xe9, 0xf9, 0xf3, 0xb9, 0x02, 0xe4, 0xe5, 0xcf, 0x5c, 0xa6, 0x6c, 0xfe,
-	0x8c, 0x66, 0x91, 0xce, 0xef, 0xae, 0xc0, 0x6a, 0x0e, 0x23, 0xfa, 0x14, 0x96, 0x04, 0x0d, 0x22,
-	0x4e, 0x7d, 0xd3, 0x19, 0xd4, 0x2f, 0xa8, 0xa5, 0x76, 0x90, 0xc2, 0x4c, 0xbb, 0x30, 0x64, 0xd9,
-	0xf8, 0xb3, 0x05, 0x4b, 0x66, 0x09, 0xdd, 0x04, 0x88, 0xf0, 0xb1, 0x17, 0x73, 0xca, 0x64, 0xda,
-	0x79, 0x2c, 0xb8, 0x85, 0x08, 0x1f, 0xef, 0xeb, 0x09, 0xf4, 0x53, 0xb0, 0xe2, 0xb4, 0xae, 0x35,
-	0x6a, 0x8a, 0xe4, 0x1f, 0x7d, 0xfb, 0x6e, 0x40, 0x65, 0xa7, 0xdb, 0xac, 0xb5, 0x78, 0x54, 0x37,
-	0x1d, 0x54, 0xfa, 0xef, 0xbe, 0xf0, 0x5f, 0xd5, 0xe5, 0x49, 0x4c, 0x44, 0x6d, 0x8f, 0xb4, 0x5c,
-	0x2b, 0x56, 0x68, 0xa1, 0x5b, 0x88, 0x4b, 0xa0, 0x85, 0xf3, 0xad, 0x05, 0xab, 0x39, 0x15, 0x0a,
-	0xad, 0xc3, 0x72, 0x44, 0x99, 0xa7, 0x92, 0xa4, 0x11, 0xbc, 0x14, 0x51, 0xd6, 0xe0, 0xcc, 0x47,
-	0xdb, 0x70, 0x55, 0xf2, 0x57, 0x84, 0x09, 0x2f, 0xd6, 0xd1, 0x40, 0x99, 0xd4, 0xea, 0x17, 0xdc,
-	0x95, 0x74, 0x7e, 0x5f, 0x5d, 0x3e, 0x65, 0x12, 0xfd, 0x12, 0xae, 0x76, 0x99, 0xa2, 0x50, 0xdf,
-	0xc9, 0x98, 0x24, 0x94, 0xfb, 0xa6, 0xd9, 0x59, 0xaf, 0xa5, 0x9d, 0x5b, 0x6d, 0xd8, 0xb9, 0xd5,
-	0xf6, 0x4c, 0xe7, 0xd6, 0x58, 0x56, 0x4e, 0xfc, 0xf1, 0x9f, 0xb6, 0xe5, 0xbe, 0x37, 0x02, 0xef,
-	0x6b, 0x2c, 0x7a, 0x04, 0x1f, 0xb4, 0x42, 0x4c, 0x23, 0x0f, 0x77, 0x25, 0x4f, 0x88, 0xec, 0x26,
-	0xcc, 0x0b, 0x69, 0x44, 0xa5, 0xae, 0xbf, 0x65, 0x77, 0x4d, 0xaf, 0xee, 0x8c, 0x16, 0x9f, 0xa9,
-	0x35, 0xe7, 0xab, 0x25, 0x58, 0xcb, 0x2b, 0x80, 0xfa, 0x5a, 0x28, 0x9b, 0xbe, 0x16, 0xca, 0xcc,
-	0xb5, 0xdc, 0x81, 0x15, 0x75, 0x6b, 0xa3, 0x84, 0x91, 0xf6, 0x1e, 0x65, 0xb7, 0x1c, 0xe1, 0xe3,
-	0x51, 0x52, 0x12, 0xe8, 0x63, 0x28, 0x91, 0x98, 0xb7, 0x3a, 0x5e, 0x48, 0x58, 0x20, 0x3b, 0xef,
-	0xe2, 0x60, 0x51, 0x03, 0x9f, 0x69, 0x1c, 0x6a, 0x0c, 0x79, 0x12, 0x72, 0x84, 0x13, 0xdf, 0xb4,
-	0x14, 0xeb, 0xb5, 0xf4, 0xe6, 0x6a, 0xaa, 0x81, 0xce, 0xa4, 0x6e, 0xca, 0x4c, 0xc0, 0xa5, 0x1c,
-	0xae, 0xc6, 0xa0, 0x0a, 0x2c, 0x89, 0x16, 0x0e, 0x29, 0x4b, 0xbb, 0x81, 0xb2, 0x3b, 0x1c, 0xa2,
-	0x17, 0xb0, 0xd2, 0x26, 0x44, 0x5d, 0x42, 0x8b, 0x30, 0x89, 0x03, 0xa2, 0x6b, 0xf4, 0xbb, 0x87,
-	0x4c, 0xb9, 0x4d, 0xc8, 0xfe, 0x88, 0x04, 0x45, 0xb0, 0x39, 0x55, 0x25, 0x52, 0xf5, 0x9e, 0xf6,
-	0xd5, 0x54, 0xe0, 0x77, 0xb5, 0x51, 0x99, 0x28, 0x27, 0xa9, 0x6b, 0xae, 0xe2, 0x43, 0x6d, 0xb8,
-	0x9e, 0xe9, 0x10, 0x26, 0x4c, 0x2d, 0x5f, 0xca, 0xd4, 0xb5, 0x31, 0x5d, 0xd6, 0x8e, 0xaf, 0x9b,
-	0x2c, 0x53, 0x53, 0x26, 0xcc, 0x14, 0x2e, 0x65, 0x66, 0x6d, 0xc4, 0x96, 0xb5, 0x62, 0x43, 0x51,
-	0x05, 0xb2, 0xd7, 0x65, 0x5f, 0x60, 0x1a, 0xea, 0x1a, 0xb8, 0xec, 0x82, 0x9a, 0x7a, 0xa1, 0x67,
-	0x10, 0x81, 0xeb, 0x3e, 0xef, 0x36, 0x43, 0xe2, 0x09, 0x1a, 0x30, 0x4f, 0x84, 0x58, 0x74, 0x8c,
-	0x8e, 0xe2, 0xe5, 0x74, 0xa4, 0x74, 0x07, 0x34, 0x60, 0x07, 0x8a, 0x2c, 0xd5, 0xf1, 0x03, 0x78,
-	0xbf, 0x47, 0x12, 0xda, 0x3e, 0xc9, 0xc6, 0x7a, 0x49, 0xab, 0xb9, 0x9a, 0x2e, 0x64, 0xc2, 0xfd,
-	0x05, 0xac, 0xf2, 0x76, 0x3b, 0xa4, 0x8c, 0x3c, 0xc5, 0x34, 0x1c, 0x06, 0xb5, 0x29, 0x2b, 0x17,
-	0x8a, 0xfa, 0x3c, 0xbc, 0xf3, 0x39, 0x54, 0x4e, 0xeb, 0x0c, 0xd1, 0xcf, 0x61, 0xb9, 0x83, 0xc3,
-	0x76, 0x48, 0xdb, 0xc4, 0x24, 0xe7, 0x0b, 0xd9, 0x19, 0x81, 0x9c, 0x5f, 0x5f, 0x81, 0xad, 0xf3,
-	0x3a, 0x34, 0x84, 0x60, 0x81, 0xe1, 0x28, 0xb5, 0x50, 0x70, 0xf5, 0x67, 0xb4, 0x07, 0x65, 0x22,
-	0x5a, 0x09, 0x3f, 0xf2, 0x70, 0xa4, 0x5e, 0x19, 0xe6, 0xcd, 0x77, 0xee, 0x97, 0xb2, 0x94, 0xa2,
-	0x76, 0x34, 0x08, 0x3d, 0x81, 0x92, 0xe9, 0x4b, 0x92, 0x6e, 0x48, 0x84, 0xc9, 0x10, 0xb9, 0x4f,
-	0xb2, 0xb4, 0x29, 0x71, 0xd5, 0xb6, 0xe1, 0xf7, 0xbb, 0x37, 0x9e, 0x42, 0x3f, 0x81, 0x0d, 0x9f,
-	0xb0, 0x13, 0x2f, 0xa4, 0x22, 0xd3, 0x20, 0x0f, 0x9f, 0x46, 0x0b, 0xe9, 0xd3, 0x48, 0xed, 0x78,
-	0x46, 0xc5, 0xe8, 0x14, 0xcd, 0xd3, 0xc8, 0x09, 0x60, 0xf3, 0x8c, 0x96, 0x6f, 0x46, 0xa5, 0x75,
-	0x59, 0x95, 0x0e, 0x85, 0x9b, 0x67, 0x36, 0x6d, 0xff, 0x43, 0x53, 0x5f, 0x42, 0x31, 0xf3, 0x8a,
-	0x45, 0x4f, 0xa0, 0x3c, 0x3a, 0x15, 0x15, 0xf5, 0x9a, 0x79, 0xe5, 0xe1, 0xad, 0x73, 0x5e, 0xbf,
-	0x87, 0x27, 0x31, 0x71, 0x4b, 0xad, 0xcc, 0x48, 0x65, 0xd2, 0xc9, 0x17, 0xe7, 0x70, 0xe8, 0xbc,
-	0x84, 0xc2, 0xe8, 0xed, 0x88, 0xee, 0x8c, 0xb7, 0xe9, 0xb8, 0x69, 0x14, 0x07, 0x7d, 0x7b, 0x38,
-	0x35, 0xc2, 0x20, 0x07, 0x16, 0x4d, 0x95, 0xd1, 0x85, 0xb2, 0x01, 0xea, 0x89, 0x9c, 0xce, 0xb8,
-	0xe6, 0xbf, 0xf3, 0x6f, 0x0b, 0x8a, 0x19, 0x6f, 0xd1, 0x2d, 0x28, 0x9b, 0x43, 0x32, 0x95, 0xd3,
-	0xd2, 0x19, 0xdd, 0x9c, 0x9c, 0xa9, 0x88, 0x1f, 0xc3, 0xe2, 0x97, 0x5d, 0x9e, 0x74, 0xa3, 0x4b,
-	0xf6, 0x0f, 0x06, 0x8d, 0x9e, 0x41, 0x41, 0x76, 0x12, 0x22, 0x3a, 0x3c, 0xf4, 0x2f, 0xd9, 0x4c,
-	0x8c, 0x09, 0xd0, 0x5d, 0x78, 0x0f, 0x87, 0x21, 0x3f, 0xf2, 0x08, 0xf3, 0x3d, 0x82, 0x93, 0xf0,
-	0x44, 0xc7, 0xe6, 0xb2, 0x5b, 0xd6, 0xd3, 0x8f, 0x99, 0xff, 0x58, 0x4d, 0x3a, 0xbf, 0x9d, 0x87,
-	0xf5, 0x53, 0x9b, 0xce, 0xff, 0xfb, 0x2f, 0xa4, 0xaa, 0xf8, 0x3e, 0x15, 0x71, 0x57, 0xaa, 0x57,
-	0xa0, 0x90, 0xe6, 0x01, 0x7f, 0x7e, 0xc5, 0x37, 0xa0, 0x5d, 0x2e, 0x24, 0x7a, 0x0a, 0x2b, 0x47,
-	0x98, 0x66, 0xc3, 0x64, 0xf1, 0xe2, 0x19, 0xb2, 0x6c, 0xa0, 0x69, 0x30, 0x35, 0x7e, 0xf6, 0xf5,
-	0xeb, 0xaa, 0xf5, 0xcd, 0xeb, 0xaa, 0xf5, 0xed, 0xeb, 0xaa, 0xf5, 0xfb, 0x37, 0xd5, 0xb9, 0x6f,
-	0xde, 0x54, 0xe7, 0xfe, 0xfe, 0xa6, 0x3a, 0xf7, 0xd9, 0xed, 0x89, 0x18, 0xd0, 0x3f, 0xc8, 0xc9,
-	0x20, 0xc1, 0x3e, 0xa9, 0x1f, 0xeb, 0x5f, 0xe6, 0x74, 0x14, 0x34, 0x17, 0xb5, 0xad, 0x1f, 0xfe,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x6f, 0x80, 0x04, 0x40, 0x14, 0x00, 0x00,
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x3d, 0x6c, 0x1c, 0xc7,
+	0x15, 0xe6, 0x92, 0x34, 0xc9, 0x7b, 0x77, 0x47, 0xcb, 0x43, 0xca, 0x5a, 0x92, 0xd2, 0x2d, 0xb3,
+	0xfa, 0x09, 0xe3, 0x48, 0x77, 0x90, 0x22, 0x20, 0x81, 0x13, 0x24, 0xe0, 0x91, 0xb2, 0x65, 0x43,
+	0x8a, 0x89, 0xd5, 0x4f, 0x00, 0xbb, 0x58, 0xcc, 0xdd, 0xce, 0x2d, 0xc7, 0xda, 0x9d, 0x59, 0xef,
+	0xcc, 0x1d, 0xc9, 0x2e, 0x40, 0x52, 0x26, 0x40, 0x8a, 0x14, 0x41, 0x4a, 0x23, 0x55, 0xba, 0x34,
+	0xe9, 0xd2, 0xbb, 0x74, 0x19, 0xa4, 0xb8, 0x18, 0x12, 0xd2, 0x5c, 0x15, 0xa8, 0x4c, 0x15, 0xcc,
+	0xec, 0xcf, 0xed, 0x1d, 0x97, 0x14, 0x73, 0x48, 0xe5, 0x86, 0xbc, 0x9d, 0x79, 0xdf, 0xf7, 0xbe,
+	0x37, 0xf3, 0xf6, 0xcd, 0x9b, 0x85, 0xed, 0x2e, 0x67, 0x3d, 0xca, 0x5b, 0x11, 0x27, 0xad, 0xc1,
+	0xdd, 0x0e, 0x91, 0xf8, 0x6e, 0xcb, 0x27, 0x8c, 0x08, 0x2a, 0x9a, 0x51, 0xcc, 0x25, 0x47, 0x28,
+	0xb1, 0x68, 0x46, 0x9c, 0x34, 0x53, 0x8b, 0xcd, 0x75, 0x9f, 0xfb, 0x5c, 0x4f, 0xb7, 0xd4, 0xaf,
+	0xc4, 0x72, 0xb3, 0xd1, 0xe5, 0x22, 0xe4, 0xa2, 0xd5, 0xc1, 0x62, 0x4c, 0xd6, 0xe5, 0x94, 0x15,
+	0xe7, 0x8f, 0xb0, 0x08, 0x5b, 0xfa, 0xcf, 0x60, 0xca, 0xd3, 0xe6, 0xd5, 0x12, 0x2d, 0xca, 0x6b,
+	0x8a, 0xf6, 0x39, 0xf7, 0x03, 0xd2, 0xd2, 0x4f, 0x9d, 0x7e, 0xaf, 0xe5, 0xf5, 0x63, 0x2c, 0x29,
+	0x4f, 0xd9, 0xed, 0x7f, 0x19, 0x50, 0xfb, 0x30, 0xe1, 0x7b, 0x22, 0xb1, 0x24, 0xe8, 0x47, 0xb0,
+	0x14, 0xe1, 0x18, 0x87, 0xc2, 0x34, 0xb6, 0x8d, 0x9d, 0xea, 0xbd, 0xcd, 0xe6, 0xe9, 0x48, 0x9a,
+	0x07, 0xda, 0xa2, 0xbd, 0xf8, 0xd5, 0xd0, 0x9a, 0x73, 0x52, 0x7b, 0xf4, 0x31, 0xac, 0x0a, 0x42,
+	0x3c, 0xb7, 0xcb, 0x99, 0x8c, 0x71, 0x57, 0x0a, 0x73, 0x5e, 0x33, 0x7c, 0xa7, 0x8c, 0xe1, 0x09,
+	0x21, 0xde, 0x5e, 0x66, 0xf8, 0x70, 0xce, 0xa9, 0x8b, 0xe2, 0x00, 0xda, 0x85, 0x2a, 0x0d, 0x23,
+	0x1e, 0x4b, 0xd7, 0xeb, 0x87, 0x91, 0xb9, 0xa0, 0x89, 0x1a, 0x65, 0x44, 0x1f, 0x69, 0xb3, 0xfd,
+	0x7e, 0x18, 0x3d, 0x9c, 0x73, 0x80, 0xe6, 0x4f, 0xed, 0x1a, 0x80, 0x20, 0xb2, 0x1f, 0xb9, 0x21,
+	0xf7, 0x88, 0xfd, 0x97, 0x79, 0x80, 0xb1, 0x29, 0xfa, 0x14, 0x2a, 0x63, 0x99, 0xc6, 0xf6, 0xc2,
+	0x4e, 0xf5, 0x9e, 0x55, 0x1a, 0x28, 0x7f, 0x90, 0x89, 0x6a, 0x6f, 0xa9, 0x68, 0x47, 0x43, 0x6b,
+	0x2d, 0x47, 0xde, 0xe6, 0x21, 0x95, 0x24, 0x8c, 0xe4, 0x89, 0x33, 0xa6, 0x43, 0x0c, 0xea, 0x82,
+	0xfa, 0x8c, 0x32, 0xdf, 0xa5, 0xac, 0xc7, 0xd5, 0x32, 0x28, 0xfe, 0x9d, 0x32, 0xfe, 0xe7, 0x38,
+	0xa0, 0x1e, 0x96, 0x3c, 0x7e, 0x92, 0x20, 0x3e, 0x62, 0x3d, 0xde, 0xb6, 0x52, 0x47, 0x57, 0x26,
+	0x68, 0x0a, 0xce, 0x6a, 0x62, 0x6c, 0x2d, 0xd0, 0x2f, 0xa0, 0x22, 0x02, 0x2c, 0x0e, 0x29, 0xf3,
+	0x85, 0xb9, 0xa0, 0x7d, 0x5d, 0x2d, 0x5d, 0xf2, 0xd4, 0x68, 0x1c, 0x48, 0x0e, 0x2b, 0x06, 0x92,
+	0x0f, 0xda, 0x7f, 0xab, 0x43, 0x7d, 0x62, 0x9f, 0xd0, 0x01, 0x2c, 0xfb, 0x84, 0xb9, 0xf2, 0x38,
+	0x59, 0xb4, 0x5a, 0xfb, 0x87, 0xa3, 0xa1, 0xb5, 0xe4, 0x13, 0x26, 0x8f, 0xc5, 0xeb, 0xa1, 0x55,
+	0x3f, 0xc1, 0x61, 0xf0, 0xbe, 0x9d, 0x3c, 0xdb, 0xff, 0x19, 0x5a, 0x26, 0x61, 0x5d, 0xee, 0x51,
+	0xe6, 0xb7, 0x3e, 0x17, 0x9c, 0x35, 0x1d, 0x7c, 0xf4, 0x98, 0x08, 0x81, 0x7d, 0xe2, 0x28, 0xd0,
+	0xd3, 0x63, 0x81, 0xde, 0x87, 0x8d, 0x0e, 0xe7, 0x52, 0xc8, 0x18, 0x47, 0x2e, 0xee, 0x76, 0x79,
+	0x9f, 0x49, 0x17, 0x7b, 0x5e, 0x4c, 0x44, 0x92, 0x3f, 0x15, 0xe7, 0x4a, 0x6e, 0xb0, 0x9b, 0xcc,
+	0xef, 0x26, 0xd3, 0xe8, 0x33, 0x00, 0xc2, 0x7c, 0xec, 0x93, 0x90, 0x30, 0x99, 0x46, 0x7e, 0xad,
+	0x2c, 0xf2, 0xa7, 0x1f, 0xde, 0x7f, 0x4c, 0xc2, 0x0e, 0x89, 0xdb, 0x57, 0xd3, 0xd0, 0xd7, 0xc7,
+	0xc0, 0x42, 0xec, 0x05, 0x3a, 0xf4, 0x4b, 0x03, 0x2e, 0x0b, 0x89, 0x5f, 0x90, 0x3c, 0x9f, 0x5d,
+	0xcd, 0xec, 0x9b, 0x8b, 0x3a, 0x19, 0xbf, 0x5b, 0xba, 0xc4, 0x0a, 0x90, 0x2d, 0xd7, 0x9e, 0x36,
+	0x6f, 0x5f, 0x1f, 0x0d, 0x2d, 0xab, 0x94, 0xa9, 0xe0, 0x79, 0x4d, 0x9c, 0x46, 0xa2, 0x5f, 0x1b,
+	0xf0, 0xee, 0x00, 0x07, 0x82, 0xc8, 0x53, 0x1a, 0xde, 0xda, 0x36, 0xce, 0x49, 0x29, 0x41, 0xe4,
+	0x94, 0x88, 0x1b, 0xa3, 0xa1, 0xb5, 0x5d, 0xce, 0x55, 0x50, 0xb1, 0x3e, 0x28, 0xc1, 0xa2, 0xdf,
+	0x1b, 0xb0, 0x39, 0x5e, 0x98, 0x53, 0x52, 0x96, 0xb4, 0x94, 0xdb, 0x65, 0x52, 0x1e, 0xe4, 0xa8,
+	0x29, 0x39, 0x3b, 0xa3, 0xa1, 0x75, 0xe3, 0x6c, 0xce, 0x82, 0x24, 0x93, 0x9c, 0xc1, 0x81, 0xee,
+	0x03, 0x74, 0x38, 0xf3, 0x5c, 0x8f, 0x30, 0x1e, 0x9a, 0xcb, 0x2a, 0x55, 0xda, 0x97, 0x5f, 0x0f,
+	0xad, 0x77, 0x92, 0x24, 0x1c, 0xcf, 0xd9, 0x4e, 0x45, 0x3d, 0xec, 0xab, 0xdf, 0xe8, 0xaf, 0x06,
+	0x5c, 0xe7, 0x03, 0x12, 0x0b, 0xea, 0x1f, 0x2a, 0x77, 0x61, 0x48, 0xa5, 0x24, 0xa7, 0x37, 0x79,
+	0x45, 0x47, 0x75, 0xbf, 0x2c, 0xaa, 0x4f, 0x32, 0xf8, 0x5e, 0x86, 0x9e, 0x8a, 0xee, 0xee, 0x68,
+	0x68, 0xdd, 0xb9, 0x80, 0x93, 0x42, 0x98, 0xdb, 0xfc, 0x0d, 0xa4, 0xe8, 0x4b, 0x03, 0x1a, 0x8a,
+	0xa9, 0xcf, 0xa8, 0x3c, 0x71, 0x23, 0xce, 0x83, 0x53, 0x9a, 0x2b, 0x5a, 0x73, 0xab, 0x4c, 0xf3,
+	0x5e, 0x86, 0x3c, 0xe0, 0x3c, 0x98, 0x92, 0x7b, 0x7b, 0x34, 0xb4, 0x76, 0xce, 0xa7, 0x2e, 0x28,
+	0xdd, 0xea, 0x9e, 0x4d, 0x85, 0xfe, 0x6c, 0xc0, 0xf6, 0x20, 0x2b, 0x69, 0xee, 0x80, 0x4b, 0x55,
+	0xbd, 0xa6, 0x65, 0x82, 0x96, 0x79, 0xf7, 0xdc, 0x72, 0xf8, 0x5c, 0x43, 0xa7, 0x84, 0x36, 0x47,
+	0x43, 0xeb, 0xbd, 0x37, 0xd1, 0x17, 0xa4, 0x5e, 0x1b, 0x9c, 0x47, 0x87, 0x28, 0x6c, 0x4d, 0x6e,
+	0x52, 0x12, 0x7f, 0xa8, 0x2b, 0x85, 0x30, 0xab, 0xdb, 0x0b, 0x3b, 0x95, 0xf6, 0xf7, 0x46, 0x43,
+	0xeb, 0xe6, 0x39, 0x66, 0x05, 0x77, 0x1b, 0x13, 0x7b, 0xa8, 0xad, 0x92, 0xaa, 0x23, 0xd0, 0x53,
+	0x58, 0xc7, 0x71, 0x87, 0x4a, 0x12, 0x27, 0xcb, 0x9b, 0xf9, 0xa8, 0x69, 0x1f, 0xf6, 0x68, 0x68,
+	0x35, 0xca, 0xe6, 0x0b, 0xe4, 0x28, 0x9d, 0x57, 0x8b, 0x9e, 0xb1, 0xfe, 0xd1, 0x80, 0xab, 0x13,
+	0xb0, 0xe9, 0x95, 0xae, 0xeb, 0x95, 0xbe, 0x53, 0xb6, 0xd2, 0xbb, 0x63, 0xba, 0xa9, 0x55, 0x7e,
+	0x6f, 0x34, 0xb4, 0x6e, 0x9d, 0x47, 0x5b, 0x0c, 0x19, 0x9f, 0x45, 0xa3, 0xeb, 0x67, 0x48, 0x8f,
+	0x49, 0x7c, 0x4a, 0xd5, 0xea, 0xd9, 0xf5, 0xf3, 0xb1, 0x02, 0x94, 0xd5, 0xcf, 0x52, 0xa6, 0x62,
+	0xfd, 0x0c, 0x4f, 0x23, 0xed, 0xdf, 0xce, 0xc3, 0x5a, 0x09, 0x23, 0xfa, 0x04, 0x96, 0x05, 0xf5,
+	0x43, 0x4e, 0x3d, 0xd3, 0x38, 0xfb, 0x95, 0x29, 0x41, 0x36, 0x9f, 0x24, 0xb0, 0xb4, 0xf1, 0xc9,
+	0x58, 0x36, 0xff, 0x64, 0xc0, 0x72, 0x3a, 0x85, 0xae, 0x01, 0x84, 0xf8, 0xd8, 0x8d, 0x38, 0x65,
+	0x32, 0xe9, 0xa1, 0x16, 0x9d, 0x4a, 0x88, 0x8f, 0x0f, 0xf4, 0x00, 0xfa, 0x09, 0x18, 0x51, 0x72,
+	0xae, 0xb5, 0x9b, 0x8a, 0xe4, 0x1f, 0x43, 0xeb, 0x96, 0x4f, 0xe5, 0x61, 0xbf, 0xd3, 0xec, 0xf2,
+	0xb0, 0x95, 0xf6, 0x82, 0xc9, 0xbf, 0x3b, 0xc2, 0x7b, 0xd1, 0x92, 0x27, 0x11, 0x11, 0xcd, 0x7d,
+	0xd2, 0x75, 0x8c, 0x48, 0xa1, 0x85, 0xb9, 0x30, 0x1b, 0x5a, 0xd8, 0xdf, 0x18, 0xb0, 0x56, 0x72,
+	0x42, 0xa1, 0x0d, 0x58, 0x09, 0x29, 0x73, 0x55, 0x91, 0x4c, 0x05, 0x2f, 0x87, 0x94, 0xb5, 0x39,
+	0xf3, 0xd0, 0x0e, 0x5c, 0x92, 0xfc, 0x05, 0x61, 0xc2, 0x8d, 0x74, 0x36, 0x50, 0x26, 0xb5, 0xfa,
+	0x45, 0x67, 0x35, 0x19, 0x3f, 0x50, 0x9b, 0x4f, 0x99, 0x44, 0x3f, 0x87, 0x4b, 0x7d, 0xa6, 0x28,
+	0xd4, 0x3b, 0x19, 0x91, 0x98, 0x72, 0x2f, 0x6d, 0xdb, 0x36, 0x9a, 0x49, 0x0f, 0xda, 0xcc, 0x7a,
+	0xd0, 0xe6, 0x7e, 0xda, 0x83, 0xb6, 0x57, 0x54, 0x10, 0x7f, 0xf8, 0xa7, 0x65, 0x38, 0x6f, 0xe7,
+	0xe0, 0x03, 0x8d, 0x45, 0xf7, 0xe1, 0xdd, 0x6e, 0x80, 0x69, 0xe8, 0xe2, 0xbe, 0xe4, 0x31, 0x91,
+	0xfd, 0x98, 0xb9, 0x01, 0x0d, 0xa9, 0xd4, 0xe7, 0x6f, 0xdd, 0x59, 0xd7, 0xb3, 0xbb, 0xf9, 0xe4,
+	0x23, 0x35, 0x67, 0x7f, 0xb9, 0x0c, 0xeb, 0x65, 0x07, 0xa0, 0xde, 0x16, 0xca, 0xa6, 0xb7, 0x85,
+	0xb2, 0x74, 0x5b, 0x6e, 0xc2, 0xaa, 0xda, 0xb5, 0xbc, 0x60, 0x24, 0xbd, 0x47, 0xdd, 0xa9, 0x87,
+	0xf8, 0x38, 0x2f, 0x4a, 0x02, 0x7d, 0x00, 0x35, 0x12, 0xf1, 0xee, 0xa1, 0x1b, 0x10, 0xe6, 0xcb,
+	0xc3, 0xff, 0x25, 0xc0, 0xaa, 0x06, 0x3e, 0xd2, 0x38, 0xd4, 0xce, 0x78, 0x62, 0x72, 0x84, 0x63,
+	0x2f, 0x6d, 0x29, 0x36, 0x9a, 0xc9, 0xce, 0x35, 0xd5, 0x55, 0xa0, 0x50, 0xba, 0x29, 0x4b, 0x13,
+	0x2e, 0xe1, 0x70, 0x34, 0x06, 0x99, 0xb0, 0x2c, 0xba, 0x38, 0xa0, 0x2c, 0xe9, 0x06, 0xea, 0x4e,
+	0xf6, 0x88, 0x9e, 0xc1, 0x6a, 0x8f, 0x10, 0xb5, 0x09, 0x5d, 0xc2, 0x24, 0xf6, 0x89, 0xb9, 0x34,
+	0x53, 0xca, 0xd4, 0x7b, 0x84, 0x1c, 0xe4, 0x24, 0x28, 0x84, 0xad, 0xa9, 0x53, 0x22, 0x51, 0xef,
+	0xea, 0x58, 0xcd, 0xe5, 0x99, 0x7c, 0x98, 0x13, 0xc7, 0x49, 0x12, 0x9a, 0xa3, 0xf8, 0x50, 0x0f,
+	0xae, 0x14, 0x3a, 0x84, 0x09, 0x57, 0x2b, 0x33, 0xb9, 0xba, 0x3c, 0xa6, 0x2b, 0xfa, 0xf1, 0x74,
+	0x93, 0x95, 0x9e, 0x29, 0x13, 0x6e, 0x2a, 0x33, 0xb9, 0x59, 0xcf, 0xd9, 0x8a, 0x5e, 0x2c, 0xa8,
+	0xaa, 0x44, 0x76, 0xfb, 0xec, 0x73, 0x4c, 0x03, 0x7d, 0x06, 0xae, 0x38, 0xa0, 0x86, 0x9e, 0xe9,
+	0x11, 0x44, 0xe0, 0x8a, 0xc7, 0xfb, 0x9d, 0x80, 0xb8, 0xaa, 0xb9, 0x77, 0x75, 0x17, 0x9e, 0xea,
+	0xa8, 0xce, 0xa6, 0x23, 0xa1, 0x53, 0x17, 0x0b, 0xdd, 0xf9, 0x27, 0x3a, 0xbe, 0x0f, 0xef, 0x0c,
+	0x48, 0x4c, 0x7b, 0x27, 0xc5, 0x5c, 0xaf, 0x69, 0x35, 0x97, 0x92, 0x89, 0x42, 0xba, 0x3f, 0x83,
+	0x35, 0xde, 0xeb, 0x05, 0x94, 0x91, 0x8f, 0x31, 0x0d, 0xb2, 0xa4, 0x36, 0xeb, 0x17, 0xcf, 0xfa,
+	0x32, 0xbc, 0xfd, 0x19, 0x98, 0x67, 0x75, 0x86, 0xe8, 0x67, 0xb0, 0x72, 0x88, 0x83, 0x5e, 0x40,
+	0x7b, 0xc4, 0x34, 0x2e, 0xee, 0x27, 0x07, 0xd9, 0xbf, 0x9a, 0x87, 0xed, 0x37, 0x75, 0x68, 0x08,
+	0xc1, 0x22, 0xc3, 0x61, 0xe2, 0xa1, 0xe2, 0xe8, 0xdf, 0x68, 0x1f, 0xea, 0x44, 0x74, 0x63, 0x7e,
+	0xe4, 0xe2, 0x50, 0xdd, 0x32, 0xcc, 0xf9, 0x8b, 0xbd, 0x94, 0xb5, 0x04, 0xb5, 0xab, 0x41, 0xe8,
+	0x21, 0xd4, 0xd2, 0xbe, 0x24, 0xee, 0x07, 0x44, 0xa4, 0x15, 0xa2, 0xf4, 0x6e, 0x99, 0x34, 0x25,
+	0x8e, 0x32, 0xcb, 0xde, 0xef, 0xc1, 0x78, 0x08, 0xfd, 0x18, 0x36, 0x3d, 0xc2, 0x4e, 0xdc, 0x80,
+	0x8a, 0x42, 0x83, 0x9c, 0x5d, 0x8d, 0x16, 0x93, 0xab, 0x91, 0xb2, 0x78, 0x44, 0x45, 0xbe, 0x8a,
+	0xe9, 0xd5, 0xc8, 0xf6, 0x61, 0xeb, 0x9c, 0x96, 0xef, 0x94, 0x4a, 0x63, 0x56, 0x95, 0x36, 0x85,
+	0x6b, 0xe7, 0x36, 0x6d, 0xff, 0x47, 0x57, 0x5f, 0x40, 0xb5, 0x70, 0x1d, 0x47, 0x0f, 0xa1, 0x9e,
+	0xaf, 0x8a, 0xca, 0x7a, 0xcd, 0xbc, 0x7a, 0xef, 0xfa, 0x1b, 0xae, 0xf1, 0x4f, 0x4f, 0x22, 0xe2,
+	0xd4, 0xba, 0x85, 0x27, 0x55, 0x49, 0x27, 0x6f, 0x9c, 0xd9, 0xa3, 0xfd, 0x1c, 0x2a, 0xf9, 0xdd,
+	0x11, 0xdd, 0x1c, 0x9b, 0xe9, 0xbc, 0x69, 0x57, 0x47, 0x43, 0x2b, 0x1b, 0xca, 0x31, 0xc8, 0x86,
+	0xa5, 0xf4, 0x94, 0xd1, 0x07, 0x65, 0x1b, 0xd4, 0x15, 0x39, 0x19, 0x71, 0xd2, 0xff, 0xf6, 0xbf,
+	0x0d, 0xa8, 0x16, 0xa2, 0x45, 0xd7, 0xa1, 0x9e, 0x2e, 0x52, 0x7a, 0x72, 0x1a, 0xba, 0xa2, 0xa7,
+	0x2b, 0x97, 0x9e, 0x88, 0x1f, 0xc0, 0xd2, 0x17, 0x7d, 0x1e, 0xf7, 0xc3, 0x19, 0xfb, 0x87, 0x14,
+	0x8d, 0x1e, 0x41, 0x45, 0x1e, 0xc6, 0x44, 0x1c, 0xf2, 0xc0, 0x9b, 0xb1, 0x99, 0x18, 0x13, 0xa0,
+	0x5b, 0xf0, 0x36, 0x0e, 0x02, 0x7e, 0xe4, 0x12, 0xe6, 0xb9, 0x04, 0xc7, 0xc1, 0x89, 0xce, 0xcd,
+	0x15, 0xa7, 0xae, 0x87, 0x1f, 0x30, 0xef, 0x81, 0x1a, 0xb4, 0x7f, 0xb3, 0x00, 0x1b, 0x67, 0x36,
+	0x9d, 0xdf, 0xfa, 0x17, 0x52, 0x9d, 0xf8, 0x1e, 0x15, 0x51, 0x5f, 0xaa, 0x5b, 0xa0, 0x90, 0xe6,
+	0x5b, 0x17, 0x8b, 0xa5, 0x9a, 0x82, 0xf6, 0xb8, 0x90, 0xea, 0x03, 0xdb, 0x11, 0xa6, 0xc5, 0x34,
+	0x59, 0xba, 0x78, 0x85, 0xac, 0xa7, 0xd0, 0x24, 0x99, 0xda, 0x3f, 0xfd, 0xea, 0x65, 0xc3, 0xf8,
+	0xfa, 0x65, 0xc3, 0xf8, 0xe6, 0x65, 0xc3, 0xf8, 0xdd, 0xab, 0xc6, 0xdc, 0xd7, 0xaf, 0x1a, 0x73,
+	0x7f, 0x7f, 0xd5, 0x98, 0xfb, 0xf4, 0xc6, 0x44, 0x0e, 0xe8, 0x4f, 0x8b, 0xd2, 0x8f, 0xb1, 0x47,
+	0x5a, 0xc7, 0xfa, 0x1b, 0xa3, 0xce, 0x82, 0xce, 0x92, 0xf6, 0xf5, 0x83, 0xff, 0x0e, 0x00, 0xe2,
+	0x60, 0x69, 0x1c, 0x0a, 0x15, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1419,6 +1441,34 @@ func (m *ImportDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2292,6 +2342,18 @@ func (m *ImportDump) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2810,6 +2872,74 @@ func (m *ImportDump) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, ValidatorSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, Slashing{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
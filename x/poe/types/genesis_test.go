@@ -24,6 +24,22 @@ func TestValidateGenesis(t *testing.T) {
 		"all good": {
 			source: GenesisStateFixture(),
 		},
+		"custom fee split": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)}
+			}),
+		},
+		"empty fee split": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{}
+			}),
+		},
+		"invalid fee split": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.ZeroDec()}
+			}),
+			expErr: true,
+		},
 		"seed with empty engagement group": {
 			source: GenesisStateFixture(func(m *GenesisState) {
 				m.GetSeedContracts().Engagement = []TG4Member{}
@@ -21,4 +21,11 @@ const (
 var (
 	ContractPrefix    = []byte{0x01}
 	HistoricalInfoKey = []byte{0x02}
+	SigningInfoKey    = []byte{0x03}
+	SlashingKey       = []byte{0x04}
+	BlockEvidenceKey  = []byte{0x05}
+	// HistoricalValsetHeightKey indexes the heights of the historical info entries that contain a validator set
+	HistoricalValsetHeightKey = []byte{0x06}
+	// ValsetEpochKey stores the epoch length and the next epoch of the valset contract
+	ValsetEpochKey = []byte{0x07}
 )
@@ -9,15 +9,21 @@ import (
 )
 
 const (
-	TypeMsgCreateValidator = "create_validator"
-	TypeMsgUpdateValidator = "update_validator"
-	TypeMsgUndelegate      = "begin_unbonding"
-	TypeMsgDelegate        = "delegate"
+	TypeMsgCreateValidator    = "create_validator"
+	TypeMsgUpdateValidator    = "update_validator"
+	TypeMsgUndelegate         = "begin_unbonding"
+	TypeMsgDelegate           = "delegate"
+	TypeMsgClaimRewards       = "claim_rewards"
+	TypeMsgSetWithdrawAddress = "set_withdraw_address"
+	TypeMsgUnjail             = "unjail"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
 	_ sdk.Msg = &MsgUpdateValidator{}
+	_ sdk.Msg = &MsgClaimRewards{}
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgUnjail{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -258,3 +264,127 @@ func (msg MsgUndelegate) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgClaimRewards constructor
+func NewMsgClaimRewards(sender sdk.AccAddress, distribution, engagement bool) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Sender:       sender.String(),
+		Distribution: distribution,
+		Engagement:   engagement,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgClaimRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgClaimRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "sender address")
+	}
+	return nil
+}
+
+// ClaimDistribution returns true when the distribution rewards are claimed
+func (msg MsgClaimRewards) ClaimDistribution() bool {
+	return msg.Distribution || !msg.Engagement
+}
+
+// ClaimEngagement returns true when the engagement rewards are claimed
+func (msg MsgClaimRewards) ClaimEngagement() bool {
+	return msg.Engagement || !msg.Distribution
+}
+
+// NewMsgSetWithdrawAddress constructor
+func NewMsgSetWithdrawAddress(sender, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
+	return &MsgSetWithdrawAddress{
+		Sender:          sender.String(),
+		WithdrawAddress: withdrawAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) Type() string { return TypeMsgSetWithdrawAddress }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, "withdraw address")
+	}
+	return nil
+}
+
+// NewMsgUnjail constructor
+func NewMsgUnjail(opAddr sdk.AccAddress) *MsgUnjail {
+	return &MsgUnjail{
+		OperatorAddress: opAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUnjail) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUnjail) Type() string { return TypeMsgUnjail }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	opAddr, err := sdk.AccAddressFromBech32(msg.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{opAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnjail) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "operator address")
+	}
+	return nil
+}
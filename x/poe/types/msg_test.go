@@ -191,3 +191,68 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgClaimRewards(t *testing.T) {
+	tests := []struct {
+		name         string
+		sender       sdk.AccAddress
+		distribution bool
+		engagement   bool
+		expectPass   bool
+	}{
+		{"all rewards", sdk.AccAddress(valAddr1), false, false, true},
+		{"distribution only", sdk.AccAddress(valAddr1), true, false, true},
+		{"engagement only", sdk.AccAddress(valAddr1), false, true, true},
+		{"empty sender", sdk.AccAddress(emptyAddr), false, false, false},
+	}
+	for _, tc := range tests {
+		msg := NewMsgClaimRewards(tc.sender, tc.distribution, tc.engagement)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgSetWithdrawAddress(t *testing.T) {
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		name         string
+		sender       sdk.AccAddress
+		withdrawAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), otherAddr, true},
+		{"reset to sender", sdk.AccAddress(valAddr1), sdk.AccAddress(valAddr1), true},
+		{"empty sender", sdk.AccAddress(emptyAddr), otherAddr, false},
+		{"empty withdraw address", sdk.AccAddress(valAddr1), sdk.AccAddress(emptyAddr), false},
+	}
+	for _, tc := range tests {
+		msg := NewMsgSetWithdrawAddress(tc.sender, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgUnjail(t *testing.T) {
+	tests := []struct {
+		name         string
+		operatorAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), true},
+		{"empty operator", sdk.AccAddress(emptyAddr), false},
+	}
+	for _, tc := range tests {
+		msg := NewMsgUnjail(tc.operatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
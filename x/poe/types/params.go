@@ -18,12 +18,17 @@ const (
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries                uint32 = 10000
 	DefaultInitialValidatorEngagementPoints uint64 = 1
+	DefaultSignedBlocksWindow               uint32 = 100
+	DefaultHistoricalValsetMode                    = HistoricalValsetModeEpoch
 )
 
 var (
 	KeyHistoricalEntries          = []byte("HistoricalEntries")
 	KeyInitialValEngagementPoints = []byte("InitialValidatorEngagementPoints")
 	KeyMinDelegationAmounts       = []byte("MinDelegationAmounts")
+	KeySignedBlocksWindow         = []byte("SignedBlocksWindow")
+	KeyHistoricalValsetMode       = []byte("HistoricalValsetMode")
+	KeyFeeSplit                   = []byte("FeeSplit")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -34,11 +39,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(historicalEntries uint32, engagementPoints uint64, min sdk.Coins) Params {
+func NewParams(historicalEntries uint32, engagementPoints uint64, min sdk.Coins, signedBlocksWindow uint32, valsetMode HistoricalValsetMode, feeSplit FeeSplit) Params {
 	return Params{
 		HistoricalEntries:          historicalEntries,
 		InitialValEngagementPoints: engagementPoints,
 		MinDelegationAmounts:       min,
+		SignedBlocksWindow:         signedBlocksWindow,
+		HistoricalValsetMode:       valsetMode,
+		FeeSplit:                   feeSplit,
 	}
 }
 
@@ -48,6 +56,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateUint32),
 		paramtypes.NewParamSetPair(KeyInitialValEngagementPoints, &p.InitialValEngagementPoints, validateUint64),
 		paramtypes.NewParamSetPair(KeyMinDelegationAmounts, &p.MinDelegationAmounts, validateSDKCoins),
+		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateUint32),
+		paramtypes.NewParamSetPair(KeyHistoricalValsetMode, &p.HistoricalValsetMode, validateHistoricalValsetMode),
+		paramtypes.NewParamSetPair(KeyFeeSplit, &p.FeeSplit, validateFeeSplit),
 	}
 }
 
@@ -57,9 +68,21 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		DefaultInitialValidatorEngagementPoints,
 		sdk.Coins{},
+		DefaultSignedBlocksWindow,
+		DefaultHistoricalValsetMode,
+		DefaultFeeSplit(),
 	)
 }
 
+// DefaultFeeSplit sends all fees to the valset contract
+func DefaultFeeSplit() FeeSplit {
+	return FeeSplit{
+		Valset:        sdk.OneDec(),
+		CommunityPool: sdk.ZeroDec(),
+		Burn:          sdk.ZeroDec(),
+	}
+}
+
 // String returns a human-readable string representation of the parameters.
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
@@ -71,6 +94,12 @@ func (p Params) String() string {
 
 // Validate validate a set of params
 func (p Params) Validate() error {
+	if err := validateHistoricalValsetMode(p.HistoricalValsetMode); err != nil {
+		return sdkerrors.Wrap(err, "historical valset mode")
+	}
+	if err := p.FeeSplit.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "fee split")
+	}
 	return sdkerrors.Wrap(p.MinDelegationAmounts.Validate(), "min delegation amounts")
 }
 
@@ -97,3 +126,65 @@ func validateSDKCoins(i interface{}) error {
 	}
 	return c.Validate()
 }
+
+func validateHistoricalValsetMode(i interface{}) error {
+	m, ok := i.(HistoricalValsetMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := HistoricalValsetMode_name[int32(m)]; !ok {
+		return fmt.Errorf("unknown mode: %d", m)
+	}
+	return nil
+}
+
+func validateFeeSplit(i interface{}) error {
+	s, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return s.ValidateBasic()
+}
+
+// ValidateBasic ensures that the shares are not negative and add up to 1. An empty split is valid and means
+// the default split.
+func (s FeeSplit) ValidateBasic() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	for _, v := range []struct {
+		name  string
+		share sdk.Dec
+	}{{"valset", s.Valset}, {"community pool", s.CommunityPool}, {"burn", s.Burn}} {
+		if v.share.IsNil() {
+			return sdkerrors.Wrapf(ErrEmpty, "%s share", v.name)
+		}
+		if v.share.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "%s share must not be negative", v.name)
+		}
+	}
+	if !s.Valset.Add(s.CommunityPool).Add(s.Burn).Equal(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "shares must add up to 1")
+	}
+	return nil
+}
+
+// IsEmpty returns true when no share is set
+func (s FeeSplit) IsEmpty() bool {
+	isEmpty := func(d sdk.Dec) bool { return d.IsNil() || d.IsZero() }
+	return isEmpty(s.Valset) && isEmpty(s.CommunityPool) && isEmpty(s.Burn)
+}
+
+// Split splits the fees by the shares. The community pool and burn amounts are rounded down so that the valset
+// contract receives the remainder.
+func (s FeeSplit) Split(fees sdk.Coins) (valset, communityPool, burn sdk.Coins) {
+	for _, c := range fees {
+		communityPoolAmount := s.CommunityPool.MulInt(c.Amount).TruncateInt()
+		burnAmount := s.Burn.MulInt(c.Amount).TruncateInt()
+		valsetAmount := c.Amount.Sub(communityPoolAmount).Sub(burnAmount)
+		valset = valset.Add(sdk.NewCoin(c.Denom, valsetAmount))
+		communityPool = communityPool.Add(sdk.NewCoin(c.Denom, communityPoolAmount))
+		burn = burn.Add(sdk.NewCoin(c.Denom, burnAmount))
+	}
+	return
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSplitValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    FeeSplit
+		expErr bool
+	}{
+		"default": {
+			src: DefaultFeeSplit(),
+		},
+		"all shares set": {
+			src: FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)},
+		},
+		"all burned": {
+			src: FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+		},
+		"empty": {
+			src: FeeSplit{},
+		},
+		"all zero": {
+			src: FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.ZeroDec()},
+		},
+		"below 1": {
+			src:    FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(1, 1)},
+			expErr: true,
+		},
+		"above 1": {
+			src:    FeeSplit{Valset: sdk.OneDec(), CommunityPool: sdk.NewDecWithPrec(1, 1), Burn: sdk.ZeroDec()},
+			expErr: true,
+		},
+		"negative share": {
+			src:    FeeSplit{Valset: sdk.NewDecWithPrec(11, 1), CommunityPool: sdk.NewDecWithPrec(-1, 1), Burn: sdk.ZeroDec()},
+			expErr: true,
+		},
+		"share not set": {
+			src:    FeeSplit{Valset: sdk.OneDec(), CommunityPool: sdk.ZeroDec()},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestFeeSplitSplit(t *testing.T) {
+	split := FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)}
+	specs := map[string]struct {
+		split            FeeSplit
+		src              sdk.Coins
+		expValset        sdk.Coins
+		expCommunityPool sdk.Coins
+		expBurn          sdk.Coins
+	}{
+		"exact": {
+			split:            split,
+			src:              sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(5))),
+			expCommunityPool: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3))),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+		},
+		"remainder to valset": {
+			split:            split,
+			src:              sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(6))),
+			expCommunityPool: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+		},
+		"too small to split": {
+			split:     split,
+			src:       sdk.NewCoins(sdk.NewCoin("ALX", sdk.OneInt())),
+			expValset: sdk.NewCoins(sdk.NewCoin("ALX", sdk.OneInt())),
+		},
+		"multiple denoms": {
+			split:            split,
+			src:              sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10)), sdk.NewCoin("BLX", sdk.NewInt(100))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(5)), sdk.NewCoin("BLX", sdk.NewInt(50))),
+			expCommunityPool: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3)), sdk.NewCoin("BLX", sdk.NewInt(30))),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2)), sdk.NewCoin("BLX", sdk.NewInt(20))),
+		},
+		"default split": {
+			split:     DefaultFeeSplit(),
+			src:       sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+			expValset: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+		},
+		"all burned": {
+			split:   FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+			src:     sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+			expBurn: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(9))),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotValset, gotCommunityPool, gotBurn := spec.split.Split(spec.src)
+			assert.Equal(t, spec.expValset.String(), gotValset.String())
+			assert.Equal(t, spec.expCommunityPool.String(), gotCommunityPool.String())
+			assert.Equal(t, spec.expBurn.String(), gotBurn.String())
+			// no coins lost
+			assert.Equal(t, spec.src, gotValset.Add(gotCommunityPool...).Add(gotBurn...))
+		})
+	}
+}
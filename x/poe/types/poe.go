@@ -4,6 +4,10 @@ import (
 	"sort"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	twasmcontract "github.com/confio/tgrade/x/twasm/contract"
 )
 
 func (t PoEContractType) ValidateBasic() error {
@@ -43,3 +47,42 @@ func IteratePoEContractTypes(cb func(tp PoEContractType) bool) {
 		}
 	}
 }
+
+// ValidateBasic ensure basic constraints
+func (s ValidatorSigningInfo) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(s.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if s.StartHeight < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "start height must not be negative")
+	}
+	if s.IndexOffset < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "index offset must not be negative")
+	}
+	if s.MissedBlocksCounter < 0 || s.MissedBlocksCounter > int64(len(s.MissedBlocks))*8 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "missed blocks counter exceeds bitmap")
+	}
+	if s.WindowSize != 0 && len(s.MissedBlocks) != int((s.WindowSize+7)/8) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "missed blocks bitmap does not match window size")
+	}
+	return nil
+}
+
+// ValidateBasic ensure basic constraints
+func (s Slashing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.OperatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "operator address")
+	}
+	if s.Height <= 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "height must be positive")
+	}
+	if s.Portion.IsNil() || !s.Portion.IsPositive() || s.Portion.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "portion must be in (0,1]")
+	}
+	switch s.EvidenceType {
+	case "", string(twasmcontract.EvidenceDuplicateVote), string(twasmcontract.EvidenceLightClientAttack):
+	default:
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "evidence type %q", s.EvidenceType)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
@@ -27,6 +29,7 @@ var _ = proto.Marshal
 var (
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -91,6 +94,41 @@ func (PoEContractType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_df6d9ea68813554a, []int{0}
 }
 
+// HistoricalValsetMode defines when the active validator set is stored with
+// the historical info
+type HistoricalValsetMode int32
+
+const (
+	// NONE stores the header only
+	HistoricalValsetModeNone HistoricalValsetMode = 0
+	// EVERY_BLOCK stores the active set at each height
+	HistoricalValsetModeEveryBlock HistoricalValsetMode = 1
+	// EPOCH stores the active set only at the first height after the valset
+	// contract has applied a new set. Other heights refer to the most recent
+	// stored set.
+	HistoricalValsetModeEpoch HistoricalValsetMode = 2
+)
+
+var HistoricalValsetMode_name = map[int32]string{
+	0: "NONE",
+	1: "EVERY_BLOCK",
+	2: "EPOCH",
+}
+
+var HistoricalValsetMode_value = map[string]int32{
+	"NONE":        0,
+	"EVERY_BLOCK": 1,
+	"EPOCH":       2,
+}
+
+func (x HistoricalValsetMode) String() string {
+	return proto.EnumName(HistoricalValsetMode_name, int32(x))
+}
+
+func (HistoricalValsetMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df6d9ea68813554a, []int{1}
+}
+
 // Params defines the parameters for the PoE module.
 type Params struct {
 	// HistoricalEntries is the number of historical entries to persist.
@@ -102,6 +140,16 @@ type Params struct {
 	// needs to self delegate to receive any engagement points. One must be
 	// exceeded. No minimum condition set when empty.
 	MinDelegationAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_delegation_amounts,json=minDelegationAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_delegation_amounts" yaml:"min_delegation_amounts"`
+	// SignedBlocksWindow is the number of blocks in the sliding window that is
+	// used to track missed blocks per validator. Tracking is disabled when 0.
+	SignedBlocksWindow uint32 `protobuf:"varint,4,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	// HistoricalValsetMode defines when the active validator set is stored with
+	// the historical info
+	HistoricalValsetMode HistoricalValsetMode `protobuf:"varint,5,opt,name=historical_valset_mode,json=historicalValsetMode,proto3,enum=confio.poe.v1beta1.HistoricalValsetMode" json:"historical_valset_mode,omitempty" yaml:"historical_valset_mode"`
+	// FeeSplit defines how the tx fees are split between the valset contract,
+	// the community pool contract and burning. All fees go to the valset
+	// contract when not set.
+	FeeSplit FeeSplit `protobuf:"bytes,6,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split" yaml:"fee_split"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -162,66 +210,359 @@ func (m *Params) GetMinDelegationAmounts() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *Params) GetSignedBlocksWindow() uint32 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetHistoricalValsetMode() HistoricalValsetMode {
+	if m != nil {
+		return m.HistoricalValsetMode
+	}
+	return HistoricalValsetModeNone
+}
+
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+// FeeSplit defines the shares of the tx fees. The shares must add up to 1.
+type FeeSplit struct {
+	// Valset share of the fees that is sent to the valset contract. It receives
+	// the rounding remainder of the other shares.
+	Valset github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=valset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset"`
+	// CommunityPool share of the fees that is sent to the community pool
+	// contract
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// Burn share of the fees that is burned
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6d9ea68813554a, []int{1}
+}
+
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// ValidatorSigningInfo defines the liveness data of a validator within the
+// signed blocks window.
+type ValidatorSigningInfo struct {
+	// Address is the validator consensus address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// StartHeight is the height at which the validator was first tracked
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// IndexOffset is the number of blocks tracked since the start height
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty" yaml:"index_offset"`
+	// MissedBlocksCounter is the number of missed blocks in the signed blocks
+	// window
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// MissedBlocks is the bitmap of the signed blocks window. A set bit is a
+	// missed block at position `index_offset % signed_blocks_window`.
+	MissedBlocks []byte `protobuf:"bytes,5,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty" yaml:"missed_blocks"`
+	// WindowSize is the signed blocks window that the bitmap was tracked with.
+	// The bitmap is reset when the window param changes.
+	WindowSize uint32 `protobuf:"varint,6,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty" yaml:"window_size"`
+}
+
+func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
+func (m *ValidatorSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningInfo) ProtoMessage()    {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6d9ea68813554a, []int{2}
+}
+
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
+func (m *ValidatorSigningInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorSigningInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetMissedBlocks() []byte {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *ValidatorSigningInfo) GetWindowSize() uint32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+// Slashing defines a slashing of a validator that was executed by the valset
+// contract.
+type Slashing struct {
+	// OperatorAddress is the validator operator address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// Height is the block height of the slashing
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time of the slashing
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Portion is the slashed portion of the validator's stake
+	Portion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=portion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"portion"`
+	// EvidenceType is the type of the evidence that triggered the slashing:
+	// `duplicate_vote` or `light_client_attack`. Empty when the slashing was not
+	// triggered by evidence, for example an oversight community punishment.
+	EvidenceType string `protobuf:"bytes,5,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty" yaml:"evidence_type"`
+}
+
+func (m *Slashing) Reset()         { *m = Slashing{} }
+func (m *Slashing) String() string { return proto.CompactTextString(m) }
+func (*Slashing) ProtoMessage()    {}
+func (*Slashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6d9ea68813554a, []int{3}
+}
+
+func (m *Slashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Slashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Slashing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Slashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slashing.Merge(m, src)
+}
+
+func (m *Slashing) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Slashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slashing proto.InternalMessageInfo
+
+func (m *Slashing) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *Slashing) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Slashing) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Slashing) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("confio.poe.v1beta1.PoEContractType", PoEContractType_name, PoEContractType_value)
+	proto.RegisterEnum("confio.poe.v1beta1.HistoricalValsetMode", HistoricalValsetMode_name, HistoricalValsetMode_value)
 	proto.RegisterType((*Params)(nil), "confio.poe.v1beta1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "confio.poe.v1beta1.FeeSplit")
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "confio.poe.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*Slashing)(nil), "confio.poe.v1beta1.Slashing")
 }
 
 func init() { proto.RegisterFile("confio/poe/v1beta1/poe.proto", fileDescriptor_df6d9ea68813554a) }
 
 var fileDescriptor_df6d9ea68813554a = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0x4d, 0xfa, 0x6f, 0x77, 0x67, 0x17, 0x30, 0xde, 0xb2, 0x6a, 0x86, 0xc6, 0x1e, 0x42, 0x57,
-	0x44, 0xa0, 0x26, 0x14, 0x38, 0xa0, 0x95, 0x58, 0xc9, 0x69, 0x4c, 0xb0, 0x48, 0xe2, 0xe0, 0xb8,
-	0x11, 0x70, 0xb1, 0x26, 0xc9, 0xd4, 0x1d, 0x35, 0x9e, 0x89, 0x3c, 0x93, 0x6a, 0xf3, 0x0d, 0x90,
-	0x4f, 0x88, 0x13, 0x17, 0x4b, 0x2b, 0xb8, 0xf1, 0x49, 0xf6, 0xb8, 0x47, 0x4e, 0x05, 0xb5, 0x17,
-	0xce, 0xfd, 0x04, 0xc8, 0x7f, 0xd2, 0x2e, 0xde, 0x02, 0x17, 0x7b, 0x7e, 0xf3, 0xde, 0xef, 0xbd,
-	0xd1, 0xfb, 0x8d, 0x06, 0xec, 0x4e, 0x38, 0x3b, 0xa6, 0xbc, 0x39, 0xe7, 0xa4, 0x79, 0x76, 0x30,
-	0x26, 0x12, 0x1f, 0x24, 0xeb, 0xc6, 0x3c, 0xe4, 0x92, 0xab, 0x6a, 0x86, 0x36, 0x92, 0x9d, 0x1c,
-	0x85, 0xdb, 0x3e, 0xf7, 0x79, 0x0a, 0x37, 0x93, 0x55, 0xc6, 0x84, 0x15, 0x9f, 0x73, 0x7f, 0x46,
-	0x9a, 0x69, 0x35, 0x5e, 0x1c, 0x37, 0x31, 0x5b, 0xe6, 0x90, 0x56, 0x84, 0xa6, 0x8b, 0x10, 0x4b,
-	0xca, 0x59, 0x8e, 0xeb, 0x45, 0x5c, 0xd2, 0x80, 0x08, 0x89, 0x83, 0xf9, 0x4a, 0x7b, 0xc2, 0x45,
-	0xc0, 0x85, 0x97, 0x99, 0x66, 0xc5, 0x4a, 0x3b, 0xab, 0x9a, 0x63, 0x2c, 0x6e, 0xce, 0x3f, 0xe1,
-	0x74, 0xa5, 0xbd, 0x97, 0xe3, 0x42, 0xe2, 0x53, 0xca, 0xfc, 0x6b, 0x4a, 0x5e, 0xe7, 0xac, 0x5d,
-	0x49, 0xd8, 0x94, 0x84, 0x01, 0x65, 0xb2, 0x29, 0x97, 0x73, 0x22, 0xb2, 0x6f, 0x86, 0xd6, 0xae,
-	0xd6, 0xc0, 0xd6, 0x00, 0x87, 0x38, 0x10, 0x6a, 0x17, 0xa8, 0x27, 0x54, 0x48, 0x1e, 0xd2, 0x09,
-	0x9e, 0x79, 0x84, 0xc9, 0x90, 0x12, 0xb1, 0x53, 0x46, 0xe5, 0xfa, 0x1b, 0xad, 0xea, 0xd5, 0xb9,
-	0x5e, 0x59, 0xe2, 0x60, 0xf6, 0xa4, 0xf6, 0x3a, 0xa7, 0xe6, 0xbc, 0x7d, 0xb3, 0x69, 0x66, 0x7b,
-	0xea, 0x29, 0xa8, 0x52, 0x46, 0x25, 0xc5, 0x33, 0xef, 0x2c, 0xa5, 0xfa, 0xd8, 0x27, 0x01, 0x61,
-	0xd2, 0x9b, 0x73, 0xca, 0xa4, 0xd8, 0x59, 0x43, 0xe5, 0xfa, 0x46, 0xab, 0x7e, 0x75, 0xae, 0xef,
-	0x65, 0xc2, 0xff, 0x49, 0xaf, 0x39, 0x30, 0xc7, 0x47, 0x89, 0xc7, 0x0a, 0x1d, 0xa4, 0xa0, 0xfa,
-	0x4b, 0x19, 0x3c, 0x0a, 0x28, 0xf3, 0xa6, 0x64, 0x46, 0xfc, 0x34, 0x7e, 0x0f, 0x07, 0x7c, 0x91,
-	0xd8, 0xac, 0xa3, 0xf5, 0xfa, 0xfd, 0x4f, 0x2a, 0x8d, 0x3c, 0xd9, 0x24, 0xcb, 0xd5, 0xb4, 0x1b,
-	0x87, 0x9c, 0xb2, 0xd6, 0x37, 0x2f, 0xce, 0xf5, 0xd2, 0xd5, 0xb9, 0x5e, 0xcd, 0x4e, 0x71, 0xbb,
-	0x4c, 0xed, 0xb7, 0x3f, 0xf4, 0xba, 0x4f, 0xe5, 0xc9, 0x62, 0xdc, 0x98, 0xf0, 0x20, 0x9f, 0x53,
-	0xfe, 0xdb, 0x17, 0xd3, 0xd3, 0x3c, 0xd4, 0x44, 0x51, 0x38, 0xdb, 0x01, 0x65, 0xed, 0x6b, 0x0d,
-	0x23, 0x93, 0x78, 0x72, 0xf7, 0xe7, 0xe7, 0x7a, 0xe9, 0xaf, 0xe7, 0x7a, 0xf9, 0xc3, 0x9f, 0x36,
-	0xc1, 0x5b, 0x03, 0x6e, 0x1e, 0x72, 0x26, 0x43, 0x3c, 0x91, 0xee, 0x72, 0x4e, 0xd4, 0x8f, 0xc0,
-	0xbd, 0xa3, 0x7e, 0xdb, 0xfc, 0xd2, 0xea, 0x9b, 0x6d, 0xa5, 0x04, 0x77, 0xa3, 0x18, 0xed, 0x14,
-	0x38, 0x47, 0x6c, 0x4a, 0x8e, 0x29, 0x23, 0x53, 0xf5, 0x03, 0x70, 0x67, 0xe8, 0x1a, 0x5f, 0x5b,
-	0xfd, 0x8e, 0x52, 0x86, 0x30, 0x8a, 0xd1, 0xa3, 0x02, 0x75, 0x98, 0x5d, 0x01, 0xf5, 0x31, 0xd8,
-	0x1a, 0x19, 0xdd, 0xa1, 0xe9, 0x2a, 0x6b, 0xb0, 0x12, 0xc5, 0xe8, 0x9d, 0x02, 0x6f, 0x84, 0x67,
-	0x82, 0x48, 0x75, 0x1f, 0x00, 0xb3, 0xdf, 0x31, 0x3a, 0x66, 0xcf, 0xec, 0xbb, 0xca, 0x3a, 0xac,
-	0x46, 0x31, 0xaa, 0x14, 0xa8, 0x37, 0xa1, 0xab, 0xef, 0x83, 0xcd, 0x9e, 0xf5, 0xad, 0xe9, 0x28,
-	0x1b, 0x70, 0x27, 0x8a, 0xd1, 0x76, 0x81, 0xd9, 0xa3, 0xcf, 0x48, 0xa8, 0x1e, 0x80, 0x07, 0x6d,
-	0x6b, 0xe8, 0x3a, 0x56, 0xeb, 0xc8, 0xb5, 0xec, 0xbe, 0xb2, 0x09, 0xf5, 0x28, 0x46, 0xef, 0x16,
-	0xb8, 0x6d, 0x2a, 0x64, 0x48, 0xc7, 0x8b, 0x24, 0x28, 0xf5, 0x29, 0x78, 0x68, 0x8f, 0x4c, 0x67,
-	0x68, 0x75, 0xbe, 0x72, 0xbd, 0x43, 0xbb, 0xd7, 0x3b, 0xea, 0x5b, 0xee, 0x77, 0xca, 0x16, 0x7c,
-	0x1c, 0xc5, 0xe8, 0xbd, 0x42, 0xa7, 0x7d, 0x46, 0x42, 0x41, 0xfd, 0x13, 0x79, 0xc8, 0x83, 0x60,
-	0xc1, 0xa8, 0x5c, 0xaa, 0x2e, 0xa8, 0xde, 0xd2, 0xef, 0x0d, 0x1c, 0x7b, 0x60, 0x0f, 0x8d, 0xee,
-	0x50, 0xb9, 0x03, 0x0f, 0xa2, 0x18, 0xed, 0xff, 0xaf, 0x52, 0x87, 0x9f, 0x0d, 0x42, 0x3e, 0xe7,
-	0x02, 0xcf, 0x84, 0xfa, 0x19, 0x78, 0xf3, 0x15, 0x2d, 0xdb, 0xee, 0x2a, 0x77, 0x21, 0x8a, 0x62,
-	0xb4, 0x5b, 0x90, 0xb9, 0xee, 0x1e, 0x70, 0x3e, 0x53, 0x3f, 0x07, 0xca, 0xc8, 0xe8, 0x5a, 0x6d,
-	0xc3, 0xb5, 0x1d, 0x6f, 0x64, 0xbb, 0xc9, 0xac, 0xee, 0xc1, 0x5a, 0x14, 0x23, 0xed, 0xf5, 0x19,
-	0xd0, 0x29, 0x96, 0x3c, 0x1c, 0x71, 0x99, 0xcc, 0xec, 0x63, 0xf0, 0xc0, 0x70, 0x5a, 0x96, 0x6b,
-	0x3a, 0x99, 0x1b, 0x80, 0x5a, 0x14, 0x23, 0x58, 0xe8, 0x32, 0xc2, 0x31, 0x95, 0x24, 0x4c, 0xbd,
-	0xbe, 0x00, 0x0f, 0x5f, 0xed, 0x58, 0xd9, 0xdd, 0x87, 0x7b, 0x51, 0x8c, 0xd0, 0xbf, 0x37, 0x66,
-	0x86, 0x70, 0xe3, 0x87, 0x5f, 0xb5, 0x52, 0xeb, 0xe9, 0x8b, 0x0b, 0xad, 0xfc, 0xf2, 0x42, 0x2b,
-	0xff, 0x79, 0xa1, 0x95, 0x7f, 0xbc, 0xd4, 0x4a, 0x2f, 0x2f, 0xb5, 0xd2, 0xef, 0x97, 0x5a, 0xe9,
-	0xfb, 0xbd, 0x7f, 0x5c, 0xfc, 0xf4, 0x45, 0x95, 0x7e, 0x88, 0xa7, 0xa4, 0xf9, 0x2c, 0x7d, 0x5a,
-	0xd3, 0xab, 0x3f, 0xde, 0x4a, 0x1f, 0x94, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x74,
-	0x0b, 0xa9, 0x75, 0x05, 0x00, 0x00,
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x37, 0x6d, 0xf9, 0xd7, 0xc9, 0x4e, 0xf4, 0x3d, 0x2b, 0x8e, 0xcc, 0xd8, 0x22, 0xc3, 0xaf,
+	0x93, 0x0a, 0x29, 0x22, 0xd5, 0x4e, 0x81, 0x06, 0x06, 0x12, 0x40, 0x94, 0x69, 0x5b, 0x88, 0x2d,
+	0x29, 0x94, 0xac, 0x36, 0x5d, 0x08, 0x4a, 0x3c, 0xd1, 0x07, 0x8b, 0x3c, 0x81, 0x3c, 0x3b, 0x71,
+	0xb7, 0x6e, 0x85, 0xd0, 0x21, 0xe8, 0xd4, 0x45, 0x45, 0xd0, 0x6c, 0x05, 0xfa, 0x7f, 0x64, 0xcc,
+	0x58, 0x74, 0x50, 0x8a, 0x64, 0xe9, 0xac, 0xa1, 0x73, 0xc1, 0x3b, 0xd2, 0x96, 0x15, 0xf5, 0x57,
+	0x16, 0x89, 0xef, 0xbd, 0xcf, 0xe7, 0xf3, 0x8e, 0xef, 0xbd, 0xbb, 0x23, 0x58, 0x6d, 0x12, 0xb7,
+	0x85, 0x49, 0xae, 0x43, 0x50, 0xee, 0x74, 0xa3, 0x81, 0xa8, 0xb9, 0x11, 0x3c, 0x67, 0x3b, 0x1e,
+	0xa1, 0x04, 0x42, 0x1e, 0xcd, 0x06, 0x9e, 0x30, 0x2a, 0x26, 0x6d, 0x62, 0x13, 0x16, 0xce, 0x05,
+	0x4f, 0x1c, 0x29, 0xae, 0xd8, 0x84, 0xd8, 0x6d, 0x94, 0x63, 0x56, 0xe3, 0xa4, 0x95, 0x33, 0xdd,
+	0xb3, 0x30, 0x94, 0x1e, 0x0d, 0x59, 0x27, 0x9e, 0x49, 0x31, 0x71, 0xc3, 0xb8, 0x34, 0x1a, 0xa7,
+	0xd8, 0x41, 0x3e, 0x35, 0x9d, 0x4e, 0xa4, 0xdd, 0x24, 0xbe, 0x43, 0x7c, 0x83, 0x27, 0xe5, 0x46,
+	0xa4, 0xcd, 0xad, 0x5c, 0xc3, 0xf4, 0x2f, 0xd6, 0xdf, 0x24, 0x38, 0xd2, 0x5e, 0x0f, 0xe3, 0x3e,
+	0x35, 0x8f, 0xb1, 0x6b, 0x9f, 0x43, 0x42, 0x3b, 0x44, 0xad, 0x52, 0xe4, 0x5a, 0xc8, 0x73, 0xb0,
+	0x4b, 0x73, 0xf4, 0xac, 0x83, 0x7c, 0xfe, 0xcb, 0xa3, 0xca, 0x0f, 0xd3, 0x60, 0xa6, 0x62, 0x7a,
+	0xa6, 0xe3, 0xc3, 0x7d, 0x00, 0x8f, 0xb0, 0x4f, 0x89, 0x87, 0x9b, 0x66, 0xdb, 0x40, 0x2e, 0xf5,
+	0x30, 0xf2, 0x53, 0x82, 0x2c, 0x64, 0x16, 0xd5, 0xb5, 0x41, 0x5f, 0x5a, 0x39, 0x33, 0x9d, 0xf6,
+	0x96, 0xf2, 0x3e, 0x46, 0xd1, 0xff, 0x77, 0xe1, 0xd4, 0xb8, 0x0f, 0x1e, 0x83, 0x35, 0xec, 0x62,
+	0x8a, 0xcd, 0xb6, 0x71, 0xca, 0xa0, 0xb6, 0x69, 0x23, 0x07, 0xb9, 0xd4, 0xe8, 0x10, 0xec, 0x52,
+	0x3f, 0x35, 0x29, 0x0b, 0x99, 0x98, 0x9a, 0x19, 0xf4, 0xa5, 0x75, 0x2e, 0xfc, 0xb7, 0x70, 0x45,
+	0x17, 0xc3, 0x78, 0x3d, 0xc8, 0x11, 0x45, 0x2b, 0x2c, 0x08, 0x7f, 0x14, 0xc0, 0xb2, 0x83, 0x5d,
+	0xc3, 0x42, 0x6d, 0x64, 0xb3, 0xf2, 0x1b, 0xa6, 0x43, 0x4e, 0x82, 0x34, 0x53, 0xf2, 0x54, 0x26,
+	0xbe, 0xb9, 0x92, 0x0d, 0x2b, 0x1b, 0xd4, 0x32, 0xea, 0x76, 0xb6, 0x40, 0xb0, 0xab, 0x3e, 0x7e,
+	0xd5, 0x97, 0x26, 0x06, 0x7d, 0x69, 0x8d, 0xaf, 0x62, 0xbc, 0x8c, 0xf2, 0xd3, 0x1b, 0x29, 0x63,
+	0x63, 0x7a, 0x74, 0xd2, 0xc8, 0x36, 0x89, 0x13, 0xf6, 0x29, 0xfc, 0xbb, 0xeb, 0x5b, 0xc7, 0x61,
+	0x51, 0x03, 0x45, 0x5f, 0x4f, 0x3a, 0xd8, 0xdd, 0x3e, 0xd7, 0xc8, 0x73, 0x09, 0xf8, 0x18, 0x24,
+	0x7d, 0x6c, 0xbb, 0xc8, 0x32, 0x1a, 0x6d, 0xd2, 0x3c, 0xf6, 0x8d, 0xa7, 0xd8, 0xb5, 0xc8, 0xd3,
+	0x54, 0x8c, 0x55, 0x58, 0x1a, 0xf4, 0xa5, 0x1b, 0x7c, 0x09, 0xe3, 0x50, 0x8a, 0x0e, 0xb9, 0x5b,
+	0x65, 0xde, 0xcf, 0x99, 0x13, 0x7e, 0x2d, 0x80, 0xe5, 0xa1, 0x7e, 0x9c, 0x9a, 0x6d, 0x1f, 0x51,
+	0xc3, 0x21, 0x16, 0x4a, 0x4d, 0xcb, 0x42, 0xe6, 0xca, 0x66, 0x26, 0xfb, 0xfe, 0x90, 0x67, 0xf7,
+	0xce, 0x19, 0x75, 0x46, 0x38, 0x20, 0x16, 0x52, 0x6f, 0x5e, 0x94, 0x60, 0xbc, 0xa2, 0xa2, 0x27,
+	0x8f, 0xc6, 0x10, 0x61, 0x15, 0xcc, 0xb7, 0x10, 0x32, 0xfc, 0x4e, 0x1b, 0xd3, 0xd4, 0x8c, 0x2c,
+	0x64, 0xe2, 0x9b, 0xab, 0xe3, 0xb2, 0xee, 0x20, 0x54, 0x0d, 0x30, 0x6a, 0x2a, 0x2c, 0x78, 0x82,
+	0x67, 0x3b, 0x27, 0x2b, 0xfa, 0x5c, 0x2b, 0xc4, 0x6c, 0xcd, 0x7d, 0xff, 0x42, 0x9a, 0xf8, 0xfd,
+	0x85, 0x24, 0x28, 0xdf, 0x4e, 0x82, 0xb9, 0x88, 0x0a, 0x77, 0xc0, 0x0c, 0x5f, 0x11, 0x1b, 0xcb,
+	0x79, 0x35, 0x1b, 0x48, 0xfd, 0xda, 0x97, 0x6e, 0xff, 0x8b, 0xd6, 0x6c, 0xa3, 0xa6, 0x1e, 0xb2,
+	0xa1, 0x0b, 0xae, 0x34, 0x89, 0xe3, 0x9c, 0xb8, 0x98, 0x9e, 0x19, 0x1d, 0x42, 0xda, 0x6c, 0x1a,
+	0xe7, 0xd5, 0xdd, 0xff, 0xa6, 0x37, 0xe8, 0x4b, 0xd7, 0xf8, 0x4b, 0x5c, 0x56, 0x53, 0xf4, 0xc5,
+	0x73, 0x47, 0x85, 0x90, 0x36, 0x54, 0x41, 0xac, 0x71, 0xe2, 0xb9, 0xa9, 0xa9, 0x0f, 0x5a, 0x35,
+	0xe3, 0x6e, 0xc5, 0x58, 0x39, 0xfe, 0x98, 0x04, 0xc9, 0xba, 0xd9, 0xc6, 0x96, 0x49, 0x89, 0x57,
+	0xc5, 0xb6, 0x8b, 0x5d, 0xbb, 0xe8, 0xb6, 0x08, 0x4c, 0x81, 0x59, 0xd3, 0xb2, 0x3c, 0xe4, 0xf3,
+	0x2d, 0x3b, 0xaf, 0x47, 0x26, 0xdc, 0x02, 0x0b, 0x3e, 0x35, 0x3d, 0x6a, 0x1c, 0x21, 0x6c, 0x1f,
+	0x51, 0xf6, 0xaa, 0x53, 0xea, 0xf5, 0x41, 0x5f, 0x5a, 0x0a, 0xe7, 0x6d, 0x28, 0xaa, 0xe8, 0x71,
+	0x66, 0xee, 0x31, 0x2b, 0xe0, 0x62, 0xd7, 0x42, 0xcf, 0x0c, 0xd2, 0x6a, 0x05, 0x65, 0x9f, 0x1a,
+	0xe5, 0x0e, 0x47, 0x15, 0x3d, 0xce, 0xcc, 0x32, 0xb3, 0x60, 0x0d, 0x5c, 0x73, 0xb0, 0xef, 0x5f,
+	0x4c, 0x72, 0x33, 0xd8, 0x07, 0xc8, 0x63, 0x03, 0x3f, 0xa5, 0xca, 0x83, 0xbe, 0xb4, 0x1a, 0xed,
+	0xb9, 0x31, 0x30, 0x45, 0x5f, 0xe2, 0x7e, 0x3e, 0xf1, 0x05, 0xee, 0x85, 0x0f, 0xc0, 0xe2, 0x25,
+	0x38, 0x1b, 0xf4, 0x05, 0x35, 0x35, 0xe8, 0x4b, 0xc9, 0x31, 0x6a, 0x8a, 0xbe, 0x30, 0xac, 0x02,
+	0x3f, 0x03, 0x71, 0xbe, 0xa1, 0x0c, 0x1f, 0x7f, 0x85, 0xd8, 0xbc, 0x2e, 0xaa, 0xcb, 0x83, 0xbe,
+	0x04, 0x39, 0x79, 0x28, 0xa8, 0xe8, 0x80, 0x5b, 0xd5, 0xc0, 0xf8, 0x79, 0x12, 0xcc, 0x55, 0xdb,
+	0xa6, 0x7f, 0x84, 0x5d, 0x1b, 0xee, 0x80, 0x04, 0xe9, 0x20, 0x2f, 0xe8, 0x81, 0x71, 0xa9, 0xea,
+	0xea, 0x8d, 0x41, 0x5f, 0xba, 0xce, 0xa5, 0x46, 0x11, 0x8a, 0x7e, 0x35, 0x72, 0xe5, 0xc3, 0xd6,
+	0x2c, 0x83, 0x99, 0xe1, 0xa6, 0xe8, 0xa1, 0x05, 0xef, 0x83, 0x58, 0x70, 0x4f, 0xb0, 0x72, 0xc7,
+	0x37, 0xc5, 0x2c, 0xbf, 0x44, 0xb2, 0xd1, 0x25, 0x92, 0xad, 0x45, 0x97, 0x88, 0x3a, 0x17, 0xcc,
+	0xd2, 0xf3, 0x37, 0x92, 0xa0, 0x33, 0x06, 0xdc, 0x03, 0xb3, 0x1d, 0xe2, 0x05, 0xc7, 0x4e, 0x2a,
+	0xf6, 0x41, 0xc3, 0x16, 0xd1, 0x83, 0x42, 0xa3, 0x53, 0x6c, 0x21, 0xb7, 0x89, 0x8c, 0x20, 0xcc,
+	0x0a, 0x3d, 0x3f, 0x5c, 0xe8, 0x4b, 0x61, 0x45, 0x5f, 0x88, 0xec, 0xda, 0x59, 0x07, 0xdd, 0xf9,
+	0x6e, 0x1a, 0x5c, 0xad, 0x10, 0xad, 0x40, 0x5c, 0xea, 0x99, 0x4d, 0x1a, 0xf8, 0xe0, 0xc7, 0x60,
+	0xfe, 0xb0, 0xb4, 0xad, 0xed, 0x14, 0x4b, 0xda, 0x76, 0x62, 0x42, 0x5c, 0xed, 0xf6, 0xe4, 0xd4,
+	0x08, 0xe6, 0xd0, 0xb5, 0x50, 0x0b, 0xbb, 0xc8, 0x82, 0x1f, 0x81, 0xd9, 0x6a, 0x2d, 0xff, 0xa8,
+	0x58, 0xda, 0x4d, 0x08, 0xa2, 0xd8, 0xed, 0xc9, 0xcb, 0x23, 0xd0, 0x2a, 0xbf, 0xe6, 0xe0, 0x2d,
+	0x30, 0x53, 0xcf, 0xef, 0x57, 0xb5, 0x5a, 0x62, 0x52, 0x5c, 0xe9, 0xf6, 0xe4, 0x6b, 0x23, 0x38,
+	0x7e, 0x56, 0xc1, 0xbb, 0x00, 0x68, 0xa5, 0xdd, 0xfc, 0xae, 0x76, 0xa0, 0x95, 0x6a, 0x89, 0x29,
+	0x71, 0xad, 0xdb, 0x93, 0x57, 0x46, 0xa0, 0x17, 0x17, 0x0b, 0xfc, 0x3f, 0x98, 0x3e, 0x28, 0x7e,
+	0xa1, 0xe9, 0x89, 0x98, 0x98, 0xea, 0xf6, 0xe4, 0xe4, 0x08, 0xf2, 0x00, 0x3f, 0x43, 0x1e, 0xdc,
+	0x00, 0x0b, 0xdb, 0xc5, 0x6a, 0x4d, 0x2f, 0xaa, 0x87, 0xb5, 0x62, 0xb9, 0x94, 0x98, 0x16, 0xa5,
+	0x6e, 0x4f, 0xbe, 0x31, 0x82, 0xdd, 0xc6, 0x3e, 0xf5, 0x70, 0xe3, 0x84, 0x95, 0xf5, 0x21, 0x58,
+	0x2a, 0xd7, 0x35, 0xbd, 0x5a, 0xdc, 0xdd, 0xab, 0x19, 0x85, 0xf2, 0xc1, 0xc1, 0x61, 0xa9, 0x58,
+	0x7b, 0x92, 0x98, 0x11, 0x6f, 0x75, 0x7b, 0xf2, 0xcd, 0x11, 0x66, 0xf9, 0x14, 0x79, 0x7e, 0x30,
+	0x11, 0x85, 0xe8, 0x38, 0x81, 0x35, 0xb0, 0x36, 0x86, 0x6f, 0x54, 0xf4, 0x72, 0xa5, 0x5c, 0xcd,
+	0xef, 0x57, 0x13, 0xb3, 0xe2, 0x46, 0xb7, 0x27, 0xdf, 0xfd, 0x47, 0xa5, 0x5d, 0x72, 0x5a, 0xf1,
+	0x48, 0x87, 0xf8, 0x66, 0xdb, 0x87, 0x9f, 0x82, 0x2b, 0x43, 0x5a, 0xe5, 0xf2, 0x7e, 0x62, 0x4e,
+	0x94, 0xbb, 0x3d, 0x79, 0x75, 0x44, 0xa6, 0x70, 0xe9, 0x58, 0xbb, 0x0f, 0x12, 0xf5, 0xfc, 0x7e,
+	0x71, 0x3b, 0x5f, 0x2b, 0xeb, 0x46, 0xbd, 0x5c, 0x0b, 0x7a, 0x35, 0x2f, 0x2a, 0xdd, 0x9e, 0x9c,
+	0x7e, 0xbf, 0x07, 0xfc, 0xc8, 0xaa, 0x13, 0x1a, 0xf4, 0xec, 0x13, 0xb0, 0x90, 0xd7, 0xd5, 0x62,
+	0x4d, 0xd3, 0x79, 0x36, 0x20, 0xa6, 0xbb, 0x3d, 0x59, 0x1c, 0x61, 0xe5, 0xbd, 0x06, 0xa6, 0xc8,
+	0x63, 0xb9, 0x1e, 0x80, 0xa5, 0x61, 0x46, 0x94, 0x2e, 0x2e, 0xae, 0x77, 0x7b, 0xb2, 0xfc, 0xd7,
+	0x44, 0x9e, 0x50, 0x8c, 0x7d, 0xf3, 0x32, 0x3d, 0x71, 0xe7, 0xa5, 0x00, 0x92, 0xe3, 0x6e, 0x3f,
+	0x78, 0x1b, 0xc4, 0x4a, 0xe5, 0x92, 0x16, 0x0d, 0xe5, 0x38, 0x4c, 0x89, 0xb8, 0x08, 0xde, 0x03,
+	0x71, 0xad, 0xae, 0xe9, 0x4f, 0x0c, 0x75, 0xbf, 0x5c, 0x78, 0x94, 0x10, 0xf8, 0xcb, 0x8e, 0x83,
+	0x6b, 0xa7, 0xc8, 0x3b, 0x63, 0x87, 0x0e, 0xcc, 0x80, 0x69, 0xad, 0x52, 0x2e, 0xec, 0x25, 0x26,
+	0xf9, 0xd0, 0x8d, 0x85, 0x77, 0x48, 0xf3, 0x88, 0xaf, 0x52, 0x7d, 0xf8, 0xea, 0x6d, 0x5a, 0x78,
+	0xfd, 0x36, 0x2d, 0xfc, 0xf6, 0x36, 0x2d, 0x3c, 0x7f, 0x97, 0x9e, 0x78, 0xfd, 0x2e, 0x3d, 0xf1,
+	0xcb, 0xbb, 0xf4, 0xc4, 0x97, 0xeb, 0x97, 0x36, 0x31, 0xfb, 0xb6, 0xa5, 0xb6, 0x67, 0x5a, 0x28,
+	0xf7, 0x8c, 0x7d, 0xe4, 0xb2, 0x6d, 0xdc, 0x98, 0x61, 0xe7, 0xc4, 0xbd, 0x3f, 0x07, 0x00, 0x66,
+	0x91, 0x8f, 0xda, 0xff, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -257,6 +598,46 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if this.HistoricalValsetMode != that1.HistoricalValsetMode {
+		return false
+	}
+	if !this.FeeSplit.Equal(&that1.FeeSplit) {
+		return false
+	}
+	return true
+}
+
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Valset.Equal(that1.Valset) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
 	return true
 }
 
@@ -280,6 +661,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.HistoricalValsetMode != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.HistoricalValsetMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.MinDelegationAmounts) > 0 {
 		for iNdEx := len(m.MinDelegationAmounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,66 +708,312 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPoe(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPoe(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovPoe(uint64(m.HistoricalEntries))
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
 	}
-	if m.InitialValEngagementPoints != 0 {
-		n += 1 + sovPoe(uint64(m.InitialValEngagementPoints))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
 	}
-	if len(m.MinDelegationAmounts) > 0 {
-		for _, e := range m.MinDelegationAmounts {
-			l = e.Size()
-			n += 1 + l + sovPoe(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Valset.Size()
+		i -= size
+		if _, err := m.Valset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovPoe(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sozPoe(x uint64) (n int) {
-	return sovPoe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPoe
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSize != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MissedBlocks) > 0 {
+		i -= len(m.MissedBlocks)
+		copy(dAtA[i:], m.MissedBlocks)
+		i = encodeVarintPoe(dAtA, i, uint64(len(m.MissedBlocks)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPoe(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Slashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintPoe(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Portion.Size()
+		i -= size
+		if _, err := m.Portion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPoe(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPoe(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintPoe(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoe(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoe(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovPoe(uint64(m.HistoricalEntries))
+	}
+	if m.InitialValEngagementPoints != 0 {
+		n += 1 + sovPoe(uint64(m.InitialValEngagementPoints))
+	}
+	if len(m.MinDelegationAmounts) > 0 {
+		for _, e := range m.MinDelegationAmounts {
+			l = e.Size()
+			n += 1 + l + sovPoe(uint64(l))
+		}
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovPoe(uint64(m.SignedBlocksWindow))
+	}
+	if m.HistoricalValsetMode != 0 {
+		n += 1 + sovPoe(uint64(m.HistoricalValsetMode))
+	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valset.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	return n
+}
+
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPoe(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovPoe(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovPoe(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovPoe(uint64(m.MissedBlocksCounter))
+	}
+	l = len(m.MissedBlocks)
+	if l > 0 {
+		n += 1 + l + sovPoe(uint64(l))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovPoe(uint64(m.WindowSize))
+	}
+	return n
+}
+
+func (m *Slashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPoe(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPoe(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPoe(uint64(l))
+	l = m.Portion.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovPoe(uint64(l))
+	}
+	return n
+}
+
+func sovPoe(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozPoe(x uint64) (n int) {
+	return sovPoe(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -449,6 +1096,624 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalValsetMode", wireType)
+			}
+			m.HistoricalValsetMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalValsetMode |= HistoricalValsetMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBlocks == nil {
+				m.MissedBlocks = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Slashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Portion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPoe(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -82,3 +83,59 @@ func TestPoEContractTypeFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestSlashingValidate(t *testing.T) {
+	specs := map[string]struct {
+		mutator func(s *Slashing)
+		expErr  bool
+	}{
+		"default": {
+			mutator: func(s *Slashing) {},
+		},
+		"duplicate vote": {
+			mutator: func(s *Slashing) { s.EvidenceType = "duplicate_vote" },
+		},
+		"light client attack": {
+			mutator: func(s *Slashing) { s.EvidenceType = "light_client_attack" },
+		},
+		"full portion": {
+			mutator: func(s *Slashing) { s.Portion = sdk.OneDec() },
+		},
+		"unknown evidence type": {
+			mutator: func(s *Slashing) { s.EvidenceType = "unknown" },
+			expErr:  true,
+		},
+		"invalid operator address": {
+			mutator: func(s *Slashing) { s.OperatorAddress = "invalid" },
+			expErr:  true,
+		},
+		"zero height": {
+			mutator: func(s *Slashing) { s.Height = 0 },
+			expErr:  true,
+		},
+		"zero portion": {
+			mutator: func(s *Slashing) { s.Portion = sdk.ZeroDec() },
+			expErr:  true,
+		},
+		"portion greater one": {
+			mutator: func(s *Slashing) { s.Portion = sdk.NewDec(2) },
+			expErr:  true,
+		},
+		"nil portion": {
+			mutator: func(s *Slashing) { s.Portion = sdk.Dec{} },
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			s := Slashing{OperatorAddress: RandomAccAddress().String(), Height: 1, Portion: sdk.NewDecWithPrec(1, 1)}
+			spec.mutator(&s)
+			gotErr := s.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
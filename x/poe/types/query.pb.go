@@ -11,6 +11,7 @@ import (
 	math_bits "math/bits"
 	time "time"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +24,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -676,1050 +678,6643 @@ func (m *QueryValidatorEngagementRewardResponse) GetReward() types.DecCoin {
 	return types.DecCoin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryContractAddressRequest)(nil), "confio.poe.v1beta1.QueryContractAddressRequest")
-	proto.RegisterType((*QueryContractAddressResponse)(nil), "confio.poe.v1beta1.QueryContractAddressResponse")
-	proto.RegisterType((*QueryUnbondingPeriodRequest)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodRequest")
-	proto.RegisterType((*QueryUnbondingPeriodResponse)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodResponse")
-	proto.RegisterType((*QueryValidatorDelegationRequest)(nil), "confio.poe.v1beta1.QueryValidatorDelegationRequest")
-	proto.RegisterType((*QueryValidatorDelegationResponse)(nil), "confio.poe.v1beta1.QueryValidatorDelegationResponse")
-	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryValidatorUnbondingDelegationsResponse)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardResponse")
-	proto.RegisterType((*QueryValidatorEngagementRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardRequest")
-	proto.RegisterType((*QueryValidatorEngagementRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardResponse")
+// QuerySigningInfoRequest is the request type for the Query/SigningInfo RPC
+// method.
+type QuerySigningInfoRequest struct {
+	// cons_address is the validator consensus address to query for.
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("confio/poe/v1beta1/query.proto", fileDescriptor_55a2242dcc0e0cfb) }
-
-var fileDescriptor_55a2242dcc0e0cfb = []byte{
-	// 1019 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcb, 0x6f, 0x1b, 0x45,
-	0x18, 0xc0, 0x3d, 0xa5, 0x34, 0xed, 0x94, 0xa4, 0x30, 0x70, 0x48, 0x4d, 0x62, 0x47, 0x4b, 0x93,
-	0x96, 0x40, 0x77, 0x1a, 0xa7, 0x52, 0xa9, 0x81, 0x4a, 0xe4, 0xd1, 0x06, 0x09, 0x44, 0xb0, 0x5a,
-	0x90, 0x90, 0x90, 0x35, 0xde, 0x9d, 0x6c, 0x46, 0xb5, 0x67, 0x9c, 0x9d, 0x71, 0xc0, 0x8a, 0x72,
-	0xe1, 0xc4, 0x11, 0x09, 0x21, 0x21, 0xb8, 0x54, 0x2a, 0xa7, 0x5e, 0xf8, 0x23, 0x40, 0xa2, 0xc7,
-	0x4a, 0x5c, 0x38, 0xb5, 0x55, 0xd2, 0x03, 0x17, 0x2e, 0xfc, 0x05, 0x68, 0x67, 0x67, 0xd6, 0xde,
-	0x64, 0xd7, 0x76, 0xd2, 0x03, 0xa7, 0xec, 0xec, 0xf7, 0xfa, 0x7d, 0x8f, 0x9d, 0x2f, 0x86, 0x25,
-	0x4f, 0xf0, 0x0d, 0x26, 0x70, 0x5b, 0x50, 0xbc, 0xbd, 0xd0, 0xa0, 0x8a, 0x2c, 0xe0, 0xad, 0x0e,
-	0x0d, 0xbb, 0x6e, 0x3b, 0x14, 0x4a, 0x20, 0x14, 0xcb, 0xdd, 0xb6, 0xa0, 0xae, 0x91, 0x17, 0xe7,
-	0x3d, 0x21, 0x5b, 0x42, 0xe2, 0x06, 0x91, 0x34, 0x56, 0x4e, 0x4c, 0xdb, 0x24, 0x60, 0x9c, 0x28,
-	0x26, 0x78, 0x6c, 0x5f, 0x7c, 0x2d, 0x10, 0x81, 0xd0, 0x8f, 0x38, 0x7a, 0x32, 0x6f, 0x4b, 0x81,
-	0x10, 0x41, 0x93, 0x62, 0x7d, 0x6a, 0x74, 0x36, 0xb0, 0xdf, 0x09, 0xfb, 0xad, 0xa6, 0x8c, 0x9c,
-	0xb4, 0x19, 0x26, 0x9c, 0x0b, 0xa5, 0x85, 0xd2, 0x4a, 0x33, 0x98, 0x23, 0x3e, 0xe3, 0xbb, 0x9f,
-	0xce, 0x8a, 0x3d, 0xc1, 0xac, 0xef, 0x0b, 0x46, 0x2e, 0x15, 0xb9, 0xcb, 0x78, 0x90, 0xa8, 0x98,
-	0xb3, 0xd1, 0x72, 0x72, 0xb4, 0xfa, 0x6a, 0xe3, 0x6c, 0xc1, 0xd7, 0x3f, 0x8d, 0x8e, 0xcb, 0x82,
-	0xab, 0x90, 0x78, 0xea, 0x03, 0xdf, 0x0f, 0xa9, 0x94, 0x35, 0xba, 0xd5, 0xa1, 0x52, 0xa1, 0x35,
-	0x38, 0xee, 0x19, 0x49, 0x5d, 0x75, 0xdb, 0x74, 0x12, 0xcc, 0x80, 0x4b, 0x13, 0x95, 0x37, 0xdc,
-	0xc3, 0x25, 0x75, 0xd7, 0xc5, 0xaa, 0xf5, 0x72, 0xbb, 0xdb, 0xa6, 0xb5, 0x97, 0xbc, 0xbe, 0x53,
-	0xf5, 0xf4, 0xb7, 0xf7, 0xca, 0x85, 0xbf, 0xef, 0x95, 0x0b, 0xce, 0x3b, 0x70, 0x2a, 0x3b, 0xa4,
-	0x6c, 0x0b, 0x2e, 0x29, 0x9a, 0x84, 0x63, 0x24, 0x7e, 0xa5, 0xa3, 0x9d, 0xa9, 0xd9, 0xa3, 0x33,
-	0x6d, 0x60, 0xef, 0xf0, 0x86, 0xe0, 0x3e, 0xe3, 0xc1, 0x3a, 0x0d, 0x99, 0xf0, 0x0d, 0xac, 0xf3,
-	0xb9, 0x71, 0x7c, 0x48, 0x6c, 0x1c, 0x5f, 0x83, 0x27, 0x15, 0x6b, 0xc5, 0x39, 0x9c, 0xad, 0x9c,
-	0x77, 0xe3, 0x06, 0xb9, 0xb6, 0x81, 0xee, 0x8a, 0x69, 0xe0, 0xd2, 0xe9, 0x87, 0x8f, 0xcb, 0x85,
-	0x1f, 0x9f, 0x94, 0x41, 0x4d, 0x1b, 0x38, 0x6b, 0xb0, 0xac, 0x1d, 0x7f, 0x46, 0x9a, 0xcc, 0x27,
-	0x4a, 0x84, 0x2b, 0xb4, 0x49, 0x03, 0xad, 0x6b, 0x0b, 0x35, 0x0b, 0x27, 0xb6, 0xad, 0xb4, 0x1e,
-	0xf1, 0x1a, 0xf6, 0xf1, 0xe4, 0x6d, 0x94, 0xa6, 0xf3, 0x25, 0x9c, 0xc9, 0xf7, 0x64, 0x30, 0xaf,
-	0xc3, 0xb1, 0x06, 0x69, 0x12, 0xee, 0xf5, 0x48, 0xe3, 0x46, 0xba, 0xd1, 0x38, 0x24, 0xe5, 0x5e,
-	0x16, 0x8c, 0x2f, 0x9d, 0x8c, 0x48, 0x6b, 0x56, 0xdf, 0xf9, 0x09, 0xc0, 0x37, 0xd3, 0xfe, 0x93,
-	0x5a, 0xf4, 0x02, 0xc9, 0xa3, 0x31, 0xa3, 0x9b, 0x10, 0xf6, 0x3e, 0x89, 0xc9, 0x13, 0x1a, 0x69,
-	0x2e, 0x85, 0x14, 0x0f, 0x54, 0x32, 0x07, 0x24, 0xa0, 0x26, 0x44, 0xad, 0xcf, 0xd2, 0xf9, 0x03,
-	0xc0, 0xf9, 0x51, 0xe0, 0x4c, 0x19, 0xd6, 0xe1, 0x18, 0xe5, 0x2a, 0x64, 0x34, 0x1a, 0x83, 0x17,
-	0x2e, 0x9d, 0xad, 0x5c, 0xb1, 0x31, 0xed, 0x94, 0xdb, 0x80, 0x19, 0x6e, 0x56, 0xb9, 0x0a, 0xbb,
-	0xb6, 0x3a, 0xc6, 0x0d, 0xba, 0x95, 0x91, 0xc8, 0xc5, 0xa1, 0x89, 0xc4, 0x38, 0xa9, 0x4c, 0xee,
-	0xc0, 0xb9, 0x74, 0x22, 0x9f, 0x74, 0x94, 0x54, 0x44, 0x33, 0xd4, 0xe8, 0x57, 0x24, 0xb4, 0x23,
-	0x89, 0xde, 0x82, 0xaf, 0xa4, 0x4b, 0xdc, 0x9b, 0xea, 0x97, 0x53, 0x55, 0x8e, 0xc6, 0xfb, 0x17,
-	0x00, 0x2f, 0x0e, 0xf5, 0x6b, 0xaa, 0xd3, 0x85, 0xa7, 0x42, 0xfd, 0xc6, 0xcc, 0xc8, 0x54, 0xe6,
-	0x8c, 0xac, 0x50, 0x4f, 0x8f, 0xc9, 0x72, 0x54, 0x88, 0x7f, 0x1f, 0x97, 0xc7, 0xbb, 0xa4, 0xd5,
-	0xac, 0x3a, 0xb1, 0xa5, 0xf3, 0xe0, 0x49, 0x79, 0x3e, 0x60, 0x6a, 0xb3, 0xd3, 0x70, 0x3d, 0xd1,
-	0xc2, 0xe6, 0xb6, 0x88, 0xff, 0x5c, 0x96, 0xfe, 0x5d, 0x1c, 0x7d, 0xf1, 0xd2, 0x3a, 0xa9, 0x99,
-	0x80, 0xce, 0x6d, 0x38, 0x9b, 0xa6, 0x5c, 0xe5, 0x01, 0x09, 0x68, 0x8b, 0x72, 0xf5, 0x1c, 0xc9,
-	0xdf, 0x07, 0x07, 0x8b, 0x7a, 0xd8, 0xed, 0xff, 0x9e, 0x7b, 0xe5, 0xb7, 0x71, 0xf8, 0xa2, 0xa6,
-	0x44, 0x0f, 0x00, 0x3c, 0x77, 0xe0, 0x06, 0x43, 0x38, 0xeb, 0x5a, 0x1c, 0x70, 0xbd, 0x16, 0xaf,
-	0x8c, 0x6e, 0x10, 0xe7, 0xee, 0x5c, 0xfd, 0xe6, 0xcf, 0x67, 0xdf, 0x9f, 0x70, 0xd1, 0xdb, 0x58,
-	0x05, 0x21, 0xf1, 0x69, 0x6a, 0x81, 0xd8, 0x0b, 0x17, 0xef, 0xa4, 0x2e, 0xed, 0x5d, 0xf4, 0x03,
-	0x80, 0x30, 0xa9, 0xab, 0x44, 0x6e, 0xde, 0x97, 0x94, 0x6e, 0x40, 0x82, 0x89, 0x47, 0xd6, 0x37,
-	0x94, 0x73, 0x9a, 0x72, 0x06, 0x95, 0xb2, 0x28, 0xb7, 0x7b, 0x20, 0xf7, 0x01, 0x3c, 0x93, 0x98,
-	0xa3, 0xcb, 0xa3, 0x85, 0xb1, 0x54, 0xee, 0xa8, 0xea, 0x06, 0xea, 0x9a, 0x86, 0x5a, 0x40, 0x78,
-	0x30, 0x14, 0xde, 0x49, 0x0f, 0xed, 0x2e, 0xfa, 0x19, 0xc0, 0x73, 0x07, 0x76, 0xca, 0x80, 0x56,
-	0x67, 0x2f, 0xa7, 0x01, 0xad, 0xce, 0x59, 0x57, 0xce, 0xac, 0xe6, 0x2d, 0xa3, 0xe9, 0x2c, 0xde,
-	0x8e, 0x35, 0x42, 0xbf, 0x03, 0xf8, 0x6a, 0xc6, 0x3a, 0x41, 0x8b, 0xb9, 0x01, 0xf3, 0xd7, 0x58,
-	0xf1, 0xea, 0xd1, 0x8c, 0x0c, 0xe9, 0x92, 0x26, 0x7d, 0x0f, 0x55, 0x35, 0xa2, 0xa1, 0x1d, 0xa1,
-	0xb2, 0xd8, 0xef, 0xe1, 0xfe, 0x03, 0xe0, 0xf4, 0xc0, 0xc5, 0x80, 0xde, 0x1f, 0xce, 0x36, 0x60,
-	0xdb, 0x15, 0x6f, 0x1c, 0xd7, 0xdc, 0x24, 0xf9, 0xb1, 0x4e, 0xf2, 0x16, 0x5a, 0x3d, 0xe2, 0xf8,
-	0xf4, 0x5a, 0x55, 0xf7, 0xfb, 0xb2, 0xf9, 0x15, 0xc0, 0x89, 0x35, 0x26, 0x95, 0x08, 0x99, 0x47,
-	0x9a, 0x1f, 0xf2, 0x0d, 0x81, 0x2a, 0x03, 0x07, 0x3a, 0xad, 0x6c, 0xb3, 0x5a, 0x3c, 0x92, 0xcd,
-	0x28, 0x97, 0xc8, 0x66, 0x62, 0x53, 0x67, 0x7c, 0x43, 0xe0, 0x9d, 0x4d, 0xca, 0x82, 0x4d, 0xb5,
-	0x8b, 0x9e, 0x01, 0x58, 0xcc, 0xdf, 0x4c, 0xa8, 0x3a, 0xbc, 0xbe, 0x79, 0x6b, 0xb2, 0xf8, 0xee,
-	0xb1, 0x6c, 0x9f, 0xaf, 0x31, 0x54, 0xca, 0x5d, 0x2c, 0x7a, 0x5e, 0xeb, 0xf1, 0x15, 0x8f, 0x9e,
-	0x02, 0x78, 0x3e, 0x77, 0x07, 0xa1, 0xeb, 0xc3, 0x49, 0x73, 0xd6, 0x61, 0xb1, 0x7a, 0x1c, 0x53,
-	0x93, 0xe3, 0x47, 0x3a, 0xc7, 0x9b, 0x68, 0xe5, 0x18, 0x39, 0xd2, 0xc4, 0xa9, 0x49, 0x71, 0xe9,
-	0xc6, 0xc3, 0xbd, 0x12, 0x78, 0xb4, 0x57, 0x02, 0x4f, 0xf7, 0x4a, 0xe0, 0xbb, 0xfd, 0x52, 0xe1,
-	0xd1, 0x7e, 0xa9, 0xf0, 0xd7, 0x7e, 0xa9, 0xf0, 0xc5, 0x85, 0xd4, 0x4e, 0xd4, 0xbf, 0x50, 0x4c,
-	0xc0, 0xaf, 0xe3, 0x6f, 0x3b, 0xda, 0x8a, 0x8d, 0x53, 0xfa, 0x5f, 0xe6, 0xc5, 0xff, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x66, 0x42, 0xe2, 0x28, 0x79, 0x0d, 0x00, 0x00,
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{12}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ContractAddress queries the address for one of the PoE contracts
-	ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
-	// Validators queries all validators that match the given status.
-	Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error)
-	// Validator queries validator info for given validator address.
-	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
-	// ValidatorDelegation queries self delegated amount for given validator.
-	ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error)
-	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
-	ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error)
-	// ValidatorEngagementReward queries rewards of a validator address.
-	ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error)
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
-	out := new(QueryContractAddressResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ContractAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfoRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error) {
-	out := new(types1.QueryValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QuerySigningInfoResponse is the response type for the Query/SigningInfo RPC
+// method.
+type QuerySigningInfoResponse struct {
+	// val_signing_info is the liveness data of the validator
+	ValSigningInfo ValidatorSigningInfo `protobuf:"bytes,1,opt,name=val_signing_info,json=valSigningInfo,proto3" json:"val_signing_info"`
+	// signed_blocks_window is the current window length
+	SignedBlocksWindow uint32 `protobuf:"varint,2,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error) {
-	out := new(types1.QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{13}
 }
 
-func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
-	out := new(QueryUnbondingPeriodResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/UnbondingPeriod", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (c *queryClient) ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error) {
-	out := new(QueryValidatorDelegationResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error) {
-	out := new(QueryValidatorUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
 }
 
-func (c *queryClient) HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error) {
-	out := new(types1.QueryHistoricalInfoResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/HistoricalInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (c *queryClient) ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error) {
-	out := new(QueryValidatorOutstandingRewardResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorOutstandingReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetValSigningInfo() ValidatorSigningInfo {
+	if m != nil {
+		return m.ValSigningInfo
 	}
-	return out, nil
+	return ValidatorSigningInfo{}
 }
 
-func (c *queryClient) ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error) {
-	out := new(QueryValidatorEngagementRewardResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorEngagementReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySigningInfoResponse) GetSignedBlocksWindow() uint32 {
+	if m != nil {
+		return m.SignedBlocksWindow
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ContractAddress queries the address for one of the PoE contracts
-	ContractAddress(context.Context, *QueryContractAddressRequest) (*QueryContractAddressResponse, error)
-	// Validators queries all validators that match the given status.
-	Validators(context.Context, *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(context.Context, *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error)
-	// Validator queries validator info for given validator address.
-	UnbondingPeriod(context.Context, *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error)
-	// ValidatorDelegation queries self delegated amount for given validator.
-	ValidatorDelegation(context.Context, *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error)
-	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
-	ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(context.Context, *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingReward(context.Context, *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error)
-	// ValidatorEngagementReward queries rewards of a validator address.
-	ValidatorEngagementReward(context.Context, *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error)
+// QueryHistoricalInfosRequest is the request type for the Query/HistoricalInfos
+// RPC method.
+type QueryHistoricalInfosRequest struct {
+	// min_height is the first height to include. Unbounded when 0.
+	MinHeight int64 `protobuf:"varint,1,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height is the last height to include. Unbounded when 0.
+	MaxHeight int64 `protobuf:"varint,2,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+func (m *QueryHistoricalInfosRequest) Reset()         { *m = QueryHistoricalInfosRequest{} }
+func (m *QueryHistoricalInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalInfosRequest) ProtoMessage()    {}
+func (*QueryHistoricalInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{14}
+}
 
-func (*UnimplementedQueryServer) ContractAddress(ctx context.Context, req *QueryContractAddressRequest) (*QueryContractAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractAddress not implemented")
+func (m *QueryHistoricalInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryHistoricalInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+func (m *QueryHistoricalInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalInfosRequest.Merge(m, src)
 }
 
-func (*UnimplementedQueryServer) UnbondingPeriod(ctx context.Context, req *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+func (m *QueryHistoricalInfosRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (*UnimplementedQueryServer) ValidatorDelegation(ctx context.Context, req *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegation not implemented")
+func (m *QueryHistoricalInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalInfosRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) ValidatorUnbondingDelegations(ctx context.Context, req *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
+var xxx_messageInfo_QueryHistoricalInfosRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalInfosRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+func (m *QueryHistoricalInfosRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) ValidatorOutstandingReward(ctx context.Context, req *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingReward not implemented")
+func (m *QueryHistoricalInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) ValidatorEngagementReward(ctx context.Context, req *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEngagementReward not implemented")
+// QueryHistoricalInfosResponse is the response type for the
+// Query/HistoricalInfos RPC method.
+type QueryHistoricalInfosResponse struct {
+	// hists are the stored historical infos ordered by height
+	Hists []types1.HistoricalInfo `protobuf:"bytes,1,rep,name=hists,proto3" json:"hists"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryHistoricalInfosResponse) Reset()         { *m = QueryHistoricalInfosResponse{} }
+func (m *QueryHistoricalInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalInfosResponse) ProtoMessage()    {}
+func (*QueryHistoricalInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{15}
 }
 
-func _Query_ContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ContractAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractAddress(ctx, req.(*QueryContractAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryHistoricalInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*types1.QueryValidatorsRequest))
+func (m *QueryHistoricalInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*types1.QueryValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryHistoricalInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalInfosResponse.Merge(m, src)
 }
 
-func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingPeriodRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbondingPeriod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/UnbondingPeriod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriodRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryHistoricalInfosResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func _Query_ValidatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorDelegation(ctx, req.(*QueryValidatorDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryHistoricalInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalInfosResponse.DiscardUnknown(m)
 }
 
-func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, req.(*QueryValidatorUnbondingDelegationsRequest))
+var xxx_messageInfo_QueryHistoricalInfosResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalInfosResponse) GetHists() []types1.HistoricalInfo {
+	if m != nil {
+		return m.Hists
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryHistoricalInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HistoricalInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/HistoricalInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HistoricalInfo(ctx, req.(*types1.QueryHistoricalInfoRequest))
+func (m *QueryHistoricalInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorOutstandingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorOutstandingRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorOutstandingReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorOutstandingReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorOutstandingReward(ctx, req.(*QueryValidatorOutstandingRewardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValsetEpochRequest is the request type for the Query/ValsetEpoch RPC
+// method.
+type QueryValsetEpochRequest struct{}
+
+func (m *QueryValsetEpochRequest) Reset()         { *m = QueryValsetEpochRequest{} }
+func (m *QueryValsetEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetEpochRequest) ProtoMessage()    {}
+func (*QueryValsetEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{16}
 }
 
-func _Query_ValidatorEngagementReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorEngagementRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorEngagementReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorEngagementReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorEngagementReward(ctx, req.(*QueryValidatorEngagementRewardRequest))
+func (m *QueryValsetEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryValsetEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.poe.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ContractAddress",
-			Handler:    _Query_ContractAddress_Handler,
-		},
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "UnbondingPeriod",
-			Handler:    _Query_UnbondingPeriod_Handler,
-		},
-		{
-			MethodName: "ValidatorDelegation",
-			Handler:    _Query_ValidatorDelegation_Handler,
-		},
-		{
-			MethodName: "ValidatorUnbondingDelegations",
-			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "HistoricalInfo",
-			Handler:    _Query_HistoricalInfo_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingReward",
-			Handler:    _Query_ValidatorOutstandingReward_Handler,
-		},
-		{
-			MethodName: "ValidatorEngagementReward",
-			Handler:    _Query_ValidatorEngagementReward_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/poe/v1beta1/query.proto",
+func (m *QueryValsetEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetEpochRequest.Merge(m, src)
 }
 
-func (m *QueryContractAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetEpochRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetEpochRequest.DiscardUnknown(m)
 }
 
-func (m *QueryContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ContractType))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryValsetEpochRequest proto.InternalMessageInfo
+
+// QueryValsetEpochResponse is the response type for the Query/ValsetEpoch RPC
+// method.
+type QueryValsetEpochResponse struct {
+	// epoch_length is the duration of an epoch. The validator set is updated
+	// only once per epoch.
+	EpochLength time.Duration `protobuf:"bytes,1,opt,name=epoch_length,json=epochLength,proto3,stdduration" json:"epoch_length"`
+	// current_epoch is the current epoch number (block time / epoch length)
+	CurrentEpoch uint64 `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// last_update_time is the block time of the last validator set update
+	LastUpdateTime time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time"`
+	// last_update_height is the block height of the last validator set update
+	LastUpdateHeight uint64 `protobuf:"varint,4,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	// next_update_time is the earliest block time of the next validator set
+	// update
+	NextUpdateTime time.Time `protobuf:"bytes,5,opt,name=next_update_time,json=nextUpdateTime,proto3,stdtime" json:"next_update_time"`
 }
 
-func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetEpochResponse) Reset()         { *m = QueryValsetEpochResponse{} }
+func (m *QueryValsetEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetEpochResponse) ProtoMessage()    {}
+func (*QueryValsetEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{17}
 }
 
-func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValsetEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingPeriodRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetEpochResponse.Merge(m, src)
 }
 
-func (m *QueryUnbondingPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetEpochResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryUnbondingPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValsetEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetEpochResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingPeriodResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValsetEpochResponse proto.InternalMessageInfo
+
+func (m *QueryValsetEpochResponse) GetEpochLength() time.Duration {
+	if m != nil {
+		return m.EpochLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryUnbondingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetEpochResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
 }
 
-func (m *QueryUnbondingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time):])
-	if err1 != nil {
-		return 0, err1
+func (m *QueryValsetEpochResponse) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
 	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryValidatorDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetEpochResponse) GetLastUpdateHeight() uint64 {
+	if m != nil {
+		return m.LastUpdateHeight
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryValidatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *QueryValidatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValsetEpochResponse) GetNextUpdateTime() time.Time {
+	if m != nil {
+		return m.NextUpdateTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryValidatorDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryValsetConfigRequest is the request type for the Query/ValsetConfig RPC
+// method.
+type QueryValsetConfigRequest struct{}
+
+func (m *QueryValsetConfigRequest) Reset()         { *m = QueryValsetConfigRequest{} }
+func (m *QueryValsetConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetConfigRequest) ProtoMessage()    {}
+func (*QueryValsetConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{18}
 }
 
-func (m *QueryValidatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryValidatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValsetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetConfigRequest.Merge(m, src)
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetConfigRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryValsetConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryValsetConfigRequest proto.InternalMessageInfo
 
-func (m *QueryValidatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValsetConfigResponse is the response type for the Query/ValsetConfig
+// RPC method.
+type QueryValsetConfigResponse struct {
+	// membership is the address of the contract that provides the points
+	Membership string `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"`
+	// min_points is the minimum number of points to be a validator
+	MinPoints uint64 `protobuf:"varint,2,opt,name=min_points,json=minPoints,proto3" json:"min_points,omitempty"`
+	// max_validators is the maximum number of validators in the active set
+	MaxValidators uint32 `protobuf:"varint,3,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// scaling is the factor to scale the points to the validator power
+	Scaling uint32 `protobuf:"varint,4,opt,name=scaling,proto3" json:"scaling,omitempty"`
+	// epoch_reward is the amount minted as reward per epoch
+	EpochReward types.Coin `protobuf:"bytes,5,opt,name=epoch_reward,json=epochReward,proto3" json:"epoch_reward"`
+	// fee_percentage is the share of the collected fees that is subtracted from
+	// the minted reward
+	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage"`
+	// distribution_contracts receive their ratio of the epoch reward
+	DistributionContracts []RewardDistributionContract `protobuf:"bytes,7,rep,name=distribution_contracts,json=distributionContracts,proto3" json:"distribution_contracts"`
+	// validator_group is the address of the contract that distributes the
+	// validator rewards
+	ValidatorGroup string `protobuf:"bytes,8,opt,name=validator_group,json=validatorGroup,proto3" json:"validator_group,omitempty"`
+	// auto_unjail unjails a validator automatically after the jailing period
+	AutoUnjail bool `protobuf:"varint,9,opt,name=auto_unjail,json=autoUnjail,proto3" json:"auto_unjail,omitempty"`
+}
+
+func (m *QueryValsetConfigResponse) Reset()         { *m = QueryValsetConfigResponse{} }
+func (m *QueryValsetConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetConfigResponse) ProtoMessage()    {}
+func (*QueryValsetConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{19}
+}
+
+func (m *QueryValsetConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValsetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetConfigResponse.Merge(m, src)
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetConfigResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValsetConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetConfigResponse proto.InternalMessageInfo
+
+func (m *QueryValsetConfigResponse) GetMembership() string {
+	if m != nil {
+		return m.Membership
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetConfigResponse) GetMinPoints() uint64 {
+	if m != nil {
+		return m.MinPoints
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetConfigResponse) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryValsetConfigResponse) GetScaling() uint32 {
+	if m != nil {
+		return m.Scaling
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetConfigResponse) GetEpochReward() types.Coin {
+	if m != nil {
+		return m.EpochReward
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryValidatorEngagementRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetConfigResponse) GetDistributionContracts() []RewardDistributionContract {
+	if m != nil {
+		return m.DistributionContracts
+	}
+	return nil
 }
 
-func (m *QueryValidatorEngagementRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValsetConfigResponse) GetValidatorGroup() string {
+	if m != nil {
+		return m.ValidatorGroup
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorEngagementRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetConfigResponse) GetAutoUnjail() bool {
+	if m != nil {
+		return m.AutoUnjail
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryValidatorEngagementRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardDistributionContract is a contract that receives a share of the epoch
+// reward
+type RewardDistributionContract struct {
+	// address is the contract address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// ratio is the share of the epoch reward. Range 0 - 1
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
 }
 
-func (m *QueryValidatorEngagementRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+func (m *RewardDistributionContract) Reset()         { *m = RewardDistributionContract{} }
+func (m *RewardDistributionContract) String() string { return proto.CompactTextString(m) }
+func (*RewardDistributionContract) ProtoMessage()    {}
+func (*RewardDistributionContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{20}
+}
+
+func (m *RewardDistributionContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RewardDistributionContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDistributionContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *RewardDistributionContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDistributionContract.Merge(m, src)
 }
 
-func (m *QueryContractAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractType != 0 {
-		n += 1 + sovQuery(uint64(m.ContractType))
-	}
-	return n
+func (m *RewardDistributionContract) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryContractAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RewardDistributionContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDistributionContract.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RewardDistributionContract proto.InternalMessageInfo
+
+func (m *RewardDistributionContract) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryUnbondingPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QuerySimulateNextValidatorSetRequest is the request type for the
+// Query/SimulateNextValidatorSet RPC method.
+type QuerySimulateNextValidatorSetRequest struct{}
+
+func (m *QuerySimulateNextValidatorSetRequest) Reset()         { *m = QuerySimulateNextValidatorSetRequest{} }
+func (m *QuerySimulateNextValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateNextValidatorSetRequest) ProtoMessage()    {}
+func (*QuerySimulateNextValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{21}
 }
 
-func (m *QueryValidatorDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySimulateNextValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryValidatorDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateNextValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateNextValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateNextValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateNextValidatorSetRequest.Merge(m, src)
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateNextValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateNextValidatorSetRequest proto.InternalMessageInfo
+
+// QuerySimulateNextValidatorSetResponse is the response type for the
+// Query/SimulateNextValidatorSet RPC method.
+type QuerySimulateNextValidatorSetResponse struct {
+	// validators are the members of the simulated active set
+	Validators []ValidatorPower `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) Reset()         { *m = QuerySimulateNextValidatorSetResponse{} }
+func (m *QuerySimulateNextValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateNextValidatorSetResponse) ProtoMessage()    {}
+func (*QuerySimulateNextValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{22}
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateNextValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateNextValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateNextValidatorSetResponse.Merge(m, src)
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateNextValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateNextValidatorSetResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateNextValidatorSetResponse) GetValidators() []ValidatorPower {
+	if m != nil {
+		return m.Validators
 	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// ValidatorPower is a member of the active validator set
+type ValidatorPower struct {
+	// operator_address is the validator operator address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// cons_address is the validator consensus address
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// power is the voting power of the validator
+	Power uint64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{23}
+}
+
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ValidatorPower) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorPower) GetPower() uint64 {
+	if m != nil {
+		return m.Power
 	}
-	var l int
-	_ = l
-	l = m.Reward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QuerySlashingsRequest is the request type for the Query/Slashings RPC method.
+type QuerySlashingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingsRequest) Reset()         { *m = QuerySlashingsRequest{} }
+func (m *QuerySlashingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingsRequest) ProtoMessage()    {}
+func (*QuerySlashingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{24}
+}
+
+func (m *QuerySlashingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySlashingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+
+func (m *QuerySlashingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingsRequest.Merge(m, src)
+}
+
+func (m *QuerySlashingsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySlashingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
+}
+
+// QuerySlashingsResponse is the response type for the Query/Slashings RPC
+// method.
+type QuerySlashingsResponse struct {
+	// slashings are the recorded slashings ordered by height
+	Slashings []Slashing `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingsResponse) Reset()         { *m = QuerySlashingsResponse{} }
+func (m *QuerySlashingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingsResponse) ProtoMessage()    {}
+func (*QuerySlashingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{25}
+}
+
+func (m *QuerySlashingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySlashingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QuerySlashingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingsResponse.Merge(m, src)
+}
+
+func (m *QuerySlashingsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySlashingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingsResponse) GetSlashings() []Slashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
+
+func (m *QuerySlashingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEngagementPointsRequest is the request type for the
+// Query/EngagementPoints RPC method.
+type QueryEngagementPointsRequest struct {
+	// address is the bech32 address to query for
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryEngagementPointsRequest) Reset()         { *m = QueryEngagementPointsRequest{} }
+func (m *QueryEngagementPointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementPointsRequest) ProtoMessage()    {}
+func (*QueryEngagementPointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{26}
+}
+
+func (m *QueryEngagementPointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEngagementPointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementPointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEngagementPointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementPointsRequest.Merge(m, src)
+}
+
+func (m *QueryEngagementPointsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEngagementPointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementPointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEngagementPointsRequest proto.InternalMessageInfo
+
+func (m *QueryEngagementPointsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEngagementPointsResponse is the response type for the
+// Query/EngagementPoints RPC method.
+type QueryEngagementPointsResponse struct {
+	// points are the engagement points of the address
+	Points uint64 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *QueryEngagementPointsResponse) Reset()         { *m = QueryEngagementPointsResponse{} }
+func (m *QueryEngagementPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementPointsResponse) ProtoMessage()    {}
+func (*QueryEngagementPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{27}
+}
+
+func (m *QueryEngagementPointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEngagementPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementPointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEngagementPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementPointsResponse.Merge(m, src)
+}
+
+func (m *QueryEngagementPointsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEngagementPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementPointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEngagementPointsResponse proto.InternalMessageInfo
+
+func (m *QueryEngagementPointsResponse) GetPoints() uint64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+// QueryListEngagementMembersRequest is the request type for the
+// Query/ListEngagementMembers RPC method.
+type QueryListEngagementMembersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListEngagementMembersRequest) Reset()         { *m = QueryListEngagementMembersRequest{} }
+func (m *QueryListEngagementMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListEngagementMembersRequest) ProtoMessage()    {}
+func (*QueryListEngagementMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{28}
+}
+
+func (m *QueryListEngagementMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryListEngagementMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEngagementMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryListEngagementMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEngagementMembersRequest.Merge(m, src)
+}
+
+func (m *QueryListEngagementMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryListEngagementMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEngagementMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListEngagementMembersRequest proto.InternalMessageInfo
+
+func (m *QueryListEngagementMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListEngagementMembersResponse is the response type for the
+// Query/ListEngagementMembers RPC method.
+type QueryListEngagementMembersResponse struct {
+	// members are the engagement members ordered by address
+	Members []TG4Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListEngagementMembersResponse) Reset()         { *m = QueryListEngagementMembersResponse{} }
+func (m *QueryListEngagementMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListEngagementMembersResponse) ProtoMessage()    {}
+func (*QueryListEngagementMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{29}
+}
+
+func (m *QueryListEngagementMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryListEngagementMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListEngagementMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryListEngagementMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListEngagementMembersResponse.Merge(m, src)
+}
+
+func (m *QueryListEngagementMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryListEngagementMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListEngagementMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListEngagementMembersResponse proto.InternalMessageInfo
+
+func (m *QueryListEngagementMembersResponse) GetMembers() []TG4Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryListEngagementMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEngagementHalflifeRequest is the request type for the
+// Query/EngagementHalflife RPC method.
+type QueryEngagementHalflifeRequest struct{}
+
+func (m *QueryEngagementHalflifeRequest) Reset()         { *m = QueryEngagementHalflifeRequest{} }
+func (m *QueryEngagementHalflifeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementHalflifeRequest) ProtoMessage()    {}
+func (*QueryEngagementHalflifeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{30}
+}
+
+func (m *QueryEngagementHalflifeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEngagementHalflifeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementHalflifeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEngagementHalflifeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementHalflifeRequest.Merge(m, src)
+}
+
+func (m *QueryEngagementHalflifeRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEngagementHalflifeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementHalflifeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEngagementHalflifeRequest proto.InternalMessageInfo
+
+// QueryEngagementHalflifeResponse is the response type for the
+// Query/EngagementHalflife RPC method. All fields are empty when the halflife
+// is disabled.
+type QueryEngagementHalflifeResponse struct {
+	// halflife is the duration after which the engagement points are halved
+	Halflife time.Duration `protobuf:"bytes,1,opt,name=halflife,proto3,stdduration" json:"halflife"`
+	// last_halflife is the block time when the halflife was last applied
+	LastHalflife time.Time `protobuf:"bytes,2,opt,name=last_halflife,json=lastHalflife,proto3,stdtime" json:"last_halflife"`
+	// next_halflife is the earliest block time when the halflife is applied
+	// next
+	NextHalflife time.Time `protobuf:"bytes,3,opt,name=next_halflife,json=nextHalflife,proto3,stdtime" json:"next_halflife"`
+}
+
+func (m *QueryEngagementHalflifeResponse) Reset()         { *m = QueryEngagementHalflifeResponse{} }
+func (m *QueryEngagementHalflifeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementHalflifeResponse) ProtoMessage()    {}
+func (*QueryEngagementHalflifeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{31}
+}
+
+func (m *QueryEngagementHalflifeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEngagementHalflifeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementHalflifeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEngagementHalflifeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementHalflifeResponse.Merge(m, src)
+}
+
+func (m *QueryEngagementHalflifeResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEngagementHalflifeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementHalflifeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEngagementHalflifeResponse proto.InternalMessageInfo
+
+func (m *QueryEngagementHalflifeResponse) GetHalflife() time.Duration {
+	if m != nil {
+		return m.Halflife
+	}
+	return 0
+}
+
+func (m *QueryEngagementHalflifeResponse) GetLastHalflife() time.Time {
+	if m != nil {
+		return m.LastHalflife
+	}
+	return time.Time{}
+}
+
+func (m *QueryEngagementHalflifeResponse) GetNextHalflife() time.Time {
+	if m != nil {
+		return m.NextHalflife
+	}
+	return time.Time{}
+}
+
+// QueryMixedPointsRequest is the request type for the Query/MixedPoints RPC
+// method.
+type QueryMixedPointsRequest struct {
+	// address is the bech32 address to query for
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMixedPointsRequest) Reset()         { *m = QueryMixedPointsRequest{} }
+func (m *QueryMixedPointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMixedPointsRequest) ProtoMessage()    {}
+func (*QueryMixedPointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{32}
+}
+
+func (m *QueryMixedPointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMixedPointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMixedPointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMixedPointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMixedPointsRequest.Merge(m, src)
+}
+
+func (m *QueryMixedPointsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMixedPointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMixedPointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMixedPointsRequest proto.InternalMessageInfo
+
+func (m *QueryMixedPointsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMixedPointsResponse is the response type for the Query/MixedPoints RPC
+// method.
+type QueryMixedPointsResponse struct {
+	// points are the mixed PoE points of the address
+	Points uint64 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *QueryMixedPointsResponse) Reset()         { *m = QueryMixedPointsResponse{} }
+func (m *QueryMixedPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMixedPointsResponse) ProtoMessage()    {}
+func (*QueryMixedPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{33}
+}
+
+func (m *QueryMixedPointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMixedPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMixedPointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMixedPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMixedPointsResponse.Merge(m, src)
+}
+
+func (m *QueryMixedPointsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMixedPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMixedPointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMixedPointsResponse proto.InternalMessageInfo
+
+func (m *QueryMixedPointsResponse) GetPoints() uint64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+// QueryMixerConfigRequest is the request type for the Query/MixerConfig RPC
+// method.
+type QueryMixerConfigRequest struct{}
+
+func (m *QueryMixerConfigRequest) Reset()         { *m = QueryMixerConfigRequest{} }
+func (m *QueryMixerConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMixerConfigRequest) ProtoMessage()    {}
+func (*QueryMixerConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{34}
+}
+
+func (m *QueryMixerConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMixerConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMixerConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMixerConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMixerConfigRequest.Merge(m, src)
+}
+
+func (m *QueryMixerConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMixerConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMixerConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMixerConfigRequest proto.InternalMessageInfo
+
+// QueryMixerConfigResponse is the response type for the Query/MixerConfig RPC
+// method.
+type QueryMixerConfigResponse struct {
+	// staking_contract_address is the address of the staking contract that is
+	// mixed as left group
+	StakingContractAddress string `protobuf:"bytes,1,opt,name=staking_contract_address,json=stakingContractAddress,proto3" json:"staking_contract_address,omitempty"`
+	// engagement_contract_address is the address of the engagement contract that
+	// is mixed as right group
+	EngagementContractAddress string `protobuf:"bytes,2,opt,name=engagement_contract_address,json=engagementContractAddress,proto3" json:"engagement_contract_address,omitempty"`
+	// total_points is the sum of the mixed points of all members
+	TotalPoints uint64 `protobuf:"varint,3,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	// function_type is the name of the function that mixes stake and engagement
+	// points, for example `sigmoid`
+	FunctionType string `protobuf:"bytes,4,opt,name=function_type,json=functionType,proto3" json:"function_type,omitempty"`
+	// sigmoid are the function params when the function type is `sigmoid`
+	Sigmoid *MixerContractConfig_Sigmoid `protobuf:"bytes,5,opt,name=sigmoid,proto3" json:"sigmoid,omitempty"`
+}
+
+func (m *QueryMixerConfigResponse) Reset()         { *m = QueryMixerConfigResponse{} }
+func (m *QueryMixerConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMixerConfigResponse) ProtoMessage()    {}
+func (*QueryMixerConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{35}
+}
+
+func (m *QueryMixerConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMixerConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMixerConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryMixerConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMixerConfigResponse.Merge(m, src)
+}
+
+func (m *QueryMixerConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMixerConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMixerConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMixerConfigResponse proto.InternalMessageInfo
+
+func (m *QueryMixerConfigResponse) GetStakingContractAddress() string {
+	if m != nil {
+		return m.StakingContractAddress
+	}
+	return ""
+}
+
+func (m *QueryMixerConfigResponse) GetEngagementContractAddress() string {
+	if m != nil {
+		return m.EngagementContractAddress
+	}
+	return ""
+}
+
+func (m *QueryMixerConfigResponse) GetTotalPoints() uint64 {
+	if m != nil {
+		return m.TotalPoints
+	}
+	return 0
+}
+
+func (m *QueryMixerConfigResponse) GetFunctionType() string {
+	if m != nil {
+		return m.FunctionType
+	}
+	return ""
+}
+
+func (m *QueryMixerConfigResponse) GetSigmoid() *MixerContractConfig_Sigmoid {
+	if m != nil {
+		return m.Sigmoid
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryContractAddressRequest)(nil), "confio.poe.v1beta1.QueryContractAddressRequest")
+	proto.RegisterType((*QueryContractAddressResponse)(nil), "confio.poe.v1beta1.QueryContractAddressResponse")
+	proto.RegisterType((*QueryUnbondingPeriodRequest)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodRequest")
+	proto.RegisterType((*QueryUnbondingPeriodResponse)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodResponse")
+	proto.RegisterType((*QueryValidatorDelegationRequest)(nil), "confio.poe.v1beta1.QueryValidatorDelegationRequest")
+	proto.RegisterType((*QueryValidatorDelegationResponse)(nil), "confio.poe.v1beta1.QueryValidatorDelegationResponse")
+	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryValidatorUnbondingDelegationsResponse)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardResponse")
+	proto.RegisterType((*QueryValidatorEngagementRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardRequest")
+	proto.RegisterType((*QueryValidatorEngagementRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "confio.poe.v1beta1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "confio.poe.v1beta1.QuerySigningInfoResponse")
+	proto.RegisterType((*QueryHistoricalInfosRequest)(nil), "confio.poe.v1beta1.QueryHistoricalInfosRequest")
+	proto.RegisterType((*QueryHistoricalInfosResponse)(nil), "confio.poe.v1beta1.QueryHistoricalInfosResponse")
+	proto.RegisterType((*QueryValsetEpochRequest)(nil), "confio.poe.v1beta1.QueryValsetEpochRequest")
+	proto.RegisterType((*QueryValsetEpochResponse)(nil), "confio.poe.v1beta1.QueryValsetEpochResponse")
+	proto.RegisterType((*QueryValsetConfigRequest)(nil), "confio.poe.v1beta1.QueryValsetConfigRequest")
+	proto.RegisterType((*QueryValsetConfigResponse)(nil), "confio.poe.v1beta1.QueryValsetConfigResponse")
+	proto.RegisterType((*RewardDistributionContract)(nil), "confio.poe.v1beta1.RewardDistributionContract")
+	proto.RegisterType((*QuerySimulateNextValidatorSetRequest)(nil), "confio.poe.v1beta1.QuerySimulateNextValidatorSetRequest")
+	proto.RegisterType((*QuerySimulateNextValidatorSetResponse)(nil), "confio.poe.v1beta1.QuerySimulateNextValidatorSetResponse")
+	proto.RegisterType((*ValidatorPower)(nil), "confio.poe.v1beta1.ValidatorPower")
+	proto.RegisterType((*QuerySlashingsRequest)(nil), "confio.poe.v1beta1.QuerySlashingsRequest")
+	proto.RegisterType((*QuerySlashingsResponse)(nil), "confio.poe.v1beta1.QuerySlashingsResponse")
+	proto.RegisterType((*QueryEngagementPointsRequest)(nil), "confio.poe.v1beta1.QueryEngagementPointsRequest")
+	proto.RegisterType((*QueryEngagementPointsResponse)(nil), "confio.poe.v1beta1.QueryEngagementPointsResponse")
+	proto.RegisterType((*QueryListEngagementMembersRequest)(nil), "confio.poe.v1beta1.QueryListEngagementMembersRequest")
+	proto.RegisterType((*QueryListEngagementMembersResponse)(nil), "confio.poe.v1beta1.QueryListEngagementMembersResponse")
+	proto.RegisterType((*QueryEngagementHalflifeRequest)(nil), "confio.poe.v1beta1.QueryEngagementHalflifeRequest")
+	proto.RegisterType((*QueryEngagementHalflifeResponse)(nil), "confio.poe.v1beta1.QueryEngagementHalflifeResponse")
+	proto.RegisterType((*QueryMixedPointsRequest)(nil), "confio.poe.v1beta1.QueryMixedPointsRequest")
+	proto.RegisterType((*QueryMixedPointsResponse)(nil), "confio.poe.v1beta1.QueryMixedPointsResponse")
+	proto.RegisterType((*QueryMixerConfigRequest)(nil), "confio.poe.v1beta1.QueryMixerConfigRequest")
+	proto.RegisterType((*QueryMixerConfigResponse)(nil), "confio.poe.v1beta1.QueryMixerConfigResponse")
+}
+
+func init() { proto.RegisterFile("confio/poe/v1beta1/query.proto", fileDescriptor_55a2242dcc0e0cfb) }
+
+var fileDescriptor_55a2242dcc0e0cfb = []byte{
+	// 2273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xa4, 0x49, 0xd3, 0x9c, 0xc4, 0x69, 0xb8, 0xb4, 0xc5, 0x99, 0x26, 0x76, 0x3a, 0x69,
+	0x3e, 0x9b, 0x7a, 0xd2, 0xa4, 0xd0, 0x36, 0xec, 0x16, 0x48, 0x93, 0x36, 0x95, 0xda, 0x12, 0xbc,
+	0xcd, 0x2e, 0x42, 0x42, 0xd6, 0xd8, 0xbe, 0x19, 0x0f, 0xb1, 0x67, 0xdc, 0x99, 0xf1, 0x36, 0x51,
+	0xc8, 0x0b, 0x4f, 0xf0, 0xb6, 0x5a, 0x04, 0x42, 0xc0, 0xc3, 0x42, 0x11, 0x48, 0x0b, 0x02, 0x9e,
+	0x10, 0x7f, 0x00, 0x12, 0xfb, 0xb8, 0x02, 0x21, 0x21, 0x1e, 0xba, 0xab, 0x76, 0x1f, 0x78, 0xd9,
+	0x17, 0x9e, 0x78, 0x44, 0xf7, 0xce, 0xb9, 0xe3, 0x19, 0x7b, 0xc6, 0x1e, 0x67, 0x2b, 0xf1, 0x64,
+	0xcf, 0xbd, 0xe7, 0xe3, 0x77, 0xce, 0x3d, 0xf7, 0x9c, 0x7b, 0x0e, 0x64, 0x4a, 0x96, 0xb9, 0x67,
+	0x58, 0x6a, 0xdd, 0xa2, 0xea, 0xdb, 0xd7, 0x8a, 0xd4, 0xd5, 0xae, 0xa9, 0x4f, 0x1a, 0xd4, 0x3e,
+	0xcc, 0xd5, 0x6d, 0xcb, 0xb5, 0x08, 0xf1, 0xf6, 0x73, 0x75, 0x8b, 0xe6, 0x70, 0x5f, 0x5e, 0x2a,
+	0x59, 0x4e, 0xcd, 0x72, 0xd4, 0xa2, 0xe6, 0x50, 0x8f, 0xd8, 0x67, 0xad, 0x6b, 0xba, 0x61, 0x6a,
+	0xae, 0x61, 0x99, 0x1e, 0xbf, 0x7c, 0x4e, 0xb7, 0x74, 0x8b, 0xff, 0x55, 0xd9, 0x3f, 0x5c, 0xcd,
+	0xe8, 0x96, 0xa5, 0x57, 0xa9, 0xca, 0xbf, 0x8a, 0x8d, 0x3d, 0xb5, 0xdc, 0xb0, 0x83, 0x5c, 0xd9,
+	0xd6, 0x7d, 0xd7, 0xa8, 0x51, 0xc7, 0xd5, 0x6a, 0x75, 0x24, 0x98, 0x44, 0x02, 0xad, 0x6e, 0xa8,
+	0x9a, 0x69, 0x5a, 0x2e, 0xe7, 0x76, 0x70, 0x77, 0x3a, 0xc2, 0x28, 0x9d, 0x9a, 0xd4, 0x31, 0x04,
+	0xc5, 0x64, 0x04, 0x05, 0x33, 0x11, 0xe1, 0x05, 0x0d, 0x14, 0xdb, 0x25, 0xcb, 0x10, 0xf0, 0x2e,
+	0xe3, 0xbe, 0xe3, 0x6a, 0xfb, 0x86, 0xa9, 0xfb, 0x24, 0xf8, 0x8d, 0x54, 0x4a, 0x0c, 0x55, 0xc0,
+	0xbd, 0xca, 0x13, 0xb8, 0xf8, 0x0d, 0xf6, 0x79, 0xc7, 0x32, 0x5d, 0x5b, 0x2b, 0xb9, 0x5f, 0x2b,
+	0x97, 0x6d, 0xea, 0x38, 0x79, 0xfa, 0xa4, 0x41, 0x1d, 0x97, 0x6c, 0x43, 0xaa, 0x84, 0x3b, 0x05,
+	0xf7, 0xb0, 0x4e, 0xd3, 0xd2, 0xb4, 0xb4, 0x30, 0xb6, 0x3a, 0x93, 0x6b, 0x3f, 0x95, 0xdc, 0x8e,
+	0xb5, 0x25, 0xa4, 0x3c, 0x3e, 0xac, 0xd3, 0xfc, 0x68, 0x29, 0xf0, 0xb5, 0x7e, 0xe6, 0xfb, 0xef,
+	0x65, 0xfb, 0xfe, 0xfd, 0x5e, 0xb6, 0x4f, 0xb9, 0x09, 0x93, 0xd1, 0x2a, 0x9d, 0xba, 0x65, 0x3a,
+	0x94, 0xa4, 0x61, 0x48, 0xf3, 0x96, 0xb8, 0xb6, 0xe1, 0xbc, 0xf8, 0x54, 0xa6, 0x10, 0xec, 0xae,
+	0x59, 0xb4, 0xcc, 0xb2, 0x61, 0xea, 0x3b, 0xd4, 0x36, 0xac, 0x32, 0x82, 0x55, 0xde, 0x82, 0xc9,
+	0xe8, 0x6d, 0x14, 0x7c, 0x03, 0x06, 0xd8, 0x31, 0x72, 0xa9, 0x23, 0xab, 0x13, 0x39, 0xef, 0x08,
+	0x73, 0xe2, 0x8c, 0x73, 0x9b, 0x18, 0x03, 0x1b, 0x67, 0x3e, 0x78, 0x9e, 0xed, 0xfb, 0xc9, 0x47,
+	0x59, 0x29, 0xcf, 0x19, 0x94, 0x6d, 0xc8, 0x72, 0xc1, 0x6f, 0x6a, 0x55, 0xa3, 0xac, 0xb9, 0x96,
+	0xbd, 0x49, 0xab, 0x54, 0xe7, 0xb4, 0xc2, 0x51, 0xb3, 0x30, 0xf6, 0xb6, 0xd8, 0x2d, 0x30, 0xbc,
+	0x88, 0x3d, 0xe5, 0xaf, 0x32, 0x33, 0x95, 0x6f, 0xc3, 0x74, 0xbc, 0x24, 0x84, 0x79, 0x0b, 0x86,
+	0x8a, 0x5a, 0x55, 0x33, 0x4b, 0x4d, 0xa4, 0xde, 0x41, 0xe6, 0x58, 0x38, 0xf8, 0xee, 0xbe, 0x63,
+	0x19, 0xe6, 0xc6, 0x00, 0x43, 0x9a, 0x17, 0xf4, 0xca, 0x4f, 0x25, 0x58, 0x0c, 0xcb, 0xf7, 0x7d,
+	0xd1, 0x54, 0xe4, 0xf4, 0x86, 0x99, 0xdc, 0x05, 0x68, 0xde, 0xaa, 0x74, 0x3f, 0x87, 0x34, 0x17,
+	0x82, 0xe4, 0x05, 0x94, 0x1f, 0x07, 0x9a, 0x4e, 0x51, 0x45, 0x3e, 0xc0, 0xa9, 0xfc, 0x55, 0x82,
+	0xa5, 0x24, 0xe0, 0xd0, 0x0d, 0x3b, 0x30, 0x44, 0x4d, 0xd7, 0x36, 0x28, 0x0b, 0x83, 0x53, 0x0b,
+	0x23, 0xab, 0x2b, 0x42, 0xa7, 0x88, 0x72, 0xa1, 0x30, 0x42, 0xcc, 0x96, 0xe9, 0xda, 0x87, 0xc2,
+	0x3b, 0x28, 0x86, 0xdc, 0x8b, 0x30, 0x64, 0xbe, 0xab, 0x21, 0x1e, 0x9c, 0x90, 0x25, 0xbb, 0x30,
+	0x17, 0x36, 0xe4, 0xeb, 0x0d, 0xd7, 0x71, 0x35, 0x8e, 0x21, 0x4f, 0x9f, 0x6a, 0xb6, 0x08, 0x49,
+	0x72, 0x05, 0x3e, 0x17, 0x76, 0x71, 0x33, 0xaa, 0xc7, 0x43, 0x5e, 0x66, 0xe1, 0xfd, 0x2b, 0x09,
+	0xe6, 0xbb, 0xca, 0x45, 0xef, 0x1c, 0xc2, 0x69, 0x9b, 0xaf, 0x60, 0x8c, 0x4c, 0x46, 0xc6, 0xc8,
+	0x26, 0x2d, 0xf1, 0x30, 0xb9, 0xc3, 0x1c, 0xf1, 0x9f, 0xe7, 0xd9, 0xd4, 0xa1, 0x56, 0xab, 0xae,
+	0x2b, 0x1e, 0xa7, 0xf2, 0xfe, 0x47, 0xd9, 0x25, 0xdd, 0x70, 0x2b, 0x8d, 0x62, 0xae, 0x64, 0xd5,
+	0x54, 0xcc, 0x16, 0xde, 0xcf, 0x55, 0xa7, 0xbc, 0xaf, 0xb2, 0x1b, 0xef, 0x08, 0x21, 0x79, 0x54,
+	0xa8, 0x3c, 0x86, 0xd9, 0x30, 0xca, 0x2d, 0x53, 0xd7, 0x74, 0x5a, 0xa3, 0xa6, 0xfb, 0x19, 0x8c,
+	0x7f, 0x26, 0xc1, 0x5c, 0x37, 0xb1, 0xff, 0x7f, 0xdb, 0x5f, 0x83, 0x2f, 0x70, 0x90, 0x6f, 0x18,
+	0xba, 0x69, 0x98, 0xfa, 0x7d, 0x73, 0xcf, 0x12, 0xd6, 0x5e, 0x02, 0x96, 0xf0, 0x9c, 0x16, 0x43,
+	0x47, 0xd8, 0x9a, 0xb0, 0xf1, 0xd7, 0x12, 0xa4, 0xdb, 0xd9, 0xd1, 0xaa, 0x6f, 0x02, 0x73, 0x4a,
+	0xc1, 0xf1, 0xb6, 0x0a, 0x86, 0xb9, 0x67, 0xa1, 0x7d, 0x0b, 0x51, 0xd9, 0xd6, 0x77, 0x53, 0x40,
+	0x16, 0x06, 0x3c, 0xbb, 0xd5, 0x81, 0x55, 0xb2, 0x02, 0xe7, 0x98, 0x54, 0x5a, 0x2e, 0x14, 0xab,
+	0x56, 0x69, 0xdf, 0x29, 0x3c, 0x35, 0xcc, 0xb2, 0xf5, 0x94, 0xdf, 0x80, 0x54, 0x9e, 0x78, 0x7b,
+	0x1b, 0x7c, 0xeb, 0x2d, 0xbe, 0xc3, 0x0e, 0xc3, 0xcb, 0xb4, 0xdb, 0x86, 0xe3, 0x5a, 0xb6, 0x51,
+	0xd2, 0xaa, 0x4c, 0x92, 0x9f, 0x39, 0xa6, 0x00, 0x6a, 0x86, 0x59, 0xa8, 0x50, 0x43, 0xaf, 0xb8,
+	0x1c, 0xe5, 0xa9, 0xfc, 0x70, 0xcd, 0x30, 0xb7, 0xf9, 0x02, 0xdf, 0xd6, 0x0e, 0xc4, 0x76, 0x3f,
+	0x6e, 0x6b, 0x07, 0xb8, 0x1d, 0x4e, 0x28, 0xa7, 0x4e, 0x9c, 0x50, 0x7e, 0x2b, 0xc1, 0x64, 0x34,
+	0x4a, 0x74, 0xe9, 0x06, 0x0c, 0x56, 0x0c, 0xc7, 0x15, 0x09, 0x64, 0x2e, 0x2e, 0x81, 0x84, 0xf9,
+	0xd1, 0x8b, 0x1e, 0xeb, 0xab, 0x4b, 0x1a, 0x13, 0x18, 0x3a, 0x6f, 0x6a, 0x55, 0x87, 0xba, 0x5b,
+	0x75, 0xab, 0x54, 0x11, 0x85, 0xeb, 0x1f, 0xfd, 0x90, 0x6e, 0xdf, 0x43, 0x23, 0xee, 0xc2, 0x28,
+	0x65, 0x0b, 0x85, 0x2a, 0x35, 0x75, 0xb7, 0xd2, 0x4b, 0xf5, 0x1a, 0xe1, 0x8c, 0x0f, 0x38, 0x1f,
+	0x99, 0x81, 0x54, 0xa9, 0x61, 0xdb, 0xd4, 0x74, 0x0b, 0x7c, 0x99, 0xdb, 0x32, 0x90, 0x1f, 0xc5,
+	0x45, 0xae, 0x94, 0x3c, 0x82, 0xf1, 0xaa, 0xe6, 0xb8, 0x85, 0x46, 0xbd, 0xac, 0xb9, 0xb4, 0xc0,
+	0xcb, 0xa5, 0x77, 0x40, 0x72, 0x9b, 0xc2, 0xc7, 0xe2, 0x49, 0xe4, 0x69, 0x7c, 0x87, 0x69, 0x1c,
+	0x63, 0xdc, 0xbb, 0x9c, 0x99, 0x6d, 0x93, 0x65, 0x20, 0x41, 0x79, 0x18, 0x11, 0x03, 0x5c, 0xf3,
+	0x78, 0x93, 0x16, 0x03, 0xe3, 0x11, 0x8c, 0x9b, 0xf4, 0x20, 0xac, 0x7d, 0xb0, 0x17, 0xed, 0x8c,
+	0xbb, 0xa9, 0x5d, 0x91, 0x43, 0x6e, 0xbd, 0xc3, 0x2e, 0x91, 0x2e, 0x7c, 0xfe, 0xe9, 0x29, 0x98,
+	0x88, 0xd8, 0x44, 0xa7, 0x67, 0x00, 0x6a, 0xb4, 0x56, 0xa4, 0xb6, 0x53, 0x31, 0xea, 0x78, 0x95,
+	0x03, 0x2b, 0xe2, 0x02, 0xd4, 0x2d, 0xc3, 0x74, 0x1d, 0xf4, 0x24, 0xbb, 0x00, 0x3b, 0x7c, 0x81,
+	0x55, 0x56, 0x76, 0x01, 0xfc, 0x24, 0xe7, 0x70, 0x27, 0xa6, 0xf2, 0xa9, 0x9a, 0x76, 0xe0, 0x5f,
+	0x5c, 0x87, 0xbd, 0x74, 0x9c, 0x92, 0x56, 0x35, 0x4c, 0x9d, 0xbb, 0x24, 0x95, 0x17, 0x9f, 0x64,
+	0x43, 0x1c, 0x3a, 0x26, 0xba, 0xc1, 0x64, 0x0f, 0x01, 0xef, 0xc0, 0xbd, 0x74, 0x49, 0x76, 0x61,
+	0x6c, 0x8f, 0xd2, 0x42, 0x9d, 0xda, 0x25, 0x6a, 0xba, 0x9a, 0x4e, 0xd3, 0xa7, 0x99, 0x1d, 0x1b,
+	0x39, 0x46, 0xfa, 0xaf, 0xe7, 0xd9, 0xb9, 0x64, 0xf9, 0x2f, 0x9f, 0xda, 0xa3, 0x74, 0xc7, 0x17,
+	0x42, 0xf6, 0xe1, 0x42, 0xd9, 0x70, 0x5c, 0xdb, 0x28, 0x36, 0x58, 0xb8, 0x15, 0xc4, 0x2b, 0xcf,
+	0x49, 0x0f, 0xf1, 0x5b, 0x96, 0x8b, 0xca, 0x56, 0x1e, 0xa4, 0xcd, 0x00, 0x9f, 0x78, 0xfd, 0x21,
+	0xf2, 0xf3, 0xe5, 0x88, 0x3d, 0x87, 0xcc, 0xc3, 0xd9, 0x66, 0x09, 0xd1, 0x6d, 0xab, 0x51, 0x4f,
+	0x9f, 0xe1, 0x87, 0xd1, 0x7c, 0xb9, 0xdc, 0x63, 0xab, 0x24, 0x0b, 0x23, 0x5a, 0xc3, 0xb5, 0x0a,
+	0x0d, 0xf3, 0x3b, 0x9a, 0x51, 0x4d, 0x0f, 0x4f, 0x4b, 0x0b, 0x67, 0xf2, 0xc0, 0x96, 0x76, 0xf9,
+	0x8a, 0xf2, 0x5d, 0x90, 0xe3, 0x41, 0xc4, 0xbf, 0x39, 0xc9, 0x26, 0x0c, 0xf2, 0x7b, 0x95, 0xee,
+	0x3f, 0x91, 0xf3, 0x3c, 0x66, 0x65, 0x0e, 0x2e, 0x63, 0xe2, 0xaf, 0x35, 0xaa, 0x9a, 0x4b, 0x1f,
+	0xd1, 0x03, 0xb7, 0x99, 0xc1, 0xa9, 0x2b, 0xa2, 0xf2, 0x09, 0xcc, 0x76, 0xa1, 0xc3, 0x00, 0xdd,
+	0x06, 0x08, 0x44, 0x97, 0x97, 0xdf, 0x94, 0x8e, 0x75, 0x62, 0xc7, 0x7a, 0x4a, 0x6d, 0xf4, 0x76,
+	0x80, 0x57, 0x71, 0x61, 0x2c, 0x4c, 0x43, 0x16, 0x61, 0xdc, 0xaa, 0x53, 0x3b, 0xa2, 0x6c, 0x9f,
+	0x15, 0xeb, 0x58, 0xd1, 0xda, 0x8a, 0x5e, 0x7f, 0x5b, 0xd1, 0x23, 0xe7, 0x60, 0xb0, 0xce, 0xc4,
+	0xf2, 0x2b, 0x30, 0x90, 0xf7, 0x3e, 0x94, 0x02, 0x9c, 0xf7, 0x0c, 0xad, 0x6a, 0x4e, 0xc5, 0x30,
+	0x75, 0xbf, 0xb4, 0x84, 0x8b, 0x83, 0x74, 0xe2, 0xe2, 0xf0, 0x4c, 0x82, 0x0b, 0xad, 0x1a, 0xd0,
+	0x77, 0x5f, 0x85, 0x61, 0x47, 0x2c, 0xa2, 0xeb, 0x26, 0xa3, 0x5c, 0x27, 0x38, 0xd1, 0x69, 0x4d,
+	0xa6, 0x57, 0x57, 0x14, 0x44, 0x2f, 0xd4, 0x7c, 0xeb, 0x78, 0x19, 0x44, 0x78, 0x23, 0xbe, 0x17,
+	0xba, 0x01, 0x53, 0x31, 0x9c, 0x68, 0xe5, 0x05, 0x38, 0x8d, 0xe9, 0x49, 0xe2, 0x8e, 0xc7, 0x2f,
+	0x65, 0x1f, 0x2e, 0x71, 0xc6, 0x07, 0x86, 0xe3, 0x36, 0x99, 0x1f, 0x7a, 0xb9, 0xed, 0x55, 0x9f,
+	0xc2, 0xef, 0x24, 0x50, 0x3a, 0x69, 0x43, 0xac, 0xaf, 0xc3, 0x10, 0x26, 0x57, 0x3c, 0x8f, 0xa9,
+	0xa8, 0xf3, 0x78, 0x7c, 0xef, 0xba, 0xc7, 0x28, 0x1e, 0xf6, 0xc8, 0xf3, 0xea, 0x8e, 0x63, 0x1a,
+	0x32, 0x2d, 0x4e, 0xdd, 0xd6, 0xaa, 0x7b, 0x55, 0x63, 0x4f, 0x18, 0xa7, 0xfc, 0x57, 0x82, 0x6c,
+	0x2c, 0x09, 0x5a, 0xf3, 0x15, 0x38, 0x53, 0xc1, 0xb5, 0x5e, 0xaa, 0xb5, 0xcf, 0x44, 0xee, 0x43,
+	0x8a, 0x57, 0x4d, 0x5f, 0x4a, 0x7f, 0x0f, 0x45, 0x70, 0x94, 0xb1, 0x6e, 0x07, 0x44, 0xf1, 0x92,
+	0xea, 0x8b, 0xea, 0xa5, 0x9a, 0x8f, 0x32, 0x56, 0x21, 0x4a, 0x59, 0xc3, 0x07, 0xcc, 0x43, 0xe3,
+	0x80, 0x96, 0x93, 0x86, 0xe9, 0x2a, 0xa4, 0xdb, 0x99, 0xba, 0x44, 0xe8, 0x44, 0x40, 0x91, 0x1d,
+	0xae, 0xda, 0xbf, 0xe9, 0x87, 0x74, 0xfb, 0x1e, 0xca, 0xbb, 0x09, 0x69, 0x7c, 0xd9, 0xf9, 0x45,
+	0xa9, 0x25, 0x7f, 0x5d, 0xc0, 0xfd, 0x96, 0xd1, 0x03, 0xb9, 0x0d, 0x17, 0xa9, 0x7f, 0x9e, 0xed,
+	0xcc, 0x5e, 0x56, 0x9b, 0x68, 0x92, 0xb4, 0xf2, 0x5f, 0x82, 0x51, 0xd7, 0x72, 0xb5, 0xaa, 0x78,
+	0x10, 0x78, 0xa9, 0x6e, 0x84, 0xaf, 0xe1, 0x93, 0x60, 0x06, 0x52, 0x7b, 0x0d, 0xb3, 0xc4, 0x4b,
+	0x26, 0x9f, 0xa4, 0x0c, 0x70, 0xa1, 0xa3, 0x62, 0x91, 0x0d, 0x49, 0xc8, 0x7d, 0x18, 0x72, 0x0c,
+	0xbd, 0x66, 0x19, 0xa2, 0xe2, 0xab, 0x51, 0xf7, 0x40, 0xd8, 0xce, 0x21, 0x78, 0x3e, 0xc8, 0xbd,
+	0xe1, 0xb1, 0xe5, 0x05, 0xff, 0xea, 0xdf, 0x2e, 0xc2, 0x20, 0xf7, 0x14, 0x79, 0x5f, 0x82, 0xb3,
+	0xad, 0x80, 0x23, 0xe5, 0x76, 0x18, 0x04, 0xc9, 0x2b, 0xc9, 0x19, 0xbc, 0xd3, 0x50, 0xae, 0x7f,
+	0xef, 0xef, 0x9f, 0xfc, 0xb0, 0x3f, 0x47, 0x96, 0x55, 0x57, 0xb7, 0xb5, 0x32, 0x0d, 0x8d, 0xba,
+	0x84, 0x8b, 0xd5, 0xa3, 0xd0, 0x78, 0xe9, 0x98, 0xfc, 0x48, 0x02, 0x08, 0xbc, 0x90, 0x72, 0x71,
+	0x4f, 0xf6, 0x70, 0xab, 0xe8, 0xc3, 0x54, 0x13, 0xd3, 0x23, 0xca, 0x39, 0x8e, 0x72, 0x9a, 0x64,
+	0xa2, 0x50, 0x36, 0xab, 0x24, 0x79, 0x26, 0xc1, 0xb0, 0xcf, 0x4e, 0xae, 0x26, 0x53, 0x23, 0x50,
+	0xe5, 0x92, 0x92, 0x23, 0xa8, 0x1b, 0x1c, 0xd4, 0x35, 0xa2, 0x76, 0x06, 0xa5, 0x1e, 0x85, 0xdb,
+	0xeb, 0x63, 0xf2, 0x33, 0x09, 0xce, 0xb6, 0x4c, 0xbf, 0x3a, 0x1c, 0x75, 0xf4, 0x18, 0x4d, 0x5e,
+	0x49, 0xce, 0x80, 0x78, 0x67, 0x39, 0xde, 0x2c, 0x99, 0x8a, 0xc2, 0xdb, 0x10, 0x4c, 0xe4, 0x2f,
+	0x12, 0x7c, 0x3e, 0x62, 0xf0, 0x45, 0xd6, 0x62, 0x15, 0xc6, 0x0f, 0xdc, 0xe4, 0xeb, 0xbd, 0x31,
+	0x21, 0xd2, 0x0d, 0x8e, 0xf4, 0x35, 0xb2, 0xce, 0x21, 0x22, 0xda, 0x04, 0x9e, 0x55, 0xcb, 0x4d,
+	0xb8, 0x9f, 0x4a, 0x30, 0xd5, 0x71, 0x84, 0x45, 0x5e, 0xef, 0x8e, 0xad, 0xc3, 0x5c, 0x4e, 0xbe,
+	0x7d, 0x52, 0x76, 0x34, 0xf2, 0x21, 0x37, 0xf2, 0x1e, 0xd9, 0xea, 0x31, 0x7c, 0x9a, 0x47, 0x55,
+	0x28, 0x07, 0xac, 0xf9, 0x83, 0x04, 0x63, 0xe1, 0x0e, 0x99, 0xac, 0x76, 0x0c, 0xe8, 0x30, 0xb1,
+	0xb0, 0x6a, 0xad, 0x27, 0x9e, 0x24, 0x49, 0xa4, 0xe2, 0xf3, 0xf0, 0x69, 0x89, 0x7a, 0xe4, 0xb5,
+	0x97, 0xc7, 0xe4, 0x13, 0x09, 0xe4, 0xf8, 0x19, 0x1a, 0x59, 0xef, 0xee, 0xdf, 0xb8, 0x81, 0x9e,
+	0xfc, 0xe5, 0x13, 0xf1, 0x7e, 0xb6, 0x83, 0xa1, 0x8e, 0x73, 0xac, 0x5a, 0x4d, 0xa9, 0xd8, 0x14,
+	0x92, 0x8f, 0x25, 0x98, 0x88, 0x9d, 0x96, 0x91, 0x5b, 0xdd, 0x91, 0xc6, 0x0c, 0xee, 0xe4, 0xf5,
+	0x93, 0xb0, 0xa2, 0x8d, 0x0f, 0xb8, 0x8d, 0x77, 0xc9, 0xe6, 0x09, 0x6c, 0x0c, 0xd4, 0x60, 0x34,
+	0xf1, 0x97, 0x12, 0x8c, 0x04, 0x47, 0x59, 0x57, 0x62, 0x91, 0xb5, 0x4f, 0xe4, 0xe4, 0xe5, 0x64,
+	0xc4, 0x08, 0xfc, 0x26, 0x07, 0xbe, 0x4a, 0x56, 0xa2, 0x80, 0x07, 0xa7, 0x72, 0x8e, 0x7a, 0x14,
+	0xec, 0x79, 0x8e, 0xc9, 0x2f, 0x24, 0x38, 0xdb, 0x32, 0x82, 0xea, 0x90, 0x75, 0xa3, 0x47, 0x6a,
+	0xf2, 0x4a, 0x72, 0x06, 0x04, 0x7c, 0x85, 0x03, 0x9e, 0x25, 0x33, 0x09, 0xee, 0x06, 0x79, 0x57,
+	0x82, 0x91, 0xc0, 0x74, 0xa9, 0x83, 0x23, 0xdb, 0xe7, 0x53, 0xf2, 0x72, 0x32, 0x62, 0xc4, 0xb5,
+	0xc0, 0x71, 0x29, 0x64, 0x3a, 0x26, 0x02, 0x1c, 0xea, 0xaa, 0x7c, 0x4e, 0x41, 0x7e, 0x2c, 0xc1,
+	0x68, 0x70, 0xfc, 0x42, 0xba, 0x29, 0x0a, 0x3d, 0x06, 0xe5, 0xab, 0x09, 0xa9, 0x11, 0xd7, 0x22,
+	0xc7, 0x35, 0x43, 0x2e, 0x75, 0xc0, 0x55, 0xf2, 0x70, 0xfc, 0x59, 0x82, 0x74, 0x5c, 0x0b, 0x4e,
+	0x6e, 0x76, 0x08, 0xab, 0x8e, 0xdd, 0xbd, 0x7c, 0xeb, 0x04, 0x9c, 0x08, 0x7e, 0x9e, 0x83, 0xbf,
+	0x44, 0xb2, 0x1d, 0xc0, 0xb3, 0xd7, 0x3a, 0xf9, 0x81, 0x04, 0xc3, 0x7e, 0xcb, 0x4b, 0x16, 0xe3,
+	0x35, 0xb6, 0x34, 0xde, 0xf2, 0x52, 0x12, 0xd2, 0x24, 0x05, 0xbf, 0xd9, 0x26, 0xff, 0x51, 0x82,
+	0xf1, 0xd6, 0xfe, 0x94, 0xc4, 0x07, 0x7a, 0x4c, 0x13, 0x2c, 0x5f, 0xeb, 0x81, 0x23, 0xc9, 0x0b,
+	0xaa, 0x99, 0x66, 0x54, 0xef, 0xbd, 0xae, 0x1e, 0xf9, 0x77, 0xf9, 0x4f, 0x12, 0x9c, 0x8f, 0xec,
+	0x55, 0xc9, 0x17, 0x63, 0x51, 0x74, 0xea, 0xa4, 0xe5, 0x2f, 0xf5, 0xca, 0x86, 0x16, 0xe4, 0xb8,
+	0x05, 0x0b, 0x64, 0xae, 0x8b, 0x05, 0xa2, 0x07, 0xfe, 0xbd, 0x04, 0xa4, 0xbd, 0x27, 0x25, 0xab,
+	0xb1, 0xea, 0x63, 0x7b, 0x5c, 0x79, 0xad, 0x27, 0x1e, 0xc4, 0xab, 0x72, 0xbc, 0x8b, 0x64, 0xbe,
+	0x0b, 0x5e, 0xbf, 0xc9, 0xfd, 0xb9, 0x04, 0x23, 0x81, 0xae, 0xb0, 0x43, 0x46, 0x6a, 0x6f, 0x38,
+	0xe5, 0xe5, 0x64, 0xc4, 0x88, 0x6d, 0x95, 0x63, 0x5b, 0x26, 0x4b, 0x51, 0xd8, 0x6a, 0xac, 0x9b,
+	0x6a, 0x0f, 0x84, 0x77, 0x11, 0x1e, 0x36, 0x99, 0x5d, 0xe0, 0x85, 0xdb, 0x54, 0x79, 0x39, 0x19,
+	0x71, 0x92, 0x84, 0xe9, 0xc1, 0xf3, 0xf2, 0xd2, 0xc6, 0xed, 0x0f, 0x5e, 0x64, 0xa4, 0x0f, 0x5f,
+	0x64, 0xa4, 0x8f, 0x5f, 0x64, 0xa4, 0x77, 0x5e, 0x66, 0xfa, 0x3e, 0x7c, 0x99, 0xe9, 0xfb, 0xe7,
+	0xcb, 0x4c, 0xdf, 0xb7, 0x2e, 0x87, 0xe6, 0x91, 0x4c, 0xb7, 0x10, 0x76, 0xc0, 0xc5, 0xf1, 0x89,
+	0x64, 0xf1, 0x34, 0x6f, 0xf7, 0xd7, 0xfe, 0x37, 0x00, 0x53, 0x8c, 0x28, 0xa5, 0x75, 0x21, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ContractAddress queries the address for one of the PoE contracts
+	ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
+	// Validators queries all validators that match the given status.
+	Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error)
+	// Validator queries validator info for given validator address.
+	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
+	// ValidatorDelegation queries self delegated amount for given validator.
+	ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error)
+	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
+	ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error)
+	// ValidatorEngagementReward queries rewards of a validator address.
+	ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error)
+	// SigningInfo queries the liveness data of a validator within the signed
+	// blocks window.
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// HistoricalInfos queries the stored historical infos within a range of
+	// heights.
+	HistoricalInfos(ctx context.Context, in *QueryHistoricalInfosRequest, opts ...grpc.CallOption) (*QueryHistoricalInfosResponse, error)
+	// ValsetEpoch queries the current epoch of the valset contract
+	ValsetEpoch(ctx context.Context, in *QueryValsetEpochRequest, opts ...grpc.CallOption) (*QueryValsetEpochResponse, error)
+	// ValsetConfig queries the configuration of the valset contract
+	ValsetConfig(ctx context.Context, in *QueryValsetConfigRequest, opts ...grpc.CallOption) (*QueryValsetConfigResponse, error)
+	// SimulateNextValidatorSet queries the active validator set that would be
+	// applied with the next epoch based on the current state
+	SimulateNextValidatorSet(ctx context.Context, in *QuerySimulateNextValidatorSetRequest, opts ...grpc.CallOption) (*QuerySimulateNextValidatorSetResponse, error)
+	// Slashings queries the recorded slashings of all validators ordered by
+	// height
+	Slashings(ctx context.Context, in *QuerySlashingsRequest, opts ...grpc.CallOption) (*QuerySlashingsResponse, error)
+	// EngagementPoints queries the engagement points of an address
+	EngagementPoints(ctx context.Context, in *QueryEngagementPointsRequest, opts ...grpc.CallOption) (*QueryEngagementPointsResponse, error)
+	// ListEngagementMembers queries the members of the engagement contract
+	ListEngagementMembers(ctx context.Context, in *QueryListEngagementMembersRequest, opts ...grpc.CallOption) (*QueryListEngagementMembersResponse, error)
+	// EngagementHalflife queries the halflife settings of the engagement
+	// contract
+	EngagementHalflife(ctx context.Context, in *QueryEngagementHalflifeRequest, opts ...grpc.CallOption) (*QueryEngagementHalflifeResponse, error)
+	// MixedPoints queries the mixed PoE points of an address that decide the
+	// validator power
+	MixedPoints(ctx context.Context, in *QueryMixedPointsRequest, opts ...grpc.CallOption) (*QueryMixedPointsResponse, error)
+	// MixerConfig queries the configuration of the mixer contract
+	MixerConfig(ctx context.Context, in *QueryMixerConfigRequest, opts ...grpc.CallOption) (*QueryMixerConfigResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
+	out := new(QueryContractAddressResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ContractAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error) {
+	out := new(types1.QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error) {
+	out := new(types1.QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
+	out := new(QueryUnbondingPeriodResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/UnbondingPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error) {
+	out := new(QueryValidatorDelegationResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error) {
+	out := new(QueryValidatorUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error) {
+	out := new(types1.QueryHistoricalInfoResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/HistoricalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error) {
+	out := new(QueryValidatorOutstandingRewardResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorOutstandingReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error) {
+	out := new(QueryValidatorEngagementRewardResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorEngagementReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalInfos(ctx context.Context, in *QueryHistoricalInfosRequest, opts ...grpc.CallOption) (*QueryHistoricalInfosResponse, error) {
+	out := new(QueryHistoricalInfosResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/HistoricalInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValsetEpoch(ctx context.Context, in *QueryValsetEpochRequest, opts ...grpc.CallOption) (*QueryValsetEpochResponse, error) {
+	out := new(QueryValsetEpochResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValsetEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValsetConfig(ctx context.Context, in *QueryValsetConfigRequest, opts ...grpc.CallOption) (*QueryValsetConfigResponse, error) {
+	out := new(QueryValsetConfigResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValsetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateNextValidatorSet(ctx context.Context, in *QuerySimulateNextValidatorSetRequest, opts ...grpc.CallOption) (*QuerySimulateNextValidatorSetResponse, error) {
+	out := new(QuerySimulateNextValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/SimulateNextValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Slashings(ctx context.Context, in *QuerySlashingsRequest, opts ...grpc.CallOption) (*QuerySlashingsResponse, error) {
+	out := new(QuerySlashingsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Slashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EngagementPoints(ctx context.Context, in *QueryEngagementPointsRequest, opts ...grpc.CallOption) (*QueryEngagementPointsResponse, error) {
+	out := new(QueryEngagementPointsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/EngagementPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListEngagementMembers(ctx context.Context, in *QueryListEngagementMembersRequest, opts ...grpc.CallOption) (*QueryListEngagementMembersResponse, error) {
+	out := new(QueryListEngagementMembersResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ListEngagementMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EngagementHalflife(ctx context.Context, in *QueryEngagementHalflifeRequest, opts ...grpc.CallOption) (*QueryEngagementHalflifeResponse, error) {
+	out := new(QueryEngagementHalflifeResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/EngagementHalflife", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MixedPoints(ctx context.Context, in *QueryMixedPointsRequest, opts ...grpc.CallOption) (*QueryMixedPointsResponse, error) {
+	out := new(QueryMixedPointsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/MixedPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MixerConfig(ctx context.Context, in *QueryMixerConfigRequest, opts ...grpc.CallOption) (*QueryMixerConfigResponse, error) {
+	out := new(QueryMixerConfigResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/MixerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ContractAddress queries the address for one of the PoE contracts
+	ContractAddress(context.Context, *QueryContractAddressRequest) (*QueryContractAddressResponse, error)
+	// Validators queries all validators that match the given status.
+	Validators(context.Context, *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(context.Context, *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error)
+	// Validator queries validator info for given validator address.
+	UnbondingPeriod(context.Context, *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error)
+	// ValidatorDelegation queries self delegated amount for given validator.
+	ValidatorDelegation(context.Context, *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error)
+	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
+	ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(context.Context, *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingReward(context.Context, *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error)
+	// ValidatorEngagementReward queries rewards of a validator address.
+	ValidatorEngagementReward(context.Context, *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error)
+	// SigningInfo queries the liveness data of a validator within the signed
+	// blocks window.
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// HistoricalInfos queries the stored historical infos within a range of
+	// heights.
+	HistoricalInfos(context.Context, *QueryHistoricalInfosRequest) (*QueryHistoricalInfosResponse, error)
+	// ValsetEpoch queries the current epoch of the valset contract
+	ValsetEpoch(context.Context, *QueryValsetEpochRequest) (*QueryValsetEpochResponse, error)
+	// ValsetConfig queries the configuration of the valset contract
+	ValsetConfig(context.Context, *QueryValsetConfigRequest) (*QueryValsetConfigResponse, error)
+	// SimulateNextValidatorSet queries the active validator set that would be
+	// applied with the next epoch based on the current state
+	SimulateNextValidatorSet(context.Context, *QuerySimulateNextValidatorSetRequest) (*QuerySimulateNextValidatorSetResponse, error)
+	// Slashings queries the recorded slashings of all validators ordered by
+	// height
+	Slashings(context.Context, *QuerySlashingsRequest) (*QuerySlashingsResponse, error)
+	// EngagementPoints queries the engagement points of an address
+	EngagementPoints(context.Context, *QueryEngagementPointsRequest) (*QueryEngagementPointsResponse, error)
+	// ListEngagementMembers queries the members of the engagement contract
+	ListEngagementMembers(context.Context, *QueryListEngagementMembersRequest) (*QueryListEngagementMembersResponse, error)
+	// EngagementHalflife queries the halflife settings of the engagement
+	// contract
+	EngagementHalflife(context.Context, *QueryEngagementHalflifeRequest) (*QueryEngagementHalflifeResponse, error)
+	// MixedPoints queries the mixed PoE points of an address that decide the
+	// validator power
+	MixedPoints(context.Context, *QueryMixedPointsRequest) (*QueryMixedPointsResponse, error)
+	// MixerConfig queries the configuration of the mixer contract
+	MixerConfig(context.Context, *QueryMixerConfigRequest) (*QueryMixerConfigResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) ContractAddress(ctx context.Context, req *QueryContractAddressRequest) (*QueryContractAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAddress not implemented")
+}
+
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+
+func (*UnimplementedQueryServer) UnbondingPeriod(ctx context.Context, req *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorDelegation(ctx context.Context, req *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegation not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorUnbondingDelegations(ctx context.Context, req *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
+}
+
+func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorOutstandingReward(ctx context.Context, req *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingReward not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorEngagementReward(ctx context.Context, req *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEngagementReward not implemented")
+}
+
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+
+func (*UnimplementedQueryServer) HistoricalInfos(ctx context.Context, req *QueryHistoricalInfosRequest) (*QueryHistoricalInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfos not implemented")
+}
+
+func (*UnimplementedQueryServer) ValsetEpoch(ctx context.Context, req *QueryValsetEpochRequest) (*QueryValsetEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetEpoch not implemented")
+}
+
+func (*UnimplementedQueryServer) ValsetConfig(ctx context.Context, req *QueryValsetConfigRequest) (*QueryValsetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfig not implemented")
+}
+
+func (*UnimplementedQueryServer) SimulateNextValidatorSet(ctx context.Context, req *QuerySimulateNextValidatorSetRequest) (*QuerySimulateNextValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateNextValidatorSet not implemented")
+}
+
+func (*UnimplementedQueryServer) Slashings(ctx context.Context, req *QuerySlashingsRequest) (*QuerySlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Slashings not implemented")
+}
+
+func (*UnimplementedQueryServer) EngagementPoints(ctx context.Context, req *QueryEngagementPointsRequest) (*QueryEngagementPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngagementPoints not implemented")
+}
+
+func (*UnimplementedQueryServer) ListEngagementMembers(ctx context.Context, req *QueryListEngagementMembersRequest) (*QueryListEngagementMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEngagementMembers not implemented")
+}
+
+func (*UnimplementedQueryServer) EngagementHalflife(ctx context.Context, req *QueryEngagementHalflifeRequest) (*QueryEngagementHalflifeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngagementHalflife not implemented")
+}
+
+func (*UnimplementedQueryServer) MixedPoints(ctx context.Context, req *QueryMixedPointsRequest) (*QueryMixedPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MixedPoints not implemented")
+}
+
+func (*UnimplementedQueryServer) MixerConfig(ctx context.Context, req *QueryMixerConfigRequest) (*QueryMixerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MixerConfig not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ContractAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAddress(ctx, req.(*QueryContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*types1.QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*types1.QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/UnbondingPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDelegation(ctx, req.(*QueryValidatorDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, req.(*QueryValidatorUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryHistoricalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/HistoricalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfo(ctx, req.(*types1.QueryHistoricalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOutstandingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOutstandingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOutstandingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorOutstandingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOutstandingReward(ctx, req.(*QueryValidatorOutstandingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorEngagementReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorEngagementRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorEngagementReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorEngagementReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorEngagementReward(ctx, req.(*QueryValidatorEngagementRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/HistoricalInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfos(ctx, req.(*QueryHistoricalInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValsetEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValsetEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetEpoch(ctx, req.(*QueryValsetEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValsetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValsetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetConfig(ctx, req.(*QueryValsetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateNextValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateNextValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateNextValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/SimulateNextValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateNextValidatorSet(ctx, req.(*QuerySimulateNextValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Slashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Slashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/Slashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Slashings(ctx, req.(*QuerySlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EngagementPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEngagementPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EngagementPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/EngagementPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EngagementPoints(ctx, req.(*QueryEngagementPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEngagementMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEngagementMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEngagementMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ListEngagementMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEngagementMembers(ctx, req.(*QueryListEngagementMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EngagementHalflife_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEngagementHalflifeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EngagementHalflife(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/EngagementHalflife",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EngagementHalflife(ctx, req.(*QueryEngagementHalflifeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MixedPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMixedPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MixedPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/MixedPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MixedPoints(ctx, req.(*QueryMixedPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MixerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMixerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MixerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/MixerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MixerConfig(ctx, req.(*QueryMixerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.poe.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractAddress",
+			Handler:    _Query_ContractAddress_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "UnbondingPeriod",
+			Handler:    _Query_UnbondingPeriod_Handler,
+		},
+		{
+			MethodName: "ValidatorDelegation",
+			Handler:    _Query_ValidatorDelegation_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbondingDelegations",
+			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "HistoricalInfo",
+			Handler:    _Query_HistoricalInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingReward",
+			Handler:    _Query_ValidatorOutstandingReward_Handler,
+		},
+		{
+			MethodName: "ValidatorEngagementReward",
+			Handler:    _Query_ValidatorEngagementReward_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "HistoricalInfos",
+			Handler:    _Query_HistoricalInfos_Handler,
+		},
+		{
+			MethodName: "ValsetEpoch",
+			Handler:    _Query_ValsetEpoch_Handler,
+		},
+		{
+			MethodName: "ValsetConfig",
+			Handler:    _Query_ValsetConfig_Handler,
+		},
+		{
+			MethodName: "SimulateNextValidatorSet",
+			Handler:    _Query_SimulateNextValidatorSet_Handler,
+		},
+		{
+			MethodName: "Slashings",
+			Handler:    _Query_Slashings_Handler,
+		},
+		{
+			MethodName: "EngagementPoints",
+			Handler:    _Query_EngagementPoints_Handler,
+		},
+		{
+			MethodName: "ListEngagementMembers",
+			Handler:    _Query_ListEngagementMembers_Handler,
+		},
+		{
+			MethodName: "EngagementHalflife",
+			Handler:    _Query_EngagementHalflife_Handler,
+		},
+		{
+			MethodName: "MixedPoints",
+			Handler:    _Query_MixedPoints_Handler,
+		},
+		{
+			MethodName: "MixerConfig",
+			Handler:    _Query_MixerConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/poe/v1beta1/query.proto",
+}
+
+func (m *QueryContractAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorEngagementRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorEngagementRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ValSigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hists) > 0 {
+		for iNdEx := len(m.Hists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextUpdateTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochLength, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochLength):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoUnjail {
+		i--
+		if m.AutoUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorGroup) > 0 {
+		i -= len(m.ValidatorGroup)
+		copy(dAtA[i:], m.ValidatorGroup)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorGroup)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DistributionContracts) > 0 {
+		for iNdEx := len(m.DistributionContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.EpochReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Scaling != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Scaling))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Membership) > 0 {
+		i -= len(m.Membership)
+		copy(dAtA[i:], m.Membership)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Membership)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardDistributionContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardDistributionContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDistributionContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementPointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementPointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementPointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementPointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementPointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementPointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEngagementMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEngagementMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEngagementMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEngagementMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEngagementMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEngagementMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementHalflifeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementHalflifeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementHalflifeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementHalflifeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementHalflifeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementHalflifeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextHalflife, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextHalflife):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHalflife, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHalflife):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Halflife, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Halflife):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMixedPointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMixedPointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMixedPointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMixedPointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMixedPointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMixedPointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMixerConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMixerConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMixerConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMixerConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMixerConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMixerConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sigmoid != nil {
+		{
+			size, err := m.Sigmoid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FunctionType) > 0 {
+		i -= len(m.FunctionType)
+		copy(dAtA[i:], m.FunctionType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EngagementContractAddress) > 0 {
+		i -= len(m.EngagementContractAddress)
+		copy(dAtA[i:], m.EngagementContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EngagementContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingContractAddress) > 0 {
+		i -= len(m.StakingContractAddress)
+		copy(dAtA[i:], m.StakingContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryContractAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractType != 0 {
+		n += 1 + sovQuery(uint64(m.ContractType))
+	}
+	return n
+}
+
+func (m *QueryContractAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnbondingPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryValidatorEngagementRewardResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Reward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	return n
+}
+
+func (m *QueryHistoricalInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hists) > 0 {
+		for _, e := range m.Hists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValsetEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochLength)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdateHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextUpdateTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValsetConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValsetConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Membership)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinPoints != 0 {
+		n += 1 + sovQuery(uint64(m.MinPoints))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovQuery(uint64(m.MaxValidators))
+	}
+	if m.Scaling != 0 {
+		n += 1 + sovQuery(uint64(m.Scaling))
+	}
+	l = m.EpochReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DistributionContracts) > 0 {
+		for _, e := range m.DistributionContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ValidatorGroup)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AutoUnjail {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardDistributionContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QuerySlashingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEngagementPointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEngagementPointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func (m *QueryListEngagementMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEngagementMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEngagementHalflifeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEngagementHalflifeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Halflife)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHalflife)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextHalflife)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMixedPointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMixedPointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func (m *QueryMixerConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMixerConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EngagementContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalPoints != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPoints))
+	}
+	l = len(m.FunctionType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sigmoid != nil {
+		l = m.Sigmoid.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= PoEContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, types1.UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryHistoricalInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryHistoricalInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hists = append(m.Hists, types1.HistoricalInfo{})
+			if err := m.Hists[len(m.Hists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValsetEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValsetEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochLength, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValsetConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValsetConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Membership", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Membership = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoints", wireType)
+			}
+			m.MinPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scaling", wireType)
+			}
+			m.Scaling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scaling |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionContracts = append(m.DistributionContracts, RewardDistributionContract{})
+			if err := m.DistributionContracts[len(m.DistributionContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoUnjail = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RewardDistributionContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardDistributionContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardDistributionContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySimulateNextValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateNextValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateNextValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QuerySimulateNextValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateNextValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateNextValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,17 +7337,81 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.ContractType = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1762,7 +7421,7 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractType |= PoEContractType(b&0x7F) << shift
+				m.Power |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1789,7 +7448,7 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1812,17 +7471,17 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1832,23 +7491,27 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1872,7 +7535,7 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,12 +7558,82 @@ func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, Slashing{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1923,7 +7656,7 @@ func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEngagementPointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,17 +7679,17 @@ func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEngagementPointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEngagementPointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1966,24 +7699,23 @@ func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2007,7 +7739,7 @@ func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEngagementPointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,17 +7762,17 @@ func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEngagementPointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEngagementPointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			m.Points = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2050,24 +7782,11 @@ func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Points |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2090,7 +7809,7 @@ func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEngagementMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2113,15 +7832,15 @@ func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEngagementMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEngagementMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2148,7 +7867,10 @@ func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2174,7 +7896,7 @@ func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEngagementMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2197,17 +7919,17 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEngagementMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEngagementMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2217,23 +7939,25 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, TG4Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2264,13 +7988,64 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEngagementHalflifeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEngagementHalflifeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEngagementHalflifeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2293,7 +8068,7 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEngagementHalflifeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2316,15 +8091,15 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEngagementHalflifeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEngagementHalflifeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halflife", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2351,14 +8126,13 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, types1.UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Halflife, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHalflife", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2385,10 +8159,40 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHalflife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHalflife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextHalflife, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2414,7 +8218,7 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMixedPointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,15 +8241,15 @@ func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMixedPointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMixedPointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2473,7 +8277,7 @@ func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2497,7 +8301,7 @@ func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMixedPointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,17 +8324,17 @@ func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMixedPointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMixedPointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var msglen int
+			m.Points = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2540,25 +8344,11 @@ func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Points |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2581,7 +8371,7 @@ func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMixerConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2604,44 +8394,12 @@ func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMixerConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMixerConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2664,7 +8422,7 @@ func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMixerConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2687,15 +8445,130 @@ func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMixerConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMixerConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EngagementContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EngagementContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPoints", wireType)
+			}
+			m.TotalPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigmoid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2722,7 +8595,10 @@ func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sigmoid == nil {
+				m.Sigmoid = &MixerContractConfig_Sigmoid{}
+			}
+			if err := m.Sigmoid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MixedPoints returns the points that the tg4-mixer contract calculates for the given stake and engagement points:
+// `max_points * (2 / (1 + e^(-s * (stake * engagement)^p)) - 1)` rounded down.
+// The calculation is done with float64 precision, so it is meant for projections only.
+func (m MixerContractConfig_Sigmoid) MixedPoints(stake, engagement uint64) uint64 {
+	if stake == 0 || engagement == 0 {
+		return 0
+	}
+	p, s := m.P.MustFloat64(), m.S.MustFloat64()
+	x := math.Pow(float64(stake)*float64(engagement), p)
+	// 2/(1+e^-sx)-1 == tanh(sx/2) is numerically more stable for small values
+	return uint64(float64(m.MaxPoints) * math.Tanh(s*x/2))
+}
+
+// EpochRewardSplit is the valset contract configuration that determines how the reward of an epoch is split.
+// All ratios are in range 0-1.
+type EpochRewardSplit struct {
+	EpochReward              sdk.Coin
+	FeePercentage            sdk.Dec
+	ValidatorRewardRatio     sdk.Dec
+	EngagementRewardRatio    sdk.Dec
+	CommunityPoolRewardRatio sdk.Dec
+}
+
+// NewEpochRewardSplit constructor for the seed contracts valset setup that has the values in percentage
+func NewEpochRewardSplit(c ValsetContractConfig) EpochRewardSplit {
+	return EpochRewardSplit{
+		EpochReward:              c.EpochReward,
+		FeePercentage:            c.FeePercentage.QuoInt64(100),
+		ValidatorRewardRatio:     c.ValidatorRewardRatio.QuoInt64(100),
+		EngagementRewardRatio:    c.EngagementRewardRatio.QuoInt64(100),
+		CommunityPoolRewardRatio: c.CommunityPoolRewardRatio.QuoInt64(100),
+	}
+}
+
+// EpochRewards is the reward of an epoch split by receiver
+type EpochRewards struct {
+	Total         sdk.Coin
+	Validators    sdk.Coin
+	Engagement    sdk.Coin
+	CommunityPool sdk.Coin
+}
+
+// Split returns the rewards of an epoch with the given amount of collected fees. The valset contract mints the
+// epoch reward reduced by the fee percentage of the fees and distributes it together with the fees.
+func (s EpochRewardSplit) Split(fees sdk.Int) EpochRewards {
+	minted := s.EpochReward.Amount.Sub(s.FeePercentage.MulInt(fees).TruncateInt())
+	if minted.IsNegative() {
+		minted = sdk.ZeroInt()
+	}
+	total := minted.Add(fees)
+	share := func(ratio sdk.Dec) sdk.Coin {
+		return sdk.NewCoin(s.EpochReward.Denom, ratio.MulInt(total).TruncateInt())
+	}
+	return EpochRewards{
+		Total:         sdk.NewCoin(s.EpochReward.Denom, total),
+		Validators:    share(s.ValidatorRewardRatio),
+		Engagement:    share(s.EngagementRewardRatio),
+		CommunityPool: share(s.CommunityPoolRewardRatio),
+	}
+}
+
+// ShareOf returns the part of the given reward that belongs to points out of total points
+func ShareOf(reward sdk.Coin, points, totalPoints uint64) sdk.Coin {
+	if totalPoints == 0 {
+		return sdk.NewCoin(reward.Denom, sdk.ZeroInt())
+	}
+	amount := reward.Amount.Mul(sdk.NewIntFromUint64(points)).Quo(sdk.NewIntFromUint64(totalPoints))
+	return sdk.NewCoin(reward.Denom, amount)
+}
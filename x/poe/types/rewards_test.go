@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigmoidMixedPoints(t *testing.T) {
+	sigmoid := DefaultGenesisState().GetSeedContracts().MixerContractConfig.Sigmoid
+	// expected values were taken from the tg4-mixer contract
+	specs := map[string]struct {
+		stake, engagement uint64
+		exp               uint64
+	}{
+		"min values": {
+			stake: 1, engagement: 1, exp: 4,
+		},
+		"small values": {
+			stake: 1000, engagement: 100, exp: 6294,
+		},
+		"large values": {
+			stake: 123456, engagement: 7890, exp: 953615,
+		},
+		"max points reached": {
+			stake: 1_000_000_000, engagement: 1_000_000_000, exp: 1_000_000,
+		},
+		"no stake": {
+			stake: 0, engagement: 100, exp: 0,
+		},
+		"no engagement": {
+			stake: 1000, engagement: 0, exp: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, sigmoid.MixedPoints(spec.stake, spec.engagement))
+		})
+	}
+}
+
+func TestEpochRewardSplit(t *testing.T) {
+	split := NewEpochRewardSplit(*DefaultGenesisState().GetSeedContracts().ValsetContractConfig)
+	specs := map[string]struct {
+		fees sdk.Int
+		exp  EpochRewards
+	}{
+		"no fees": {
+			fees: sdk.ZeroInt(),
+			exp: EpochRewards{
+				Total:         sdk.NewCoin(DefaultBondDenom, sdk.NewInt(100_000)),
+				Validators:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(47_500)),
+				Engagement:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(47_500)),
+				CommunityPool: sdk.NewCoin(DefaultBondDenom, sdk.NewInt(5_000)),
+			},
+		},
+		"fees reduce minted amount": {
+			fees: sdk.NewInt(20_000),
+			exp: EpochRewards{
+				Total:         sdk.NewCoin(DefaultBondDenom, sdk.NewInt(110_000)),
+				Validators:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(52_250)),
+				Engagement:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(52_250)),
+				CommunityPool: sdk.NewCoin(DefaultBondDenom, sdk.NewInt(5_500)),
+			},
+		},
+		"fees exceed minted amount": {
+			fees: sdk.NewInt(300_000),
+			exp: EpochRewards{
+				Total:         sdk.NewCoin(DefaultBondDenom, sdk.NewInt(300_000)),
+				Validators:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(142_500)),
+				Engagement:    sdk.NewCoin(DefaultBondDenom, sdk.NewInt(142_500)),
+				CommunityPool: sdk.NewCoin(DefaultBondDenom, sdk.NewInt(15_000)),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, split.Split(spec.fees))
+		})
+	}
+}
+
+func TestShareOf(t *testing.T) {
+	reward := sdk.NewCoin(DefaultBondDenom, sdk.NewInt(1000))
+	assert.Equal(t, sdk.NewCoin(DefaultBondDenom, sdk.NewInt(333)), ShareOf(reward, 1, 3))
+	assert.Equal(t, reward, ShareOf(reward, 3, 3))
+	assert.Equal(t, sdk.NewCoin(DefaultBondDenom, sdk.ZeroInt()), ShareOf(reward, 1, 0))
+}
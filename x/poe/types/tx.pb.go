@@ -405,6 +405,266 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgClaimRewards defines a SDK message for claiming distribution and/or
+// engagement rewards. Both are claimed when none is selected.
+type MsgClaimRewards struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Distribution claims the validator rewards from the distribution contract
+	Distribution bool `protobuf:"varint,2,opt,name=distribution,proto3" json:"distribution,omitempty"`
+	// Engagement claims the rewards from the engagement contract
+	Engagement bool `protobuf:"varint,3,opt,name=engagement,proto3" json:"engagement,omitempty"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{8}
+}
+
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+// MsgClaimRewardsResponse defines the Msg/ClaimRewards response type.
+type MsgClaimRewardsResponse struct{}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{9}
+}
+
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawAddress defines a SDK message for setting an address that is
+// allowed to withdraw the engagement rewards of the sender
+type MsgSetWithdrawAddress struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
+func (m *MsgSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{10}
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddress.Merge(m, src)
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddress proto.InternalMessageInfo
+
+// MsgSetWithdrawAddressResponse defines the Msg/SetWithdrawAddress response
+// type.
+type MsgSetWithdrawAddressResponse struct{}
+
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{11}
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgUnjail defines a SDK message for unjailing a jailed validator
+type MsgUnjail struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+}
+
+func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
+func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjail) ProtoMessage()    {}
+func (*MsgUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{12}
+}
+
+func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjail.Merge(m, src)
+}
+
+func (m *MsgUnjail) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
+
+// MsgUnjailResponse defines the Msg/Unjail response type.
+type MsgUnjailResponse struct{}
+
+func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
+func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailResponse) ProtoMessage()    {}
+func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{13}
+}
+
+func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailResponse.Merge(m, src)
+}
+
+func (m *MsgUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "confio.poe.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "confio.poe.v1beta1.MsgCreateValidatorResponse")
@@ -414,52 +674,70 @@ func init() {
 	proto.RegisterType((*MsgDelegateResponse)(nil), "confio.poe.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "confio.poe.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "confio.poe.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "confio.poe.v1beta1.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "confio.poe.v1beta1.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "confio.poe.v1beta1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "confio.poe.v1beta1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgUnjail)(nil), "confio.poe.v1beta1.MsgUnjail")
+	proto.RegisterType((*MsgUnjailResponse)(nil), "confio.poe.v1beta1.MsgUnjailResponse")
 }
 
 func init() { proto.RegisterFile("confio/poe/v1beta1/tx.proto", fileDescriptor_c2f36f4be4f27cf5) }
 
 var fileDescriptor_c2f36f4be4f27cf5 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4f, 0xd4, 0x4e,
-	0x14, 0x6e, 0x81, 0xec, 0x6f, 0x7f, 0x43, 0x60, 0x4d, 0x85, 0xb8, 0x14, 0xd3, 0xe2, 0x4a, 0x14,
-	0x0f, 0x4e, 0x03, 0x1e, 0x4c, 0x38, 0x98, 0xb0, 0x10, 0x2e, 0x64, 0x13, 0xb3, 0x41, 0x0f, 0x5c,
-	0x36, 0xd3, 0x76, 0x18, 0x27, 0x6c, 0x3b, 0x93, 0xce, 0x2c, 0xa1, 0xff, 0x81, 0x17, 0x13, 0xce,
-	0x9e, 0xf8, 0x1f, 0xf4, 0x8f, 0x20, 0x9e, 0x38, 0x7a, 0x42, 0xb2, 0x7b, 0xf1, 0xec, 0xd9, 0x83,
-	0x69, 0x3b, 0xed, 0xae, 0x5d, 0x37, 0xa0, 0x24, 0xc6, 0x5b, 0x67, 0xde, 0xf7, 0xbe, 0xf9, 0xbe,
-	0xf7, 0xde, 0x4c, 0xc1, 0xb2, 0xc7, 0xc2, 0x43, 0xca, 0x1c, 0xce, 0xb0, 0x73, 0xbc, 0xee, 0x62,
-	0x89, 0xd6, 0x1d, 0x79, 0x02, 0x79, 0xc4, 0x24, 0x33, 0x8c, 0x2c, 0x08, 0x39, 0xc3, 0x50, 0x05,
-	0xcd, 0x25, 0xc2, 0x18, 0xe9, 0x62, 0x27, 0x45, 0xb8, 0xbd, 0x43, 0x07, 0x85, 0x71, 0x06, 0x37,
-	0xed, 0x72, 0x48, 0xd2, 0x00, 0x0b, 0x89, 0x02, 0xae, 0x00, 0x0b, 0x84, 0x11, 0x96, 0x7e, 0x3a,
-	0xc9, 0x97, 0xda, 0x5d, 0xf2, 0x98, 0x08, 0x98, 0xe8, 0x64, 0x81, 0x6c, 0xa1, 0x42, 0x56, 0xb6,
-	0x72, 0x5c, 0x24, 0x86, 0xf2, 0x3c, 0x46, 0x43, 0x15, 0x5f, 0x55, 0x71, 0x21, 0xd1, 0x11, 0x0d,
-	0x49, 0x01, 0x51, 0xeb, 0x0c, 0xd5, 0xf8, 0x3e, 0x05, 0x8c, 0x96, 0x20, 0xdb, 0x11, 0x46, 0x12,
-	0xbf, 0x46, 0x5d, 0xea, 0x23, 0xc9, 0x22, 0x63, 0x0f, 0xcc, 0xfa, 0x58, 0x78, 0x11, 0xe5, 0x92,
-	0xb2, 0xb0, 0xae, 0xaf, 0xe8, 0x6b, 0xb3, 0x1b, 0x0f, 0xa1, 0x12, 0x90, 0x53, 0x28, 0x4a, 0xb8,
-	0x33, 0x84, 0x36, 0x67, 0xce, 0x2f, 0x6d, 0xad, 0x3d, 0x9a, 0x6d, 0xec, 0x82, 0x3b, 0x8c, 0xe3,
-	0x28, 0x21, 0xee, 0x20, 0xdf, 0x8f, 0xb0, 0x10, 0xf5, 0x99, 0x15, 0x7d, 0xed, 0xff, 0xe6, 0xf2,
-	0xb7, 0x4b, 0xfb, 0x5e, 0x8c, 0x82, 0xee, 0x66, 0xa3, 0x8c, 0x68, 0xb4, 0x6b, 0xf9, 0xd6, 0x56,
-	0xb6, 0x63, 0xec, 0x82, 0x0a, 0xef, 0xb9, 0x47, 0x38, 0xae, 0x57, 0x52, 0x3d, 0x0b, 0x30, 0x2b,
-	0x2a, 0xcc, 0x8b, 0x0a, 0xb7, 0xc2, 0xb8, 0x59, 0xff, 0xf4, 0xf1, 0xe9, 0x82, 0x12, 0xea, 0x45,
-	0x31, 0x97, 0x0c, 0xbe, 0xec, 0xb9, 0x7b, 0x38, 0x6e, 0xab, 0x6c, 0xe3, 0x39, 0xa8, 0xa0, 0x80,
-	0xf5, 0x42, 0x59, 0xff, 0x2f, 0xe5, 0x59, 0xca, 0x7d, 0x25, 0xa5, 0x2c, 0x4c, 0x6d, 0x33, 0x9a,
-	0xbb, 0x51, 0x70, 0x63, 0x17, 0xcc, 0x1f, 0x63, 0x21, 0x69, 0x48, 0x3a, 0x8a, 0xa0, 0x7a, 0x33,
-	0x82, 0x39, 0x95, 0xb6, 0x95, 0x66, 0x6d, 0x56, 0xdf, 0x9e, 0xd9, 0xda, 0xd7, 0x33, 0x5b, 0x6b,
-	0xdc, 0x07, 0xe6, 0x78, 0xf5, 0xdb, 0x58, 0x70, 0x16, 0x0a, 0xdc, 0xf8, 0xa0, 0xa7, 0xcd, 0x79,
-	0xc5, 0xfd, 0xbf, 0xdb, 0x9c, 0xa9, 0xdf, 0x6f, 0xce, 0x98, 0xa7, 0x92, 0xe8, 0xc2, 0xd3, 0x95,
-	0x0e, 0x66, 0x5b, 0x82, 0xec, 0xe0, 0x2e, 0x26, 0x48, 0xe2, 0x5f, 0x9e, 0xaf, 0xff, 0xc1, 0x70,
-	0x0c, 0x9b, 0x3a, 0x75, 0xdb, 0xa6, 0x4e, 0xdf, 0xb2, 0xa9, 0x8b, 0xe0, 0xee, 0x88, 0xc3, 0xc2,
-	0xf9, 0x7b, 0x1d, 0xcc, 0x25, 0x85, 0x09, 0xfd, 0x7f, 0xc5, 0xfb, 0x88, 0xe6, 0x43, 0xb0, 0xf8,
-	0x93, 0xb6, 0x5c, 0xb5, 0xd1, 0x02, 0x35, 0x8f, 0x05, 0xbc, 0x8b, 0x93, 0x69, 0xe9, 0x24, 0xaf,
-	0x96, 0x1a, 0x38, 0x73, 0xec, 0xf6, 0xed, 0xe7, 0x4f, 0x5a, 0xb3, 0x9a, 0x9c, 0x72, 0xfa, 0xc5,
-	0xd6, 0xdb, 0xf3, 0xc3, 0xe4, 0x24, 0xbc, 0xf1, 0x6e, 0x1a, 0x4c, 0xb7, 0x04, 0x31, 0x28, 0xa8,
-	0x95, 0xdf, 0x9c, 0x47, 0x70, 0xfc, 0x49, 0x85, 0xe3, 0xb7, 0xc3, 0x84, 0x37, 0xc3, 0x15, 0x0e,
-	0x28, 0xa8, 0x95, 0x6f, 0xd0, 0xa4, 0xa3, 0x4a, 0xb8, 0x89, 0x47, 0x4d, 0x18, 0x6e, 0x63, 0x1f,
-	0x54, 0x8b, 0xc1, 0xb6, 0x27, 0xe4, 0xe6, 0x00, 0xf3, 0xf1, 0x35, 0x80, 0x82, 0xf5, 0x00, 0x80,
-	0x91, 0xa1, 0x79, 0x30, 0x49, 0x53, 0x01, 0x31, 0x9f, 0x5c, 0x0b, 0xc9, 0xb9, 0x9b, 0x2f, 0xce,
-	0xfb, 0x96, 0x7e, 0xd1, 0xb7, 0xf4, 0xab, 0xbe, 0xa5, 0x9f, 0x0e, 0x2c, 0xed, 0x62, 0x60, 0x69,
-	0x9f, 0x07, 0x96, 0x76, 0xb0, 0x4a, 0xa8, 0x7c, 0xd3, 0x73, 0xa1, 0xc7, 0x02, 0x47, 0xfd, 0x08,
-	0x25, 0x89, 0x90, 0x8f, 0x9d, 0x93, 0xf4, 0x8f, 0x28, 0x63, 0x8e, 0x85, 0x5b, 0x49, 0xbb, 0xff,
-	0xec, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xac, 0xb2, 0x61, 0x2c, 0x07, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xbb, 0x4b, 0xc8, 0xbe, 0xee, 0x6e, 0x16, 0x6f, 0x97, 0x4d, 0xbc, 0xd4, 0x2e, 0x6e,
+	0x81, 0x22, 0x84, 0xad, 0x96, 0x03, 0x52, 0x0f, 0x48, 0x4d, 0xab, 0x5c, 0xaa, 0x20, 0x64, 0x0a,
+	0x48, 0x95, 0x50, 0x18, 0xc7, 0x53, 0x77, 0x68, 0xec, 0xb1, 0x3c, 0x93, 0xa6, 0x39, 0x72, 0xe3,
+	0xd8, 0x33, 0xa7, 0xfe, 0x87, 0xf2, 0x23, 0x2a, 0x4e, 0x3d, 0x72, 0x2a, 0x55, 0x7b, 0xe1, 0xcc,
+	0x99, 0x03, 0xb2, 0x3d, 0x76, 0x1c, 0xa7, 0xa6, 0x81, 0x22, 0xb4, 0xb7, 0xcc, 0xbc, 0xef, 0xbd,
+	0xf7, 0x7d, 0xef, 0xbd, 0x79, 0x31, 0xbc, 0xea, 0x51, 0x7f, 0x9f, 0x50, 0x33, 0xa0, 0xd8, 0x3c,
+	0x5a, 0xb3, 0x31, 0x47, 0x6b, 0x26, 0x3f, 0x36, 0x82, 0x90, 0x72, 0x2a, 0xcb, 0x89, 0xd1, 0x08,
+	0x28, 0x36, 0x84, 0x51, 0x69, 0xba, 0x94, 0xba, 0x7d, 0x6c, 0xc6, 0x08, 0x7b, 0xb0, 0x6f, 0x22,
+	0x7f, 0x94, 0xc0, 0x15, 0xad, 0x68, 0xe2, 0xc4, 0xc3, 0x8c, 0x23, 0x2f, 0x10, 0x80, 0x05, 0x97,
+	0xba, 0x34, 0xfe, 0x69, 0x46, 0xbf, 0xc4, 0x6d, 0xb3, 0x47, 0x99, 0x47, 0x59, 0x37, 0x31, 0x24,
+	0x07, 0x61, 0x52, 0x93, 0x93, 0x69, 0x23, 0x36, 0xa6, 0xd7, 0xa3, 0xc4, 0x17, 0xf6, 0x15, 0x61,
+	0x67, 0x1c, 0x1d, 0x12, 0xdf, 0xcd, 0x20, 0xe2, 0x9c, 0xa0, 0xf4, 0x3f, 0xe7, 0x40, 0xee, 0x30,
+	0x77, 0x2b, 0xc4, 0x88, 0xe3, 0xaf, 0x51, 0x9f, 0x38, 0x88, 0xd3, 0x50, 0xde, 0x81, 0x79, 0x07,
+	0xb3, 0x5e, 0x48, 0x02, 0x4e, 0xa8, 0xdf, 0x90, 0x96, 0xa4, 0xd5, 0xf9, 0xf5, 0x65, 0x43, 0x10,
+	0x48, 0x43, 0x88, 0x90, 0xc6, 0xf6, 0x18, 0xda, 0x7a, 0x78, 0x7e, 0xa9, 0x55, 0xac, 0xbc, 0xb7,
+	0xdc, 0x86, 0x67, 0x34, 0xc0, 0x61, 0x14, 0xb8, 0x8b, 0x1c, 0x27, 0xc4, 0x8c, 0x35, 0x1e, 0x2e,
+	0x49, 0xab, 0x8f, 0x5a, 0xaf, 0xfe, 0xb8, 0xd4, 0x5e, 0x8e, 0x90, 0xd7, 0xdf, 0xd0, 0x8b, 0x08,
+	0xdd, 0xaa, 0xa7, 0x57, 0x9b, 0xc9, 0x8d, 0xdc, 0x86, 0x6a, 0x30, 0xb0, 0x0f, 0xf1, 0xa8, 0x51,
+	0x8d, 0xf9, 0x2c, 0x18, 0x49, 0x51, 0x8d, 0xb4, 0xa8, 0xc6, 0xa6, 0x3f, 0x6a, 0x35, 0x7e, 0xf9,
+	0xf9, 0xe3, 0x05, 0x41, 0xb4, 0x17, 0x8e, 0x02, 0x4e, 0x8d, 0x2f, 0x06, 0xf6, 0x0e, 0x1e, 0x59,
+	0xc2, 0x5b, 0xfe, 0x14, 0xaa, 0xc8, 0xa3, 0x03, 0x9f, 0x37, 0xde, 0x8c, 0xe3, 0x34, 0x53, 0x5d,
+	0x51, 0x29, 0x33, 0x51, 0x5b, 0x94, 0xa4, 0x6a, 0x04, 0x5c, 0x6e, 0xc3, 0xd3, 0x23, 0xcc, 0x38,
+	0xf1, 0xdd, 0xae, 0x08, 0x50, 0x9b, 0x2d, 0xc0, 0x13, 0xe1, 0xb6, 0x19, 0x7b, 0x6d, 0xd4, 0x7e,
+	0x3c, 0xd5, 0x2a, 0xbf, 0x9f, 0x6a, 0x15, 0xfd, 0x1d, 0x50, 0xa6, 0xab, 0x6f, 0x61, 0x16, 0x50,
+	0x9f, 0x61, 0xfd, 0x4c, 0x8a, 0x9b, 0xf3, 0x55, 0xe0, 0xfc, 0xbf, 0xcd, 0x99, 0xfb, 0xe7, 0xcd,
+	0x99, 0xd2, 0x54, 0x20, 0x9d, 0x69, 0xba, 0x92, 0x60, 0xbe, 0xc3, 0xdc, 0x6d, 0xdc, 0xc7, 0x2e,
+	0xe2, 0xf8, 0xd6, 0xfc, 0xd2, 0xbf, 0x18, 0x8e, 0x71, 0x53, 0xe7, 0xee, 0xdb, 0xd4, 0x07, 0xf7,
+	0x6c, 0xea, 0x0b, 0x78, 0x9e, 0x53, 0x98, 0x29, 0xff, 0x49, 0x82, 0x27, 0x51, 0x61, 0x7c, 0xe7,
+	0x75, 0xd1, 0x9e, 0xe3, 0xbc, 0x0f, 0x2f, 0x26, 0xb8, 0xa5, 0xac, 0xe5, 0x0e, 0xd4, 0x7b, 0xd4,
+	0x0b, 0xfa, 0x38, 0x9a, 0x96, 0x6e, 0xb4, 0xb5, 0xc4, 0xc0, 0x29, 0x53, 0xaf, 0x6f, 0x37, 0x5d,
+	0x69, 0xad, 0x5a, 0x94, 0xe5, 0xe4, 0x37, 0x4d, 0xb2, 0x9e, 0x8e, 0x9d, 0x23, 0xb3, 0x3e, 0x84,
+	0x7a, 0x34, 0xf0, 0x7d, 0x44, 0x3c, 0x0b, 0x0f, 0x51, 0xe8, 0x30, 0xf9, 0x6d, 0xa8, 0x32, 0xec,
+	0x3b, 0x38, 0x4c, 0xb4, 0x5b, 0xe2, 0x24, 0xeb, 0xf0, 0xd8, 0x21, 0x8c, 0x87, 0xc4, 0x1e, 0xc4,
+	0x73, 0x1e, 0x69, 0xab, 0x59, 0x13, 0x77, 0xb2, 0x0a, 0x80, 0x7d, 0x17, 0xb9, 0xd8, 0xc3, 0xa2,
+	0x71, 0x35, 0x2b, 0x77, 0x93, 0x13, 0xd8, 0x84, 0x97, 0x85, 0xc4, 0x59, 0x63, 0x7e, 0x90, 0x62,
+	0xf1, 0x5f, 0x62, 0xfe, 0x0d, 0xe1, 0x07, 0x4e, 0x88, 0x86, 0x69, 0x61, 0xcb, 0xa8, 0xb5, 0xe1,
+	0xd9, 0x50, 0x40, 0xcb, 0x1f, 0x4d, 0x11, 0xa1, 0x5b, 0xf5, 0xe1, 0x64, 0xfc, 0x1c, 0x3d, 0x0d,
+	0x16, 0x6f, 0xa5, 0x90, 0x91, 0xfc, 0x16, 0x1e, 0xc5, 0x0d, 0xfa, 0x1e, 0x91, 0xfe, 0x7f, 0x35,
+	0x38, 0xb9, 0xfc, 0xcf, 0xe1, 0xad, 0x2c, 0x7c, 0x9a, 0x73, 0xfd, 0xec, 0x0d, 0x78, 0xd0, 0x61,
+	0xae, 0x4c, 0xa0, 0x5e, 0xfc, 0x83, 0x78, 0xdf, 0x98, 0xfe, 0xff, 0x33, 0xa6, 0x57, 0x99, 0x62,
+	0xcc, 0x86, 0xcb, 0xc6, 0x8d, 0x40, 0xbd, 0xb8, 0xee, 0xca, 0x52, 0x15, 0x70, 0x8a, 0x31, 0x1b,
+	0x2e, 0x4b, 0xb5, 0x0b, 0xb5, 0x6c, 0x0b, 0x69, 0x25, 0xbe, 0x29, 0x40, 0xf9, 0xe0, 0x0e, 0x40,
+	0x16, 0x75, 0x0f, 0x20, 0xf7, 0xc2, 0xdf, 0x2d, 0xe3, 0x94, 0x41, 0x94, 0x0f, 0xef, 0x84, 0x64,
+	0xb1, 0xbf, 0x83, 0xc7, 0x13, 0x2f, 0x67, 0xb9, 0xac, 0xb8, 0x39, 0x90, 0xf2, 0xd1, 0x0c, 0xa0,
+	0x2c, 0x43, 0x08, 0xf2, 0x2d, 0xcf, 0xa0, 0x8c, 0xe2, 0x34, 0x54, 0x59, 0x9b, 0x19, 0x9a, 0xe5,
+	0xfc, 0x1c, 0xaa, 0x62, 0xac, 0x17, 0x4b, 0x4b, 0x11, 0x99, 0x95, 0xf7, 0xfe, 0xd6, 0x9c, 0xc6,
+	0x6b, 0x7d, 0x76, 0x7e, 0xad, 0x4a, 0x17, 0xd7, 0xaa, 0x74, 0x75, 0xad, 0x4a, 0x27, 0x37, 0x6a,
+	0xe5, 0xe2, 0x46, 0xad, 0xfc, 0x7a, 0xa3, 0x56, 0xf6, 0x56, 0x5c, 0xc2, 0x0f, 0x06, 0xb6, 0xd1,
+	0xa3, 0x9e, 0x29, 0xbe, 0xed, 0xb8, 0x1b, 0x22, 0x07, 0x9b, 0xc7, 0xf1, 0x47, 0x1e, 0x1f, 0x05,
+	0x98, 0xd9, 0xd5, 0x78, 0xa1, 0x7d, 0xf2, 0xd7, 0x00, 0x4e, 0x66, 0x92, 0xe9, 0xff, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,6 +762,14 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// node operator
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// ClaimRewards defines a method for claiming distribution and/or engagement
+	// rewards
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// SetWithdrawAddress defines a method for setting an address that is allowed
+	// to withdraw the engagement rewards of the sender
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// Unjail defines a method for unjailing a jailed validator
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -530,6 +816,33 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -542,6 +855,14 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// node operator
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// ClaimRewards defines a method for claiming distribution and/or engagement
+	// rewards
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// SetWithdrawAddress defines a method for setting an address that is allowed
+	// to withdraw the engagement rewards of the sender
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// Unjail defines a method for unjailing a jailed validator
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -563,6 +884,18 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
 
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+
+func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -639,6 +972,60 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "confio.poe.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -659,6 +1046,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "confio/poe/v1beta1/tx.proto",
@@ -966,31 +1365,217 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Engagement {
+		i--
+		if m.Engagement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pubkey != nil {
+	if m.Distribution {
+		i--
+		if m.Distribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
 		l = m.Pubkey.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1086,6 +1671,82 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Distribution {
+		n += 2
+	}
+	if m.Engagement {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1257,7 +1918,374 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
 			}
@@ -1312,7 +2340,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,10 +2363,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1363,7 +2391,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,17 +2414,17 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1406,30 +2434,29 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1439,23 +2466,24 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1479,7 +2507,7 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,12 +2530,45 @@ func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1530,7 +2591,7 @@ func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1553,15 +2614,15 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1589,13 +2650,13 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1605,30 +2666,17 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Distribution = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engagement", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1638,25 +2686,12 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Engagement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1679,7 +2714,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,10 +2737,10 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1730,7 +2765,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1753,15 +2788,15 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1789,13 +2824,13 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1805,25 +2840,75 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1846,7 +2931,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,17 +2954,17 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1889,25 +2974,75 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16,10 +16,13 @@ import (
 type ViewKeeper interface {
 	GetBondDenom(ctx sdk.Context) string
 	DistributionContract(ctx sdk.Context) keeper.DistributionContract
+	EngagementContract(ctx sdk.Context) keeper.EngagementContract
 	ValsetContract(ctx sdk.Context) keeper.ValsetContract
 	StakeContract(ctx sdk.Context) keeper.StakeContract
 	GetPoEContractAddress(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error)
 	GetValidatorVotes() []abcitypes.VoteInfo
+	SignedBlocksWindow(ctx sdk.Context) uint32
+	GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (types.ValidatorSigningInfo, bool)
 }
 
 func StakingQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
@@ -121,12 +124,24 @@ func StakingQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request *wasmvmt
 			if err != nil {
 				return nil, sdkerrors.Wrap(err, "query outstanding reward")
 			}
+			// the engagement rewards are withdrawn together with the distribution rewards
+			engagementReward, err := poeKeeper.EngagementContract(ctx).QueryWithdrawableRewards(ctx, delegator)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "query engagement reward")
+			}
+			rewards := wasmvmtypes.Coins{wasmvmtypes.NewCoin(reward.Amount.Uint64(), reward.Denom)}
+			switch {
+			case engagementReward.Denom == reward.Denom:
+				rewards[0] = wasmvmtypes.NewCoin(reward.Add(engagementReward).Amount.Uint64(), reward.Denom)
+			case engagementReward.IsPositive():
+				rewards = append(rewards, wasmvmtypes.NewCoin(engagementReward.Amount.Uint64(), engagementReward.Denom))
+			}
 			if stakedAmount == nil {
 				zeroInt := sdk.ZeroInt()
 				stakedAmount = &zeroInt
 			}
 			// there can be unclaimed rewards while all stacked amounts were unbound
-			if stakedAmount.GT(sdk.ZeroInt()) || reward.Amount.GT(sdk.ZeroInt()) {
+			if stakedAmount.GT(sdk.ZeroInt()) || reward.Amount.GT(sdk.ZeroInt()) || engagementReward.IsPositive() {
 				bondDenom := poeKeeper.GetBondDenom(ctx)
 				stakedCoin := wasmvmtypes.NewCoin(stakedAmount.Uint64(), bondDenom)
 				res.Delegation = &wasmvmtypes.FullDelegation{
@@ -134,7 +149,7 @@ func StakingQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request *wasmvmt
 					Validator:          delegator.String(),
 					Amount:             stakedCoin,
 					CanRedelegate:      wasmvmtypes.NewCoin(0, bondDenom),
-					AccumulatedRewards: wasmvmtypes.Coins{wasmvmtypes.NewCoin(reward.Amount.Uint64(), reward.Denom)},
+					AccumulatedRewards: rewards,
 				}
 			}
 			return json.Marshal(res)
@@ -158,12 +173,23 @@ type ContractAddrResponse struct {
 
 type ValidatorVotesResponse struct {
 	Votes []ValidatorVote `json:"votes"`
+	// SignedBlocksWindow is the length of the missed blocks window. Not set when tracking is disabled.
+	SignedBlocksWindow uint32 `json:"signed_blocks_window,omitempty"`
 }
 
 type ValidatorVote struct {
 	Addr  sdk.AccAddress `json:"address"`
 	Power uint64         `json:"power"`
 	Voted bool           `json:"voted"`
+	// SigningInfo contains the missed blocks within the window. Not set when tracking is disabled.
+	SigningInfo *ValidatorVoteSigningInfo `json:"signing_info,omitempty"`
+}
+
+type ValidatorVoteSigningInfo struct {
+	// MissedBlocks number of blocks missed within the window
+	MissedBlocks uint64 `json:"missed_blocks"`
+	// TrackedBlocks number of blocks within the window that the validator was tracked for
+	TrackedBlocks uint64 `json:"tracked_blocks"`
 }
 
 func CustomQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
@@ -177,15 +203,16 @@ func CustomQuerier(poeKeeper ViewKeeper) func(ctx sdk.Context, request json.RawM
 		case contractQuery.PoEContractAddress != nil:
 			return handlePoEContractAddressQuery(ctx, contractQuery, poeKeeper)
 		case contractQuery.ValidatorVotes != nil:
-			return handleValidatorVotesQuery(poeKeeper)
+			return handleValidatorVotesQuery(ctx, poeKeeper)
 		}
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown poe query variant"}
 	}
 }
 
-func handleValidatorVotesQuery(poeKeeper ViewKeeper) ([]byte, error) {
+func handleValidatorVotesQuery(ctx sdk.Context, poeKeeper ViewKeeper) ([]byte, error) {
 	validatorVotes := poeKeeper.GetValidatorVotes()
 	votes := make([]ValidatorVote, len(validatorVotes))
+	window := poeKeeper.SignedBlocksWindow(ctx)
 
 	for index, v := range validatorVotes {
 		vote := ValidatorVote{
@@ -193,10 +220,23 @@ func handleValidatorVotesQuery(poeKeeper ViewKeeper) ([]byte, error) {
 			Addr:  v.Validator.Address,
 			Voted: v.SignedLastBlock,
 		}
+		if window != 0 {
+			if info, found := poeKeeper.GetValidatorSigningInfo(ctx, v.Validator.Address); found {
+				tracked := uint64(info.IndexOffset)
+				if tracked > uint64(window) {
+					tracked = uint64(window)
+				}
+				vote.SigningInfo = &ValidatorVoteSigningInfo{
+					MissedBlocks:  uint64(info.MissedBlocksCounter),
+					TrackedBlocks: tracked,
+				}
+			}
+		}
 		votes[index] = vote
 	}
 	res := ValidatorVotesResponse{
-		Votes: votes,
+		Votes:              votes,
+		SignedBlocksWindow: window,
 	}
 	bz, err := json.Marshal(res)
 	if err != nil {
@@ -159,6 +159,11 @@ func TestStakingQuerier(t *testing.T) {
 						return sdk.NewCoin("alx", sdk.NewInt(2)), nil
 					}}
 				},
+				EngagementContractFn: func(ctx sdk.Context) keeper.EngagementContract {
+					return poetesting.EngagementContractMock{QueryWithdrawableRewardsFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+						return sdk.NewCoin("alx", sdk.ZeroInt()), nil
+					}}
+				},
 			},
 			expJson: `{
   "delegation": {
@@ -180,6 +185,53 @@ func TestStakingQuerier(t *testing.T) {
     }
   }
 }
+`,
+		},
+		"query delegation - with engagement rewards": {
+			src: wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: "cosmos1yq8zt83jznmp94jkj65yvfz9n52akmxt52ehm3", Validator: "cosmos1yq8zt83jznmp94jkj65yvfz9n52akmxt52ehm3"}},
+			mock: ViewKeeperMock{
+				StakeContractFn: func(ctx sdk.Context) keeper.StakeContract {
+					return poetesting.StakeContractMock{
+						QueryStakedAmountFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (*sdk.Int, error) {
+							myValue := sdk.OneInt()
+							return &myValue, nil
+						},
+					}
+				},
+				GetBondDenomFn: func(ctx sdk.Context) string {
+					return "alx"
+				},
+				DistributionContractFn: func(ctx sdk.Context) keeper.DistributionContract {
+					return poetesting.DistributionContractMock{ValidatorOutstandingRewardFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+						return sdk.NewCoin("alx", sdk.ZeroInt()), nil
+					}}
+				},
+				EngagementContractFn: func(ctx sdk.Context) keeper.EngagementContract {
+					return poetesting.EngagementContractMock{QueryWithdrawableRewardsFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+						return sdk.NewCoin("alx", sdk.NewInt(3)), nil
+					}}
+				},
+			},
+			expJson: `{
+  "delegation": {
+    "delegator": "cosmos1yq8zt83jznmp94jkj65yvfz9n52akmxt52ehm3",
+    "validator": "cosmos1yq8zt83jznmp94jkj65yvfz9n52akmxt52ehm3",
+    "amount": {
+      "denom": "alx",
+      "amount": "1"
+    },
+    "accumulated_rewards": [
+      {
+        "denom": "alx",
+        "amount": "3"
+      }
+    ],
+    "can_redelegate": {
+      "denom": "alx",
+      "amount": "0"
+    }
+  }
+}
 `,
 		},
 		"query delegation - address do not match - return empty result": {
@@ -201,6 +253,11 @@ func TestStakingQuerier(t *testing.T) {
 						return sdk.NewCoin("alx", sdk.ZeroInt()), nil
 					}}
 				},
+				EngagementContractFn: func(ctx sdk.Context) keeper.EngagementContract {
+					return poetesting.EngagementContractMock{QueryWithdrawableRewardsFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+						return sdk.NewCoin("alx", sdk.ZeroInt()), nil
+					}}
+				},
 			},
 			expJson: `{}`,
 		},
@@ -230,6 +287,11 @@ func TestStakingQuerier(t *testing.T) {
 						return sdk.NewCoin("alx", sdk.NewInt(2)), nil
 					}}
 				},
+				EngagementContractFn: func(ctx sdk.Context) keeper.EngagementContract {
+					return poetesting.EngagementContractMock{QueryWithdrawableRewardsFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+						return sdk.NewCoin("alx", sdk.ZeroInt()), nil
+					}}
+				},
 			},
 			expJson: `{
   "delegation": {
@@ -352,9 +414,41 @@ func TestCustomQuerier(t *testing.T) {
 						},
 					}
 				},
+				SignedBlocksWindowFn: func(ctx sdk.Context) uint32 {
+					return 0
+				},
 			},
 			expJSON: `{"votes":[{"address":"` + sdk.AccAddress("validator_addr").String() + `", "power":10, "voted":true}]}`,
 		},
+		"validator votes query with signing info": {
+			src: []byte(`{ "validator_votes": {} }`),
+			mock: ViewKeeperMock{
+				GetValidatorVotesFn: func() []abcitypes.VoteInfo {
+					return []abcitypes.VoteInfo{
+						{
+							Validator:       abcitypes.Validator{Address: sdk.AccAddress("validator_addr"), Power: 10},
+							SignedLastBlock: false,
+						},
+						{
+							Validator:       abcitypes.Validator{Address: sdk.AccAddress("other_addr"), Power: 1},
+							SignedLastBlock: true,
+						},
+					}
+				},
+				SignedBlocksWindowFn: func(ctx sdk.Context) uint32 {
+					return 100
+				},
+				GetValidatorSigningInfoFn: func(ctx sdk.Context, consAddr sdk.ConsAddress) (poetypes.ValidatorSigningInfo, bool) {
+					if !consAddr.Equals(sdk.ConsAddress("validator_addr")) {
+						return poetypes.ValidatorSigningInfo{}, false
+					}
+					return poetypes.ValidatorSigningInfo{IndexOffset: 150, MissedBlocksCounter: 3}, true
+				},
+			},
+			expJSON: `{"signed_blocks_window":100,"votes":[
+{"address":"` + sdk.AccAddress("validator_addr").String() + `", "power":10, "voted":false, "signing_info":{"missed_blocks":3,"tracked_blocks":100}},
+{"address":"` + sdk.AccAddress("other_addr").String() + `", "power":1, "voted":true}]}`,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -371,12 +465,15 @@ func TestCustomQuerier(t *testing.T) {
 }
 
 type ViewKeeperMock struct {
-	GetBondDenomFn          func(ctx sdk.Context) string
-	DistributionContractFn  func(ctx sdk.Context) keeper.DistributionContract
-	ValsetContractFn        func(ctx sdk.Context) keeper.ValsetContract
-	StakeContractFn         func(ctx sdk.Context) keeper.StakeContract
-	GetPoEContractAddressFn func(ctx sdk.Context, contractType poetypes.PoEContractType) (sdk.AccAddress, error)
-	GetValidatorVotesFn     func() []abcitypes.VoteInfo
+	GetBondDenomFn            func(ctx sdk.Context) string
+	DistributionContractFn    func(ctx sdk.Context) keeper.DistributionContract
+	EngagementContractFn      func(ctx sdk.Context) keeper.EngagementContract
+	ValsetContractFn          func(ctx sdk.Context) keeper.ValsetContract
+	StakeContractFn           func(ctx sdk.Context) keeper.StakeContract
+	GetPoEContractAddressFn   func(ctx sdk.Context, contractType poetypes.PoEContractType) (sdk.AccAddress, error)
+	GetValidatorVotesFn       func() []abcitypes.VoteInfo
+	SignedBlocksWindowFn      func(ctx sdk.Context) uint32
+	GetValidatorSigningInfoFn func(ctx sdk.Context, consAddr sdk.ConsAddress) (poetypes.ValidatorSigningInfo, bool)
 }
 
 func (m ViewKeeperMock) GetBondDenom(ctx sdk.Context) string {
@@ -393,6 +490,13 @@ func (m ViewKeeperMock) DistributionContract(ctx sdk.Context) keeper.Distributio
 	return m.DistributionContractFn(ctx)
 }
 
+func (m ViewKeeperMock) EngagementContract(ctx sdk.Context) keeper.EngagementContract {
+	if m.EngagementContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.EngagementContractFn(ctx)
+}
+
 func (m ViewKeeperMock) ValsetContract(ctx sdk.Context) keeper.ValsetContract {
 	if m.ValsetContractFn == nil {
 		panic("not expected to be called")
@@ -420,3 +524,17 @@ func (m ViewKeeperMock) GetValidatorVotes() []abcitypes.VoteInfo {
 	}
 	return m.GetValidatorVotesFn()
 }
+
+func (m ViewKeeperMock) SignedBlocksWindow(ctx sdk.Context) uint32 {
+	if m.SignedBlocksWindowFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SignedBlocksWindowFn(ctx)
+}
+
+func (m ViewKeeperMock) GetValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (poetypes.ValidatorSigningInfo, bool) {
+	if m.GetValidatorSigningInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetValidatorSigningInfoFn(ctx, consAddr)
+}
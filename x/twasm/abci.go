@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -12,19 +13,37 @@ import (
 	"github.com/confio/tgrade/x/twasm/keeper"
 	"github.com/confio/tgrade/x/twasm/types"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-type abciKeeper interface {
+// PrivilegedCallbackKeeper is the subset of the keeper that is required to send abci callbacks to privileged contracts
+type PrivilegedCallbackKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	GetCallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64
+	TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+	ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+}
+
+type abciKeeper interface {
+	PrivilegedCallbackKeeper
+	ExpiredPrivileged(ctx sdk.Context) []sdk.AccAddress
+	DemoteExpiredPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	DueScheduledCallbacks(ctx sdk.Context) []types.ScheduledCallback
+	GetScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback
+	CompleteScheduledCallback(ctx sdk.Context, callback types.ScheduledCallback) error
+	IncludesLiveness(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool
 }
 
 func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	logger := keeper.ModuleLogger(ctx)
+	// demote before the callbacks so that expired contracts are not called anymore
+	demoteExpiredPrivileged(ctx, k)
+
 	evidence := make([]contract.Evidence, 0, len(b.ByzantineValidators))
 	for _, e := range b.ByzantineValidators {
 		var et contract.EvidenceType
@@ -59,7 +78,21 @@ func BeginBlocker(ctx sdk.Context, k abciKeeper, b abci.RequestBeginBlock) {
 	if err != nil {
 		panic(err) // this will crash the node as panics are not recovered
 	}
-	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeBeginBlock, abciContractCallback(ctx, k, msgBz))
+	// the larger payload with liveness data is only built when a contract opted in
+	var livenessMsgBz []byte
+	callPrivilegedContracts(ctx, k, types.PrivilegeTypeBeginBlock, func(contractAddr sdk.AccAddress) []byte {
+		if !k.IncludesLiveness(ctx, types.PrivilegeTypeBeginBlock, contractAddr) {
+			return msgBz
+		}
+		if livenessMsgBz == nil {
+			msg.BeginBlock.Liveness = newLiveness(b)
+			if livenessMsgBz, err = json.Marshal(msg); err != nil {
+				panic(err) // this will crash the node as panics are not recovered
+			}
+		}
+		return livenessMsgBz
+	})
+	deliverScheduledCallbacks(ctx, k)
 }
 
 // EndBlocker ABCI end block callback. Does not modify the validator set
@@ -70,24 +103,143 @@ func EndBlocker(ctx sdk.Context, k abciKeeper) []abci.ValidatorUpdate {
 	if err != nil {
 		panic(err) // this will break consensus
 	}
-	k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeEndBlock, abciContractCallback(ctx, k, msgBz))
+	CallPrivilegedContracts(ctx, k, types.PrivilegeTypeEndBlock, msgBz)
 	return nil
 }
 
-// returns safe method to send the message via sudo to the privileged contract
-func abciContractCallback(parentCtx sdk.Context, k abciKeeper, msgBz []byte) func(pos uint8, contractAddr sdk.AccAddress) bool {
+// returns the last commit votes and the proposer of the block
+func newLiveness(b abci.RequestBeginBlock) *contract.Liveness {
+	votes := make([]contract.VoteInfo, len(b.LastCommitInfo.Votes))
+	for i, v := range b.LastCommitInfo.Votes {
+		votes[i] = contract.VoteInfo{
+			Validator: contract.Validator{
+				Address: v.Validator.Address,
+				Power:   convUint64(v.Validator.Power),
+			},
+			Signed: v.SignedLastBlock,
+		}
+	}
+	return &contract.Liveness{
+		ProposerAddress: b.Header.ProposerAddress,
+		Votes:           votes,
+	}
+}
+
+// demotes all privileged contracts with a reached expiry. The demotion callback is gas bounded and can not block the
+// demotion. A failed demotion is not committed and retried in the next block.
+func demoteExpiredPrivileged(parentCtx sdk.Context, k abciKeeper) {
 	logger := keeper.ModuleLogger(parentCtx)
-	return func(pos uint8, contractAddr sdk.AccAddress) bool {
+	for _, contractAddr := range k.ExpiredPrivileged(parentCtx) {
+		func() {
+			// any panic will crash the node, so we are better taking care of them here
+			defer RecoverToLog(logger, contractAddr)()
+
+			ctx, commit := parentCtx.CacheContext()
+			if err := k.DemoteExpiredPrivileged(ctx, contractAddr); err != nil {
+				logger.Error("failed to demote expired privileged contract", "cause", err, "contract-address", contractAddr.String())
+				return // return without commit
+			}
+			commit()
+			parentCtx.EventManager().EmitEvents(ctx.EventManager().Events())
+		}()
+	}
+}
+
+// delivers all scheduled callbacks that are due. The callback is completed before delivery so that the contract
+// can cancel a recurring callback or schedule a new one within the callback.
+func deliverScheduledCallbacks(ctx sdk.Context, k abciKeeper) {
+	logger := keeper.ModuleLogger(ctx)
+	var succeeded, failed []sdk.AccAddress
+	for _, c := range k.DueScheduledCallbacks(ctx) {
+		contractAddr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			panic(err) // this will crash the node as the address is validated on store
+		}
+		if k.GetScheduledCallback(ctx, contractAddr, c.ID) == nil {
+			continue // canceled by an earlier callback
+		}
+		if err := k.CompleteScheduledCallback(ctx, c); err != nil {
+			logger.Error("failed to complete scheduled callback", "cause", err, "contract-address", c.ContractAddress, "id", c.ID)
+			continue
+		}
+		msgBz, err := json.Marshal(contract.TgradeSudoMsg{ScheduledCallback: &contract.ScheduledCallback{ID: c.ID, Payload: c.Payload}})
+		if err != nil {
+			panic(err) // this will crash the node as panics are not recovered
+		}
+		if abciContractCallback(ctx, k, types.PrivilegeTypeScheduler, msgBz)(0, contractAddr) {
+			succeeded = append(succeeded, contractAddr)
+		} else {
+			failed = append(failed, contractAddr)
+		}
+	}
+	trackCallbackResults(ctx, k, types.PrivilegeTypeScheduler, succeeded, failed)
+}
+
+// CallPrivilegedContracts sends the message to all contracts registered for the privilege type and tracks the
+// consecutive failures. Each contract is called in an isolated cache context.
+func CallPrivilegedContracts(ctx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, msgBz []byte) {
+	callPrivilegedContracts(ctx, k, privilegeType, func(sdk.AccAddress) []byte { return msgBz })
+}
+
+// sends the message returned for the contract to all contracts registered for the privilege type and tracks
+// the consecutive failures.
+func callPrivilegedContracts(ctx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, msgFn func(contractAddr sdk.AccAddress) []byte) {
+	var succeeded, failed []sdk.AccAddress
+	k.IteratePrivilegedContractsByType(ctx, privilegeType, func(pos uint8, contractAddr sdk.AccAddress) bool {
+		if abciContractCallback(ctx, k, privilegeType, msgFn(contractAddr))(pos, contractAddr) {
+			succeeded = append(succeeded, contractAddr)
+		} else {
+			failed = append(failed, contractAddr)
+		}
+		return false
+	})
+	trackCallbackResults(ctx, k, privilegeType, succeeded, failed)
+}
+
+// resets the consecutive failures of the succeeded contracts and tracks a failure for the failed ones.
+// A contract with any failed callback in the block counts a single failure and is not reset.
+// Failures are tracked after the iteration so that a privilege can be released without modifying the iterated index.
+func trackCallbackResults(ctx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, succeeded, failed []sdk.AccAddress) {
+	failedSet := make(map[string]struct{}, len(failed))
+	for _, contractAddr := range failed {
+		failedSet[string(contractAddr)] = struct{}{}
+	}
+	resetSet := make(map[string]struct{}, len(succeeded))
+	for _, contractAddr := range succeeded {
+		if _, ok := failedSet[string(contractAddr)]; ok {
+			continue
+		}
+		if _, ok := resetSet[string(contractAddr)]; ok {
+			continue
+		}
+		resetSet[string(contractAddr)] = struct{}{}
+		k.ResetCallbackFailures(ctx, privilegeType, contractAddr)
+	}
+	for _, contractAddr := range failed {
+		if _, ok := failedSet[string(contractAddr)]; !ok {
+			continue // already tracked
+		}
+		delete(failedSet, string(contractAddr))
+		k.TrackCallbackFailure(ctx, privilegeType, contractAddr)
+	}
+}
+
+// returns safe method to send the message via sudo to the privileged contract. The method returns true on success.
+func abciContractCallback(parentCtx sdk.Context, k PrivilegedCallbackKeeper, privilegeType types.PrivilegeType, msgBz []byte) func(pos uint8, contractAddr sdk.AccAddress) bool {
+	logger := keeper.ModuleLogger(parentCtx)
+	return func(pos uint8, contractAddr sdk.AccAddress) (success bool) {
 		// any panic will crash the node, so we are better taking care of them here
 		defer RecoverToLog(logger, contractAddr)()
 
-		logger.Debug("privileged contract callback", "type", types.PrivilegeTypeEndBlock.String(), "msg", string(msgBz))
+		logger.Debug("privileged contract callback", "type", privilegeType.String(), "msg", string(msgBz))
 		ctx, commit := parentCtx.CacheContext()
+		ctx, gasLimit := WithCallbackGasLimit(ctx, k, privilegeType, contractAddr)
+		defer RecoverOutOfGasToEvent(parentCtx, privilegeType, contractAddr, gasLimit)()
 
 		if _, err := k.Sudo(ctx, contractAddr, msgBz); err != nil {
 			logger.Error(
 				"abci callback to privileged contract failed",
-				"type", types.PrivilegeTypeEndBlock.String(),
+				"type", privilegeType.String(),
 				"cause", err,
 				"contract-address", contractAddr,
 				"position", pos,
@@ -95,7 +247,48 @@ func abciContractCallback(parentCtx sdk.Context, k abciKeeper, msgBz []byte) fun
 			return false // return without commit
 		}
 		commit()
-		return false
+		return true
+	}
+}
+
+// callbackGasLimitSource provides the gas limits for privileged contract callbacks
+type callbackGasLimitSource interface {
+	GetCallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64
+}
+
+// WithCallbackGasLimit returns a context with a limited gas meter and the limit when a gas limit applies for the callback.
+// The original context and 0 are returned otherwise.
+func WithCallbackGasLimit(ctx sdk.Context, k callbackGasLimitSource, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) (sdk.Context, uint64) {
+	limit := k.GetCallbackGasLimit(ctx, privilegeType, contractAddr)
+	if limit == 0 {
+		return ctx, 0
+	}
+	return ctx.WithGasMeter(sdk.NewGasMeter(limit)), limit
+}
+
+// RecoverOutOfGasToEvent catches out of gas panics and emits an event to the parent context.
+// Any other panic is passed through.
+func RecoverOutOfGasToEvent(parentCtx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, gasLimit uint64) func() {
+	return func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+			panic(r)
+		}
+		keeper.ModuleLogger(parentCtx).Error(
+			"privileged contract callback out of gas",
+			"type", privilegeType.String(),
+			"contract-address", contractAddr.String(),
+			"gas-limit", gasLimit,
+		)
+		parentCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallbackOutOfGas,
+			sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+			sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+		))
 	}
 }
 
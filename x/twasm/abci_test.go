@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/confio/tgrade/x/twasm/keeper"
 	"github.com/confio/tgrade/x/twasm/types"
@@ -23,6 +24,8 @@ import (
 func TestBeginBlock(t *testing.T) {
 	var (
 		capturedSudoCalls []tuple
+		capturedDemotions []sdk.AccAddress
+		capturedCompleted []uint64
 		myAddr            = keeper.RandomAddress(t)
 		myOtherAddr       = keeper.RandomAddress(t)
 		myOtherAddrBase64 = make([]byte, base64.StdEncoding.EncodedLen(address.Len))
@@ -36,6 +39,9 @@ func TestBeginBlock(t *testing.T) {
 		expSudoCalls []tuple
 		expPanic     bool
 		expCommitted []bool
+		expDemotions []sdk.AccAddress
+		// IDs of completed scheduled callbacks
+		expCompletions []uint64
 	}{
 		"single callback": {
 			setup: func(m *MockSudoer) {
@@ -56,11 +62,107 @@ func TestBeginBlock(t *testing.T) {
 			},
 			expCommitted: []bool{true, true},
 		},
+		"expired contract demoted": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr)
+				m.ExpiredPrivilegedFn = func(ctx sdk.Context) []sdk.AccAddress {
+					return []sdk.AccAddress{myOtherAddr}
+				}
+				m.DemoteExpiredPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedDemotions = append(capturedDemotions, contractAddr)
+					return nil
+				}
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"begin_block":{"evidence":[]}}`)}},
+			expCommitted: []bool{true, true},
+			expDemotions: []sdk.AccAddress{myOtherAddr},
+		},
+		"expired contract demotion fails": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr)
+				m.ExpiredPrivilegedFn = func(ctx sdk.Context) []sdk.AccAddress {
+					return []sdk.AccAddress{myOtherAddr}
+				}
+				m.DemoteExpiredPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedDemotions = append(capturedDemotions, contractAddr)
+					return errors.New("testing")
+				}
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"begin_block":{"evidence":[]}}`)}},
+			expCommitted: []bool{false, true},
+			expDemotions: []sdk.AccAddress{myOtherAddr},
+		},
 		"no callback": {
 			setup: func(m *MockSudoer) {
 				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock)
 			},
 		},
+		"scheduled callback delivered": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock)
+				m.DueScheduledCallbacksFn = func(ctx sdk.Context) []types.ScheduledCallback {
+					return []types.ScheduledCallback{{ID: 1, ContractAddress: myOtherAddr.String(), Height: 1, Payload: []byte("foo")}}
+				}
+				m.GetScheduledCallbackFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback {
+					return &types.ScheduledCallback{ID: id}
+				}
+				m.CompleteScheduledCallbackFn = func(ctx sdk.Context, callback types.ScheduledCallback) error {
+					capturedCompleted = append(capturedCompleted, callback.ID)
+					return nil
+				}
+			},
+			expSudoCalls:   []tuple{{addr: myOtherAddr, msg: []byte(`{"scheduled_callback":{"id":1,"payload":"Zm9v"}}`)}},
+			expCommitted:   []bool{true},
+			expCompletions: []uint64{1},
+		},
+		"canceled scheduled callback skipped": {
+			setup: func(m *MockSudoer) {
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock)
+				m.DueScheduledCallbacksFn = func(ctx sdk.Context) []types.ScheduledCallback {
+					return []types.ScheduledCallback{{ID: 1, ContractAddress: myOtherAddr.String(), Height: 1}}
+				}
+				m.GetScheduledCallbackFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback {
+					return nil
+				}
+			},
+		},
+		"with liveness - opted in contract only": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr, myOtherAddr)
+				m.IncludesLivenessFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					require.Equal(t, types.PrivilegeTypeBeginBlock, privilegeType)
+					return contractAddr.Equals(myOtherAddr)
+				}
+			},
+			src: abci.RequestBeginBlock{
+				Header: tmproto.Header{ProposerAddress: myOtherAddr},
+				LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{
+					{Validator: abci.Validator{Address: myOtherAddr, Power: 2}, SignedLastBlock: true},
+					{Validator: abci.Validator{Address: myAddr, Power: 1}, SignedLastBlock: false},
+				}},
+			},
+			expSudoCalls: []tuple{
+				{addr: myAddr, msg: []byte(`{"begin_block":{"evidence":[]}}`)},
+				{addr: myOtherAddr, msg: []byte(fmt.Sprintf(`{"begin_block":{"evidence":[],"liveness":{"proposer_address":%q,"votes":[{"validator":{"address":%q,"power":2},"signed":true},{"validator":{"address":%q,"power":1},"signed":false}]}}}`,
+					myOtherAddrBase64, myOtherAddrBase64, base64.StdEncoding.EncodeToString(myAddr)))},
+			},
+			expCommitted: []bool{true, true},
+		},
+		"with liveness - empty commit": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = captureSudos(&capturedSudoCalls)
+				m.IteratePrivilegedContractsByTypeFn = iterateContractsFn(t, types.PrivilegeTypeBeginBlock, myAddr)
+				m.IncludesLivenessFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+					return true
+				}
+			},
+			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"begin_block":{"evidence":[],"liveness":{"proposer_address":null,"votes":[]}}}`)}},
+			expCommitted: []bool{true},
+		},
 		"with evidence - light client": {
 			setup: func(m *MockSudoer) {
 				m.SudoFn = captureSudos(&capturedSudoCalls)
@@ -149,12 +251,13 @@ func TestBeginBlock(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			capturedSudoCalls = nil
+			capturedSudoCalls, capturedDemotions, capturedCompleted = nil, nil, nil
 			mock := MockSudoer{}
 			spec.setup(&mock)
 			commitMultistore := mockCommitMultiStore{}
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
-				WithMultiStore(&commitMultistore)
+				WithMultiStore(&commitMultistore).
+				WithEventManager(sdk.NewEventManager())
 
 			// when
 			if spec.expPanic {
@@ -176,6 +279,8 @@ func TestBeginBlock(t *testing.T) {
 			for i, v := range spec.expCommitted {
 				assert.Equal(t, v, commitMultistore.committed[i], "tx number %d", i)
 			}
+			assert.Equal(t, spec.expDemotions, capturedDemotions)
+			assert.Equal(t, spec.expCompletions, capturedCompleted)
 		})
 	}
 }
@@ -183,6 +288,8 @@ func TestBeginBlock(t *testing.T) {
 func TestEndBlock(t *testing.T) {
 	var (
 		capturedSudoCalls []tuple
+		capturedFailures  []sdk.AccAddress
+		capturedResets    []sdk.AccAddress
 		myAddr            = keeper.RandomAddress(t)
 		myOtherAddr       = keeper.RandomAddress(t)
 	)
@@ -192,6 +299,9 @@ func TestEndBlock(t *testing.T) {
 		expSudoCalls []tuple
 		expPanic     bool
 		expCommitted []bool
+		expEvents    sdk.Events
+		expFailures  []sdk.AccAddress
+		expResets    []sdk.AccAddress
 	}{
 		"end block - single callback": {
 			setup: func(m *MockSudoer) {
@@ -200,6 +310,7 @@ func TestEndBlock(t *testing.T) {
 			},
 			expSudoCalls: []tuple{{addr: myAddr, msg: []byte(`{"end_block":{}}`)}},
 			expCommitted: []bool{true},
+			expResets:    []sdk.AccAddress{myAddr},
 		},
 		"end block - multiple callbacks": {
 			setup: func(m *MockSudoer) {
@@ -211,6 +322,7 @@ func TestEndBlock(t *testing.T) {
 				{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)},
 			},
 			expCommitted: []bool{true, true},
+			expResets:    []sdk.AccAddress{myAddr, myOtherAddr},
 		},
 		"no callback": {
 			setup: func(m *MockSudoer) {
@@ -229,6 +341,8 @@ func TestEndBlock(t *testing.T) {
 			},
 			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)}},
 			expCommitted: []bool{false, true},
+			expFailures:  []sdk.AccAddress{myAddr},
+			expResets:    []sdk.AccAddress{myOtherAddr},
 		},
 		"end block - sudo panic handled": {
 			setup: func(m *MockSudoer) {
@@ -242,16 +356,57 @@ func TestEndBlock(t *testing.T) {
 			},
 			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)}},
 			expCommitted: []bool{false, true},
+			expFailures:  []sdk.AccAddress{myAddr},
+			expResets:    []sdk.AccAddress{myOtherAddr},
+		},
+		"end block - out of gas handled": {
+			setup: func(m *MockSudoer) {
+				m.SudoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					if contractAddress.Equals(myAddr) {
+						ctx.GasMeter().ConsumeGas(101, "testing")
+					}
+					return captureSudos(&capturedSudoCalls)(ctx, contractAddress, msg)
+				}
+				m.IteratePrivilegedContractsByTypeFn = endBlockTypeIterateContractsFn(t, []sdk.AccAddress{myAddr, myOtherAddr}, nil)
+				m.GetCallbackGasLimitFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+					require.Equal(t, types.PrivilegeTypeEndBlock, privilegeType)
+					if contractAddr.Equals(myAddr) {
+						return 100
+					}
+					return 0
+				}
+			},
+			expSudoCalls: []tuple{{addr: myOtherAddr, msg: []byte(`{"end_block":{}}`)}},
+			expCommitted: []bool{false, true},
+			expFailures:  []sdk.AccAddress{myAddr},
+			expResets:    []sdk.AccAddress{myOtherAddr},
+			expEvents: sdk.Events{sdk.NewEvent(
+				"privileged_callback_out_of_gas",
+				sdk.NewAttribute("_contract_address", myAddr.String()),
+				sdk.NewAttribute("privilege_type", "end_blocker"),
+				sdk.NewAttribute("gas_limit", "100"),
+			)},
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			capturedSudoCalls = nil
-			mock := MockSudoer{}
+			capturedSudoCalls, capturedFailures, capturedResets = nil, nil, nil
+			mock := MockSudoer{
+				TrackCallbackFailureFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+					require.Equal(t, types.PrivilegeTypeEndBlock, privilegeType)
+					capturedFailures = append(capturedFailures, contractAddr)
+				},
+				ResetCallbackFailuresFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+					require.Equal(t, types.PrivilegeTypeEndBlock, privilegeType)
+					capturedResets = append(capturedResets, contractAddr)
+				},
+			}
 			spec.setup(&mock)
 			commitMultistore := mockCommitMultiStore{}
+			em := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
-				WithMultiStore(&commitMultistore)
+				WithMultiStore(&commitMultistore).
+				WithEventManager(em)
 
 			// when
 			if spec.expPanic {
@@ -274,6 +429,11 @@ func TestEndBlock(t *testing.T) {
 			for i, v := range spec.expCommitted {
 				assert.Equal(t, v, commitMultistore.committed[i], "tx number %d", i)
 			}
+			// and events emitted
+			assert.ElementsMatch(t, spec.expEvents, em.Events())
+			// and failures tracked
+			assert.Equal(t, spec.expFailures, capturedFailures)
+			assert.Equal(t, spec.expResets, capturedResets)
 		})
 	}
 }
@@ -318,6 +478,15 @@ func captureSudos(capturedSudoCalls *[]tuple) func(ctx sdk.Context, contractAddr
 type MockSudoer struct {
 	SudoFn                             func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	IteratePrivilegedContractsByTypeFn func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	GetCallbackGasLimitFn              func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64
+	TrackCallbackFailureFn             func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+	ResetCallbackFailuresFn            func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress)
+	ExpiredPrivilegedFn                func(ctx sdk.Context) []sdk.AccAddress
+	DemoteExpiredPrivilegedFn          func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	DueScheduledCallbacksFn            func(ctx sdk.Context) []types.ScheduledCallback
+	GetScheduledCallbackFn             func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback
+	CompleteScheduledCallbackFn        func(ctx sdk.Context, callback types.ScheduledCallback) error
+	IncludesLivenessFn                 func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool
 }
 
 func (m MockSudoer) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
@@ -334,6 +503,69 @@ func (m MockSudoer) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeT
 	m.IteratePrivilegedContractsByTypeFn(ctx, privilegeType, cb)
 }
 
+func (m MockSudoer) GetCallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+	if m.GetCallbackGasLimitFn == nil {
+		return 0 // no limit
+	}
+	return m.GetCallbackGasLimitFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.TrackCallbackFailureFn == nil {
+		return
+	}
+	m.TrackCallbackFailureFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	if m.ResetCallbackFailuresFn == nil {
+		return
+	}
+	m.ResetCallbackFailuresFn(ctx, privilegeType, contractAddr)
+}
+
+func (m MockSudoer) ExpiredPrivileged(ctx sdk.Context) []sdk.AccAddress {
+	if m.ExpiredPrivilegedFn == nil {
+		return nil
+	}
+	return m.ExpiredPrivilegedFn(ctx)
+}
+
+func (m MockSudoer) DemoteExpiredPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if m.DemoteExpiredPrivilegedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DemoteExpiredPrivilegedFn(ctx, contractAddr)
+}
+
+func (m MockSudoer) DueScheduledCallbacks(ctx sdk.Context) []types.ScheduledCallback {
+	if m.DueScheduledCallbacksFn == nil {
+		return nil
+	}
+	return m.DueScheduledCallbacksFn(ctx)
+}
+
+func (m MockSudoer) GetScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback {
+	if m.GetScheduledCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetScheduledCallbackFn(ctx, contractAddr, id)
+}
+
+func (m MockSudoer) CompleteScheduledCallback(ctx sdk.Context, callback types.ScheduledCallback) error {
+	if m.CompleteScheduledCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.CompleteScheduledCallbackFn(ctx, callback)
+}
+
+func (m MockSudoer) IncludesLiveness(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	if m.IncludesLivenessFn == nil {
+		return false
+	}
+	return m.IncludesLivenessFn(ctx, privilegeType, contractAddr)
+}
+
 type mockCommitMultiStore struct {
 	sdk.CommitMultiStore
 	committed []bool
@@ -352,3 +584,48 @@ type mockCMS struct {
 func (m *mockCMS) Write() {
 	*m.committed = true
 }
+
+func TestDeliverScheduledCallbacksTracksFailuresOncePerContract(t *testing.T) {
+	myAddr, myOtherAddr := keeper.RandomAddress(t), keeper.RandomAddress(t)
+	var capturedFailures, capturedResets []sdk.AccAddress
+	mock := MockSudoer{
+		SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			if contractAddress.Equals(myAddr) {
+				return nil, errors.New("testing")
+			}
+			return nil, nil
+		},
+		DueScheduledCallbacksFn: func(ctx sdk.Context) []types.ScheduledCallback {
+			return []types.ScheduledCallback{
+				{ID: 1, ContractAddress: myAddr.String(), Height: 1},
+				{ID: 2, ContractAddress: myOtherAddr.String(), Height: 1},
+				{ID: 3, ContractAddress: myAddr.String(), Height: 1},
+				{ID: 4, ContractAddress: myOtherAddr.String(), Height: 1},
+			}
+		},
+		GetScheduledCallbackFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback {
+			return &types.ScheduledCallback{ID: id}
+		},
+		CompleteScheduledCallbackFn: func(ctx sdk.Context, callback types.ScheduledCallback) error {
+			return nil
+		},
+		TrackCallbackFailureFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+			require.Equal(t, types.PrivilegeTypeScheduler, privilegeType)
+			capturedFailures = append(capturedFailures, contractAddr)
+		},
+		ResetCallbackFailuresFn: func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+			require.Equal(t, types.PrivilegeTypeScheduler, privilegeType)
+			capturedResets = append(capturedResets, contractAddr)
+		},
+	}
+	ctx := sdk.Context{}.WithLogger(log.TestingLogger()).
+		WithMultiStore(&mockCommitMultiStore{}).
+		WithEventManager(sdk.NewEventManager())
+
+	// when
+	deliverScheduledCallbacks(ctx, mock)
+
+	// then
+	assert.Equal(t, []sdk.AccAddress{myAddr}, capturedFailures)
+	assert.Equal(t, []sdk.AccAddress{myOtherAddr}, capturedResets)
+}
@@ -7,6 +7,7 @@ import (
 	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/confio/tgrade/x/twasm/types"
@@ -24,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdShowPrivilegedContracts(),
 		GetCmdListPrivilegedContracts(),
+		GetCmdListCallbackFailures(),
+		GetCmdListScheduledCallbacks(),
+		GetCmdShowMintedSupply(),
 	)
 	// add all wasmd queries
 	queryCmd.AddCommand(wasmcli.GetQueryCmd().Commands()...)
@@ -93,3 +97,106 @@ func GetCmdListPrivilegedContracts() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdListCallbackFailures lists the failure counters of the privileged contract callbacks
+func GetCmdListCallbackFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "callback-failures",
+		Short:   "List consecutive failures of privileged contract callbacks",
+		Long:    "List the number of consecutive failed begin/end block callbacks for all privileged contracts with failures",
+		Aliases: []string{"lcf"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CallbackFailures(
+				cmd.Context(),
+				&types.QueryCallbackFailuresRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListScheduledCallbacks lists the pending scheduled callbacks of a contract
+func GetCmdListScheduledCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-callbacks <contract_address>",
+		Short:   "List pending scheduled callbacks of a contract",
+		Long:    "List the pending callbacks that a contract with the scheduler privilege has scheduled",
+		Aliases: []string{"schedules", "lsc"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledCallbacks(
+				cmd.Context(),
+				&types.QueryScheduledCallbacksRequest{
+					ContractAddress: args[0],
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled callbacks")
+	return cmd
+}
+
+// GetCmdShowMintedSupply shows the running totals of tokens minted by a contract
+func GetCmdShowMintedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "minted-supply <contract_address>",
+		Short:   "Show the tokens minted by a contract",
+		Long:    "Show the running totals of tokens minted by a contract with the token minter privilege per denom",
+		Aliases: []string{"minted", "ms"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintedSupply(
+				cmd.Context(),
+				&types.QueryMintedSupplyRequest{
+					ContractAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -21,6 +21,9 @@ type TgradeSudoMsg struct {
 	Export *struct{} `json:"export,omitempty"`
 	// Import genesis state
 	Import *wasmtypes.RawContractMessage `json:"import,omitempty"`
+
+	// ScheduledCallback is delivered in the begin block when a callback scheduled by the contract is due
+	ScheduledCallback *ScheduledCallback `json:"scheduled_callback,omitempty"`
 }
 
 // PrivilegeChangeMsg is called on a contract when it is made privileged or demoted
@@ -33,9 +36,32 @@ type PrivilegeChangeMsg struct {
 	Demoted *struct{} `json:"demoted,omitempty"`
 }
 
+// ScheduledCallback contains the ID and payload of the scheduled callback that is due
+type ScheduledCallback struct {
+	ID      uint64 `json:"id"`
+	Payload []byte `json:"payload,omitempty"`
+}
+
 // BeginBlock is delivered every block if the contract is currently registered for Begin Block
 type BeginBlock struct {
 	Evidence []Evidence `json:"evidence"` // This is key for slashing - let's figure out a standard for these types
+	// Liveness is only set for contracts that opted in on privilege registration
+	Liveness *Liveness `json:"liveness,omitempty"`
+}
+
+// Liveness contains the votes of the last commit and the proposer of the current block
+type Liveness struct {
+	// ProposerAddress is the address of the validator that proposed the current block
+	ProposerAddress []byte `json:"proposer_address"`
+	// Votes are the votes of the validators for the last block
+	Votes []VoteInfo `json:"votes"`
+}
+
+// VoteInfo See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L348-L352
+type VoteInfo struct {
+	Validator Validator `json:"validator"`
+	// Signed is true when the validator signed the last block
+	Signed bool `json:"signed"`
 }
 
 type EvidenceType string
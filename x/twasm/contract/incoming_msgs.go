@@ -3,6 +3,7 @@ package contract
 import (
 	"encoding/json"
 	"sort"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
@@ -24,9 +25,13 @@ type TgradeMsg struct {
 	Privilege          *PrivilegeMsg          `json:"privilege,omitempty"`
 	ExecuteGovProposal *ExecuteGovProposal    `json:"execute_gov_proposal,omitempty"`
 	MintTokens         *MintTokens            `json:"mint_tokens,omitempty"`
+	BurnTokens         *BurnTokens            `json:"burn_tokens,omitempty"`
 	ConsensusParams    *ConsensusParamsUpdate `json:"consensus_params,omitempty"`
 	Delegate           *Delegate              `json:"delegate,omitempty"`
 	Undelegate         *Undelegate            `json:"undelegate,omitempty"`
+	Schedule           *Schedule              `json:"schedule,omitempty"`
+	CancelSchedule     *CancelSchedule        `json:"cancel_schedule,omitempty"`
+	FeeDenomRatios     *FeeDenomRatios        `json:"fee_denom_ratios,omitempty"`
 }
 
 // UnmarshalWithAny from json to Go objects with cosmos-sdk Any types that have their objects/ interfaces unpacked and
@@ -45,6 +50,12 @@ func (p *TgradeMsg) UnmarshalWithAny(bz []byte, unpacker codectypes.AnyUnpacker)
 type PrivilegeMsg struct {
 	Request types.PrivilegeType `json:"request,omitempty"`
 	Release types.PrivilegeType `json:"release,omitempty"`
+	// GasLimit optional gas limit for the abci callbacks of the requested privilege.
+	// It overrides the default limit for the privilege type and can not exceed the max registered callback gas limit param.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+	// IncludeLiveness opts in to the last commit votes and proposer address in the begin block callback.
+	// Only supported for the begin_blocker privilege.
+	IncludeLiveness bool `json:"include_liveness,omitempty"`
 }
 
 // ExecuteGovProposal will execute an approved proposal in the Cosmos SDK "Gov Router".
@@ -315,6 +326,12 @@ type MintTokens struct {
 	RecipientAddr string `json:"recipient"`
 }
 
+// BurnTokens custom message to burn native tokens owned by the contract.
+type BurnTokens struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
 // ConsensusParamsUpdate subset of tendermint params.
 // See https://github.com/tendermint/tendermint/blob/v0.34.8/proto/tendermint/abci/types.proto#L282-L289
 type ConsensusParamsUpdate struct {
@@ -334,6 +351,87 @@ type Undelegate struct {
 	RecipientAddr string           `json:"recipient"`
 }
 
+// Schedule a sudo callback to the contract at a future block height or time.
+// The ID of the scheduled callback is returned in the response data.
+type Schedule struct {
+	// AtHeight block height from which on the callback is due. Either height or time must be set
+	AtHeight uint64 `json:"at_height,omitempty"`
+	// AtTime block time in seconds since unix epoch from which on the callback is due
+	AtTime uint64 `json:"at_time,omitempty"`
+	// Interval optional for recurring callbacks. In blocks for height or in seconds for time
+	Interval uint64 `json:"interval,omitempty"`
+	// Payload is passed to the contract with the callback
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// ValidateBasic check basics
+func (s Schedule) ValidateBasic() error {
+	switch {
+	case s.AtHeight == 0 && s.AtTime == 0:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "at height or at time")
+	case s.AtHeight != 0 && s.AtTime != 0:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "either at height or at time must be set")
+	case s.AtTime > uint64(types.MaxScheduledCallbackTime.Unix()):
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "at time exceeds max")
+	case s.AtTime != 0 && s.Interval > types.MaxScheduledCallbackInterval:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "interval exceeds max duration")
+	case s.AtHeight > types.MaxScheduledCallbackHeight:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "at height exceeds max")
+	case s.AtHeight != 0 && s.Interval > types.MaxScheduledCallbackHeight:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "interval exceeds max height")
+	}
+	return nil
+}
+
+// ScheduledCallback converts the message into the persistent callback type without ID and contract address
+func (s Schedule) ScheduledCallback() types.ScheduledCallback {
+	r := types.ScheduledCallback{
+		Height:   s.AtHeight,
+		Interval: s.Interval,
+		Payload:  s.Payload,
+	}
+	if s.AtTime != 0 {
+		t := time.Unix(int64(s.AtTime), 0).UTC()
+		r.Time = &t
+	}
+	return r
+}
+
+// ScheduleResponse is returned in the data field for a schedule message
+type ScheduleResponse struct {
+	ID uint64 `json:"id"`
+}
+
+// CancelSchedule removes a pending callback of the contract
+type CancelSchedule struct {
+	ID uint64 `json:"id"`
+}
+
+// FeeDenomRatios replaces the ratios of the denoms that are accepted for fees in the globalfee module
+type FeeDenomRatios struct {
+	Ratios []DenomRatio `json:"ratios"`
+}
+
+// DenomRatio is the amount of a denom that is accepted for one unit of the base denom
+type DenomRatio struct {
+	Denom string `json:"denom"`
+	// Ratio as decimal string, for example "0.5"
+	Ratio string `json:"ratio"`
+}
+
+// DecCoins converts the ratios to sorted decimal coins
+func (f FeeDenomRatios) DecCoins() (sdk.DecCoins, error) {
+	result := make(sdk.DecCoins, len(f.Ratios))
+	for i, r := range f.Ratios {
+		ratio, err := sdk.NewDecFromStr(r.Ratio)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "ratio %q: %s", r.Ratio, err)
+		}
+		result[i] = sdk.DecCoin{Denom: r.Denom, Amount: ratio}
+	}
+	return result.Sort(), nil
+}
+
 // ValidateBasic check basics
 func (c ConsensusParamsUpdate) ValidateBasic() error {
 	if c.Block == nil && c.Evidence == nil {
@@ -97,6 +97,15 @@ func TestGetProposalContent(t *testing.T) {
 				Contract:    "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
 			},
 		},
+		"promote to privileged contract with allowed privileges": {
+			src: `{"execute_gov_proposal":{"title":"foo", "description":"bar", "proposal":{"promote_to_privileged_contract":{"contract":"cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09", "allowed_privileges":["begin_blocker","token_minter"]}}}}`,
+			expGovProposal: &types.PromoteToPrivilegedContractProposal{
+				Title:             "foo",
+				Description:       "bar",
+				Contract:          "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+				AllowedPrivileges: []string{"begin_blocker", "token_minter"},
+			},
+		},
 		"demote privileged contract": {
 			src: `{"execute_gov_proposal":{"title":"foo", "description":"bar", "proposal":{"demote_privileged_contract":{"contract":"cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09"}}}}`,
 			expGovProposal: &types.DemotePrivilegedContractProposal{
@@ -105,6 +114,15 @@ func TestGetProposalContent(t *testing.T) {
 				Contract:    "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
 			},
 		},
+		"force demote privileged contract": {
+			src: `{"execute_gov_proposal":{"title":"foo", "description":"bar", "proposal":{"demote_privileged_contract":{"contract":"cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09", "force":true}}}}`,
+			expGovProposal: &types.DemotePrivilegedContractProposal{
+				Title:       "foo",
+				Description: "bar",
+				Contract:    "cosmos1vtg95naqtvf99hj8pe0s9aevy622vt0jmupc09",
+				Force:       true,
+			},
+		},
 		"instantiate contract": {
 			src: `{
   "execute_gov_proposal": {
@@ -348,3 +366,63 @@ func TestConsensusParamsUpdateValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleUnmarshalAndConvert(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	specs := map[string]struct {
+		src    string
+		exp    types.ScheduledCallback
+		expErr *sdkerrors.Error
+	}{
+		"at height": {
+			src: `{"schedule":{"at_height":10,"payload":"Zm9v"}}`,
+			exp: types.ScheduledCallback{Height: 10, Payload: []byte("foo")},
+		},
+		"at time recurring": {
+			src: `{"schedule":{"at_time":1000000000,"interval":60}}`,
+			exp: types.ScheduledCallback{Time: &myTime, Interval: 60},
+		},
+		"height and time": {
+			src:    `{"schedule":{"at_height":10,"at_time":1000000000}}`,
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"empty": {
+			src:    `{"schedule":{}}`,
+			expErr: wasmtypes.ErrEmpty,
+		},
+		"at max time": {
+			src: `{"schedule":{"at_time":253402300799}}`,
+			exp: types.ScheduledCallback{Time: &types.MaxScheduledCallbackTime},
+		},
+		"at time exceeds max": {
+			src:    `{"schedule":{"at_time":253402300800}}`,
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"time interval exceeds max": {
+			src:    `{"schedule":{"at_time":1000000000,"interval":9223372037}}`,
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"at height exceeds max": {
+			src:    `{"schedule":{"at_height":9223372036854775808}}`,
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"height interval exceeds max": {
+			src:    `{"schedule":{"at_height":10,"interval":9223372036854775808}}`,
+			expErr: wasmtypes.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var msg TgradeMsg
+			require.NoError(t, json.Unmarshal([]byte(spec.src), &msg))
+			require.NotNil(t, msg.Schedule)
+			gotErr := msg.Schedule.ValidateBasic()
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, msg.Schedule.ScheduledCallback())
+		})
+	}
+}
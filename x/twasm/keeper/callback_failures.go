@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+// TrackCallbackFailure increments the consecutive failure counter for the contract and privilege type.
+// When the failure threshold in the params is reached, the privilege is released from the contract.
+func (k Keeper) TrackCallbackFailure(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := callbackFailuresKey(privilegeType, contractAddr)
+	failures := uint64(1)
+	if bz := store.Get(key); bz != nil {
+		failures += sdk.BigEndianToUint64(bz)
+	}
+	threshold := k.GetTgradeParams(ctx).CallbackFailureThreshold
+	if threshold == 0 || failures < uint64(threshold) {
+		store.Set(key, sdk.Uint64ToBigEndian(failures))
+		return
+	}
+	if err := k.suspendPrivilege(ctx, privilegeType, contractAddr, failures); err != nil {
+		k.Logger(ctx).Error("failed to suspend privilege", "cause", err, "contractAddr", contractAddr.String(), "type", privilegeType.String())
+		store.Set(key, sdk.Uint64ToBigEndian(failures))
+	}
+}
+
+// ResetCallbackFailures removes the consecutive failure counter for the contract and privilege type.
+func (k Keeper) ResetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := callbackFailuresKey(privilegeType, contractAddr)
+	if store.Has(key) {
+		store.Delete(key)
+	}
+}
+
+// GetCallbackFailures returns the number of consecutive failed callbacks for the contract and privilege type.
+func (k Keeper) GetCallbackFailures(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(callbackFailuresKey(privilegeType, contractAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateCallbackFailures iterates over all failure counters. The callback returns true to stop early
+func (k Keeper) IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), callbackFailuresPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		// cb returns true to stop early
+		if cb(types.PrivilegeType(key[0]), key[1:], sdk.BigEndianToUint64(iter.Value())) {
+			return
+		}
+	}
+}
+
+// suspendPrivilege releases all registrations of the privilege type from the contract
+func (k Keeper) suspendPrivilege(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) error {
+	if err := k.assertCriticalPrivilegeRemains(ctx, privilegeType, contractAddr); err != nil {
+		return err
+	}
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	details.IterateRegisteredPrivileges(func(c types.PrivilegeType, pos uint8) bool {
+		if c != privilegeType {
+			return false
+		}
+		k.removePrivilegeRegistration(ctx, c, pos, contractAddr)
+		details.RemoveRegisteredPrivilege(c, pos)
+		return false
+	})
+	if err := k.setContractDetails(ctx, contractAddr, details); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("Suspend privilege", "contractAddr", contractAddr.String(), "type", privilegeType.String(), "failures", failures)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSuspendPrivilege,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, privilegeType.String()),
+		sdk.NewAttribute(types.AttributeKeyFailures, strconv.FormatUint(failures, 10)),
+	))
+	return nil
+}
+
+// callbackFailuresKey returns the key for the failure counter
+// `<prefix><privilegeType><contractAddr>`
+func callbackFailuresKey(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(callbackFailuresPrefix)
+	r := make([]byte, prefixLen+1+len(contractAddr))
+	copy(r[0:], callbackFailuresPrefix)
+	r[prefixLen] = byte(privilegeType)
+	copy(r[prefixLen+1:], contractAddr)
+	return r
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/twasm/contract"
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestTrackCallbackFailure(t *testing.T) {
+	specs := map[string]struct {
+		privilegeType types.PrivilegeType
+		threshold     uint32
+		failures      int
+		expCounter    uint64
+		expSuspended  bool
+	}{
+		"below threshold": {
+			threshold:  3,
+			failures:   2,
+			expCounter: 2,
+		},
+		"threshold reached": {
+			threshold:    3,
+			failures:     3,
+			expCounter:   0,
+			expSuspended: true,
+		},
+		"threshold disabled": {
+			failures:   5,
+			expCounter: 5,
+		},
+		"last critical privilege not suspended": {
+			privilegeType: types.PrivilegeTypeValidatorSetUpdate,
+			threshold:     3,
+			failures:      3,
+			expCounter:    3,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := NewWasmVMMock()
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			params := types.DefaultTgradeParams()
+			params.CallbackFailureThreshold = spec.threshold
+			k.SetTgradeParams(ctx, params)
+
+			_, contractAddr := seedTestContract(t, ctx, k)
+			k.setPrivilegedFlag(ctx, contractAddr)
+			privilegeType := types.PrivilegeTypeBeginBlock
+			if spec.privilegeType != types.PrivilegeTypeEmpty {
+				privilegeType = spec.privilegeType
+			}
+			h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+			require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: privilegeType}))
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			for i := 0; i < spec.failures; i++ {
+				k.TrackCallbackFailure(ctx, privilegeType, contractAddr)
+			}
+			// then
+			assert.Equal(t, spec.expCounter, k.GetCallbackFailures(ctx, privilegeType, contractAddr))
+			ok, err := k.HasPrivilegedContract(ctx, contractAddr, privilegeType)
+			require.NoError(t, err)
+			assert.Equal(t, !spec.expSuspended, ok)
+			details, err := k.getContractDetails(ctx, contractAddr)
+			require.NoError(t, err)
+			assert.Equal(t, !spec.expSuspended, details.HasRegisteredPrivilege(privilegeType))
+			var suspended bool
+			for _, e := range em.Events() {
+				suspended = suspended || e.Type == types.EventTypeSuspendPrivilege
+			}
+			assert.Equal(t, spec.expSuspended, suspended)
+		})
+	}
+}
+
+func TestResetCallbackFailures(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	myAddr, otherAddr := RandomAddress(t), RandomAddress(t)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeEndBlock, myAddr)
+	k.TrackCallbackFailure(ctx, types.PrivilegeTypeBeginBlock, otherAddr)
+
+	// when
+	k.ResetCallbackFailures(ctx, types.PrivilegeTypeBeginBlock, myAddr)
+
+	// then
+	type counter struct {
+		privilegeType types.PrivilegeType
+		addr          string
+		failures      uint64
+	}
+	var got []counter
+	k.IterateCallbackFailures(ctx, func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool {
+		got = append(got, counter{privilegeType: privilegeType, addr: contractAddr.String(), failures: failures})
+		return false
+	})
+	exp := []counter{
+		{privilegeType: types.PrivilegeTypeBeginBlock, addr: otherAddr.String(), failures: 1},
+		{privilegeType: types.PrivilegeTypeEndBlock, addr: myAddr.String(), failures: 1},
+	}
+	assert.Equal(t, exp, got)
+}
@@ -34,6 +34,7 @@ func InitGenesis(
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "wasm")
 	}
+	keeper.SetTgradeParams(ctx, data.TgradeParams)
 
 	// import privileges from dumped contract infos
 	for i, m := range data.Contracts {
@@ -42,7 +43,7 @@ func InitGenesis(
 		if err := info.ReadExtension(&d); err != nil {
 			return nil, sdkerrors.Wrapf(err, "extension contract: %d, %s", i, m.ContractAddress)
 		}
-		if len(d.RegisteredPrivileges) == 0 {
+		if len(d.RegisteredPrivileges) == 0 && d.Expiry == nil {
 			continue // nothing to do
 		}
 
@@ -82,6 +83,31 @@ func InitGenesis(
 		}
 	}
 
+	// restore scheduled callbacks for contracts with the scheduler privilege
+	for i, c := range data.ScheduledCallbacks {
+		addr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "scheduled callback: %d", i)
+		}
+		ok, err := keeper.HasPrivilegedContract(ctx, addr, types.PrivilegeTypeScheduler)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "scheduled callback: %d", i)
+		}
+		if !ok {
+			return nil, sdkerrors.Wrapf(wasmtypes.ErrInvalidGenesis, "contract %s without scheduler privilege", c.ContractAddress)
+		}
+		if err := keeper.importScheduledCallback(ctx, c); err != nil {
+			return nil, sdkerrors.Wrapf(err, "scheduled callback: %d", i)
+		}
+	}
+
+	// restore minted supply totals
+	for i, m := range data.MintedSupply {
+		if err := keeper.importMintedSupply(ctx, m); err != nil {
+			return nil, sdkerrors.Wrapf(err, "minted supply: %d", i)
+		}
+	}
+
 	// cache requested contracts
 	for _, codeID := range data.PinnedCodeIDs {
 		if err := keeper.contractKeeper.PinCode(ctx, codeID); err != nil {
@@ -129,7 +155,17 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		Contracts: contracts,
 		Sequences: wasmState.Sequences,
 		GenMsgs:   wasmState.GenMsgs,
+
+		TgradeParams: keeper.GetTgradeParams(ctx),
 	}
+	keeper.IterateScheduledCallbacks(ctx, func(c types.ScheduledCallback) bool {
+		genState.ScheduledCallbacks = append(genState.ScheduledCallbacks, c)
+		return false
+	})
+	keeper.IterateMintedSupply(ctx, func(m types.MintedSupply) bool {
+		genState.MintedSupply = append(genState.MintedSupply, m)
+		return false
+	})
 
 	// pinned is stored in code info
 	// privileges are stored contract info
@@ -44,6 +44,8 @@ func TestInitGenesis(t *testing.T) {
 		state          types.GenesisState
 		wasmvm         *wasmtesting.MockWasmer
 		expCallbackReg []registeredCallback
+		expExpired     []sdk.AccAddress
+		expScheduled   []types.ScheduledCallback
 		expErr         bool
 		expVmCalls     vmCalls
 	}{
@@ -90,6 +92,18 @@ func TestInitGenesis(t *testing.T) {
 			wasmvm:         noopMock,
 			expCallbackReg: []registeredCallback{{pos: 1, cbt: types.PrivilegeTypeBeginBlock, addr: genContractAddress(2, 2)}},
 		},
+		"privilege expiry set for dumped contract": {
+			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.Contracts[1].ContractAddress = genContractAddress(2, 2).String()
+				err := state.Contracts[1].ContractInfo.SetExtension(&types.TgradeContractDetails{
+					Expiry: &types.PrivilegeExpiry{Height: 100},
+				})
+				require.NoError(t, err)
+			}),
+			wasmvm:     noopMock,
+			expExpired: []sdk.AccAddress{genContractAddress(2, 2)},
+		},
 		"privilege set for gen msg contract": {
 			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
 				state.PrivilegedContractAddresses = []string{genContractAddress(2, 1).String()}
@@ -131,6 +145,33 @@ func TestInitGenesis(t *testing.T) {
 			wasmvm:         noopMock,
 			expCallbackReg: []registeredCallback{{pos: 1, cbt: types.PrivilegeTypeBeginBlock, addr: genContractAddress(2, 2)}},
 		},
+		"scheduled callbacks from dump": {
+			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.Contracts[1].ContractAddress = genContractAddress(2, 2).String()
+				err := state.Contracts[1].ContractInfo.SetExtension(&types.TgradeContractDetails{
+					RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "scheduler"}},
+				})
+				require.NoError(t, err)
+				state.ScheduledCallbacks = []types.ScheduledCallback{{ID: 3, ContractAddress: genContractAddress(2, 2).String(), Height: 100, Interval: 10}}
+			}),
+			wasmvm:         noopMock,
+			expCallbackReg: []registeredCallback{{pos: 1, cbt: types.PrivilegeTypeScheduler, addr: genContractAddress(2, 2)}},
+			expScheduled:   []types.ScheduledCallback{{ID: 3, ContractAddress: genContractAddress(2, 2).String(), Height: 100, Interval: 10}},
+		},
+		"scheduled callbacks without scheduler privilege": {
+			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
+				state.PrivilegedContractAddresses = nil
+				state.Contracts[1].ContractAddress = genContractAddress(2, 2).String()
+				err := state.Contracts[1].ContractInfo.SetExtension(&types.TgradeContractDetails{
+					RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "begin_blocker"}},
+				})
+				require.NoError(t, err)
+				state.ScheduledCallbacks = []types.ScheduledCallback{{ID: 3, ContractAddress: genContractAddress(2, 2).String(), Height: 100}}
+			}),
+			wasmvm: noopMock,
+			expErr: true,
+		},
 		"invalid contract details from dump": {
 			state: types.GenesisStateFixture(t, func(state *types.GenesisState) {
 				state.PrivilegedContractAddresses = nil
@@ -241,6 +282,13 @@ func TestInitGenesis(t *testing.T) {
 				gotAddr := k.getPrivilegedContract(ctx, x.cbt, x.pos)
 				assert.Equal(t, x.addr, gotAddr)
 			}
+			assert.Equal(t, spec.expExpired, k.ExpiredPrivileged(ctx.WithBlockHeight(100)))
+			var gotScheduled []types.ScheduledCallback
+			k.IterateScheduledCallbacks(ctx, func(c types.ScheduledCallback) bool {
+				gotScheduled = append(gotScheduled, c)
+				return false
+			})
+			assert.Equal(t, spec.expScheduled, gotScheduled)
 		})
 	}
 }
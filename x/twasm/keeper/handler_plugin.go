@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -27,6 +28,11 @@ type TgradeWasmHandlerKeeper interface {
 	removePrivilegeRegistration(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool
 	setContractDetails(ctx sdk.Context, contract sdk.AccAddress, details *types.TgradeContractDetails) error
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	GetTgradeParams(ctx sdk.Context) types.TgradeParams
+	assertCriticalPrivilegeRemains(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error
+	ScheduleCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) (uint64, error)
+	CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error
+	trackMintedTokens(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error
 }
 
 // bankKeeper is a subset of the SDK bank keeper
@@ -34,10 +40,16 @@ type bankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// FeeDenomRatioUpdater sets the fee denom ratios of the globalfee module
+type FeeDenomRatioUpdater interface {
+	SetDenomRatios(ctx sdk.Context, ratios sdk.DecCoins) error
+}
+
 // ConsensusParamsUpdater is a subset of baseapp to store the consensus params
 type ConsensusParamsUpdater interface {
 	GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams
@@ -53,6 +65,7 @@ type TgradeHandler struct {
 	bankKeeper             bankKeeper
 	govRouter              govtypes.Router
 	consensusParamsUpdater ConsensusParamsUpdater
+	feeDenomRatioUpdater   FeeDenomRatioUpdater
 }
 
 // NewTgradeHandler constructor
@@ -62,6 +75,7 @@ func NewTgradeHandler(
 	bankKeeper bankKeeper,
 	consensusParamsUpdater ConsensusParamsUpdater,
 	govRouter govtypes.Router,
+	feeDenomRatioUpdater FeeDenomRatioUpdater,
 ) *TgradeHandler {
 	return &TgradeHandler{
 		cdc:                    cdc,
@@ -69,6 +83,7 @@ func NewTgradeHandler(
 		govRouter:              restrictParamsDecorator(govRouter),
 		bankKeeper:             bankKeeper,
 		consensusParamsUpdater: consensusParamsUpdater,
+		feeDenomRatioUpdater:   feeDenomRatioUpdater,
 	}
 }
 
@@ -97,6 +112,9 @@ func (h TgradeHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 	case tMsg.MintTokens != nil:
 		evts, err := h.handleMintToken(ctx, contractAddr, tMsg.MintTokens)
 		return append(evts, em.Events()...), nil, err
+	case tMsg.BurnTokens != nil:
+		evts, err := h.handleBurnToken(ctx, contractAddr, tMsg.BurnTokens)
+		return append(evts, em.Events()...), nil, err
 	case tMsg.ConsensusParams != nil:
 		evts, err := h.handleConsensusParamsUpdate(ctx, contractAddr, tMsg.ConsensusParams)
 		return append(evts, em.Events()...), nil, err
@@ -106,6 +124,15 @@ func (h TgradeHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 	case tMsg.Undelegate != nil:
 		evts, err := h.handleUndelegate(ctx, contractAddr, tMsg.Undelegate)
 		return append(evts, em.Events()...), nil, err
+	case tMsg.Schedule != nil:
+		data, err := h.handleSchedule(ctx, contractAddr, tMsg.Schedule)
+		return em.Events(), data, err
+	case tMsg.CancelSchedule != nil:
+		err := h.handleCancelSchedule(ctx, contractAddr, tMsg.CancelSchedule)
+		return em.Events(), nil, err
+	case tMsg.FeeDenomRatios != nil:
+		evts, err := h.handleFeeDenomRatios(ctx, contractAddr, tMsg.FeeDenomRatios)
+		return append(evts, em.Events()...), nil, err
 	}
 
 	return nil, nil, sdkerrors.Wrapf(wasmtypes.ErrUnknownMsg, "unknown type: %T", msg)
@@ -123,21 +150,36 @@ func (h TgradeHandler) handlePrivilege(ctx sdk.Context, contractAddr sdk.AccAddr
 		return err
 	}
 
-	register := func(c types.PrivilegeType) error {
+	register := func(c types.PrivilegeType, gasLimit uint64, includeLiveness bool) error {
+		if includeLiveness && c != types.PrivilegeTypeBeginBlock {
+			return sdkerrors.Wrap(wasmtypes.ErrInvalid, "liveness data only supported for begin blocker")
+		}
 		if details.HasRegisteredPrivilege(c) {
 			return nil
 		}
+		if !details.IsPrivilegeAllowed(c) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "privilege not allowed: %s", c.String())
+		}
+		if err := h.keeper.GetTgradeParams(ctx).ValidateRegisteredGasLimit(gasLimit); err != nil {
+			return err
+		}
 		pos, err := h.keeper.appendToPrivilegedContracts(ctx, c, contractAddr)
 		if err != nil {
 			return sdkerrors.Wrap(err, "privilege registration")
 		}
-		details.AddRegisteredPrivilege(c, pos)
+		details.AddRegisteredPrivilegeWithGasLimit(c, pos, gasLimit)
+		if includeLiveness {
+			details.EnableLiveness(c)
+		}
 		return sdkerrors.Wrap(h.keeper.setContractDetails(ctx, contractAddr, &details), "store details")
 	}
 	unregister := func(tp types.PrivilegeType) error {
 		if !details.HasRegisteredPrivilege(tp) {
 			return nil
 		}
+		if err := h.keeper.assertCriticalPrivilegeRemains(ctx, tp, contractAddr); err != nil {
+			return err
+		}
 		details.IterateRegisteredPrivileges(func(c types.PrivilegeType, pos uint8) bool {
 			if c != tp {
 				return false
@@ -152,7 +194,7 @@ func (h TgradeHandler) handlePrivilege(ctx sdk.Context, contractAddr sdk.AccAddr
 	case msg.Release != types.PrivilegeTypeEmpty:
 		return unregister(msg.Release)
 	case msg.Request != types.PrivilegeTypeEmpty:
-		return register(msg.Request)
+		return register(msg.Request, msg.GasLimit, msg.IncludeLiveness)
 	default:
 		return wasmtypes.ErrUnknownMsg
 	}
@@ -195,6 +237,9 @@ func (h TgradeHandler) handleMintToken(ctx sdk.Context, contractAddr sdk.AccAddr
 	if err := token.Validate(); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error()), "mint tokens handler")
 	}
+	if err := h.keeper.trackMintedTokens(ctx, contractAddr, token); err != nil {
+		return nil, err
+	}
 	if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
 		return nil, sdkerrors.Wrap(err, "mint")
 	}
@@ -211,6 +256,82 @@ func (h TgradeHandler) handleMintToken(ctx sdk.Context, contractAddr sdk.AccAddr
 	)}, nil
 }
 
+// handle schedule callback message. Returns the json encoded callback ID in the data
+func (h TgradeHandler) handleSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, msg *contract.Schedule) ([][]byte, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeScheduler); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	id, err := h.keeper.ScheduleCallback(ctx, contractAddr, msg.ScheduledCallback())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "schedule callback")
+	}
+	bz, err := json.Marshal(contract.ScheduleResponse{ID: id})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return [][]byte{bz}, nil
+}
+
+// handle cancel scheduled callback message
+func (h TgradeHandler) handleCancelSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, msg *contract.CancelSchedule) error {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeScheduler); err != nil {
+		return err
+	}
+	return h.keeper.CancelScheduledCallback(ctx, contractAddr, msg.ID)
+}
+
+// handle the fee denom ratios message of the fee oracle
+func (h TgradeHandler) handleFeeDenomRatios(ctx sdk.Context, contractAddr sdk.AccAddress, msg *contract.FeeDenomRatios) ([]sdk.Event, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeFeeOracle); err != nil {
+		return nil, err
+	}
+	ratios, err := msg.DecCoins()
+	if err != nil {
+		return nil, err
+	}
+	if err := h.feeDenomRatioUpdater.SetDenomRatios(ctx, ratios); err != nil {
+		return nil, sdkerrors.Wrap(err, "set denom ratios")
+	}
+	return sdk.Events{sdk.NewEvent(
+		types.EventTypeFeeDenomRatios,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDenomRatios, ratios.String()),
+	)}, nil
+}
+
+// handle burn token message
+func (h TgradeHandler) handleBurnToken(ctx sdk.Context, contractAddr sdk.AccAddress, burn *contract.BurnTokens) ([]sdk.Event, error) {
+	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeTypeTokenBurner); err != nil {
+		return nil, err
+	}
+	amount, ok := sdk.NewIntFromString(burn.Amount)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, burn.Amount+burn.Denom)
+	}
+	token := sdk.Coin{Denom: burn.Denom, Amount: amount}
+	if err := token.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error()), "burn tokens handler")
+	}
+	if !token.IsPositive() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if err := h.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddr, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return nil, sdkerrors.Wrap(err, "send to module")
+	}
+	if err := h.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return nil, sdkerrors.Wrap(err, "burn")
+	}
+
+	return sdk.Events{sdk.NewEvent(
+		types.EventTypeBurnTokens,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, token.String()),
+	)}, nil
+}
+
 // handle the consensus parameters update message
 func (h TgradeHandler) handleConsensusParamsUpdate(ctx sdk.Context, contractAddr sdk.AccAddress, pUpdate *contract.ConsensusParamsUpdate) ([]sdk.Event, error) {
 	if err := h.assertHasPrivilege(ctx, contractAddr, types.PrivilegeConsensusParamChanger); err != nil {
@@ -219,8 +340,14 @@ func (h TgradeHandler) handleConsensusParamsUpdate(ctx sdk.Context, contractAddr
 	if err := pUpdate.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	params := h.consensusParamsUpdater.GetConsensusParams(ctx)
-	h.consensusParamsUpdater.StoreConsensusParams(ctx, mergeConsensusParamsUpdate(params, pUpdate))
+	params := mergeConsensusParamsUpdate(h.consensusParamsUpdater.GetConsensusParams(ctx), pUpdate)
+	if pUpdate.Block != nil {
+		bounds := h.keeper.GetTgradeParams(ctx).ConsensusParamBounds
+		if err := bounds.ValidateBlockParams(params.Block.MaxBytes, params.Block.MaxGas); err != nil {
+			return nil, sdkerrors.Wrap(err, "block params")
+		}
+	}
+	h.consensusParamsUpdater.StoreConsensusParams(ctx, params)
 	return nil, nil
 }
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
@@ -59,6 +60,9 @@ func TestTgradeHandlesDispatchMsg(t *testing.T) {
 			},
 			setup: func(m *handlerTgradeKeeperMock) {
 				setupHandlerKeeperMock(m, withPrivilegeSet(t, types.PrivilegeTypeTokenMinter))
+				m.trackMintedTokensFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+					return nil
+				}
 			},
 			expEvents: sdk.Events{sdk.NewEvent(
 				types.EventTypeMintTokens,
@@ -67,6 +71,19 @@ func TestTgradeHandlesDispatchMsg(t *testing.T) {
 				sdk.NewAttribute(types.AttributeKeyRecipient, otherAddr.String()),
 			)},
 		},
+		"handle burn msg": {
+			src: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"burn_tokens":{"amount":"1","denom":"utgd"}}`),
+			},
+			setup: func(m *handlerTgradeKeeperMock) {
+				setupHandlerKeeperMock(m, withPrivilegeSet(t, types.PrivilegeTypeTokenBurner))
+			},
+			expEvents: sdk.Events{sdk.NewEvent(
+				types.EventTypeBurnTokens,
+				sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "1utgd"),
+			)},
+		},
 		"handle consensus params change msg": {
 			src: wasmvmtypes.CosmosMsg{
 				Custom: []byte(`{"consensus_params":{"block":{"max_gas":100000000}}}`),
@@ -135,7 +152,7 @@ func TestTgradeHandlesDispatchMsg(t *testing.T) {
 			mock := handlerTgradeKeeperMock{}
 			consensusStoreMock := NoopConsensusParamsStoreMock()
 			spec.setup(&mock)
-			h := NewTgradeHandler(cdc, mock, bankMock, consensusStoreMock, govRouter)
+			h := NewTgradeHandler(cdc, mock, bankMock, consensusStoreMock, govRouter, nil)
 			em := sdk.NewEventManager()
 			ctx := sdk.Context{}.WithEventManager(em)
 
@@ -225,6 +242,60 @@ func TestTgradeHandlesPrivilegeMsg(t *testing.T) {
 			},
 			expRegistrations: []registration{{cb: types.PrivilegeTypeBeginBlock, addr: myContractAddr}},
 		},
+		"register begin block with gas limit": {
+			src: contract.PrivilegeMsg{Request: types.PrivilegeTypeBeginBlock, GasLimit: 100},
+			setup: func(m *handlerTgradeKeeperMock) {
+				captureWithMock()(m)
+				m.GetTgradeParamsFn = func(ctx sdk.Context) types.TgradeParams {
+					return types.TgradeParams{MaxRegisteredCallbackGasLimit: 100}
+				}
+			},
+			expDetails: &types.TgradeContractDetails{
+				RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "begin_blocker", GasLimit: 100}},
+			},
+			expRegistrations: []registration{{cb: types.PrivilegeTypeBeginBlock, addr: myContractAddr}},
+		},
+		"register begin block with gas limit above max": {
+			src: contract.PrivilegeMsg{Request: types.PrivilegeTypeBeginBlock, GasLimit: 101},
+			setup: func(m *handlerTgradeKeeperMock) {
+				captureWithMock()(m)
+				m.GetTgradeParamsFn = func(ctx sdk.Context) types.TgradeParams {
+					return types.TgradeParams{MaxRegisteredCallbackGasLimit: 100}
+				}
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"register begin block with liveness": {
+			src:   contract.PrivilegeMsg{Request: types.PrivilegeTypeBeginBlock, IncludeLiveness: true},
+			setup: captureWithMock(),
+			expDetails: &types.TgradeContractDetails{
+				RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "begin_blocker", IncludeLiveness: true}},
+			},
+			expRegistrations: []registration{{cb: types.PrivilegeTypeBeginBlock, addr: myContractAddr}},
+		},
+		"register other privilege with liveness": {
+			src:    contract.PrivilegeMsg{Request: types.PrivilegeTypeEndBlock, IncludeLiveness: true},
+			setup:  captureWithMock(),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"register allowed privilege": {
+			src: contract.PrivilegeMsg{Request: types.PrivilegeTypeBeginBlock},
+			setup: captureWithMock(func(info *wasmtypes.ContractInfo) {
+				info.SetExtension(&types.TgradeContractDetails{AllowedPrivileges: []string{"begin_blocker"}})
+			}),
+			expDetails: &types.TgradeContractDetails{
+				RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "begin_blocker"}},
+				AllowedPrivileges:    []string{"begin_blocker"},
+			},
+			expRegistrations: []registration{{cb: types.PrivilegeTypeBeginBlock, addr: myContractAddr}},
+		},
+		"register not allowed privilege": {
+			src: contract.PrivilegeMsg{Request: types.PrivilegeTypeTokenMinter},
+			setup: captureWithMock(func(info *wasmtypes.ContractInfo) {
+				info.SetExtension(&types.TgradeContractDetails{AllowedPrivileges: []string{"begin_blocker"}})
+			}),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
 		"unregister begin block": {
 			src: contract.PrivilegeMsg{Release: types.PrivilegeTypeBeginBlock},
 			setup: captureWithMock(func(info *wasmtypes.ContractInfo) {
@@ -274,6 +345,21 @@ func TestTgradeHandlesPrivilegeMsg(t *testing.T) {
 			expDetails:         &types.TgradeContractDetails{RegisteredPrivileges: []types.RegisteredPrivilege{}},
 			expUnRegistrations: []unregistration{{cb: types.PrivilegeTypeValidatorSetUpdate, pos: 1, addr: myContractAddr}},
 		},
+		"unregister last critical privilege rejected": {
+			src: contract.PrivilegeMsg{Release: types.PrivilegeTypeValidatorSetUpdate},
+			setup: func(m *handlerTgradeKeeperMock) {
+				captureWithMock(func(info *wasmtypes.ContractInfo) {
+					ext := &types.TgradeContractDetails{
+						RegisteredPrivileges: []types.RegisteredPrivilege{{Position: 1, PrivilegeType: "validator_set_updater"}},
+					}
+					info.SetExtension(ext)
+				})(m)
+				m.assertCriticalPrivilegeRemainsFn = func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+					return sdkerrors.ErrInvalidRequest
+				}
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
 		"register gov proposal executor": {
 			src:   contract.PrivilegeMsg{Request: types.PrivilegeTypeGovProposalExecutor},
 			setup: captureWithMock(),
@@ -379,7 +465,7 @@ func TestTgradeHandlesPrivilegeMsg(t *testing.T) {
 			capturedDetails, capturedRegistrations, capturedUnRegistrations = nil, nil, nil
 			mock := handlerTgradeKeeperMock{}
 			spec.setup(&mock)
-			h := NewTgradeHandler(nil, mock, nil, nil, nil)
+			h := NewTgradeHandler(nil, mock, nil, nil, nil, nil)
 			var ctx sdk.Context
 			gotErr := h.handlePrivilege(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -478,7 +564,7 @@ func TestHandleGovProposalExecution(t *testing.T) {
 			mock := handlerTgradeKeeperMock{}
 			spec.setup(&mock)
 			router := &CapturingGovRouter{}
-			h := NewTgradeHandler(cdc, mock, nil, nil, router)
+			h := NewTgradeHandler(cdc, mock, nil, nil, router, nil)
 			var ctx sdk.Context
 			gotErr := h.handleGovProposalExecution(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -493,6 +579,7 @@ func TestHandleMintToken(t *testing.T) {
 	specs := map[string]struct {
 		src            contract.MintTokens
 		setup          func(k *handlerTgradeKeeperMock)
+		trackErr       error
 		expErr         *sdkerrors.Error
 		expMintedCoins sdk.Coins
 		expRecipient   sdk.AccAddress
@@ -507,6 +594,16 @@ func TestHandleMintToken(t *testing.T) {
 			expMintedCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
 			expRecipient:   myRecipientAddr,
 		},
+		"quota exceeded": {
+			src: contract.MintTokens{
+				Denom:         "foo",
+				Amount:        "123",
+				RecipientAddr: myRecipientAddr.String(),
+			},
+			setup:    withPrivilegeRegistered(types.PrivilegeTypeTokenMinter),
+			trackErr: types.ErrMintQuotaExceeded,
+			expErr:   types.ErrMintQuotaExceeded,
+		},
 		"unauthorized contract": {
 			src: contract.MintTokens{
 				Denom:         "foo",
@@ -586,16 +683,25 @@ func TestHandleMintToken(t *testing.T) {
 			mintFn, capturedMintedCoins := CaptureMintedCoinsFn()
 			sendFn, capturedSentCoins := CaptureSentCoinsFromModuleFn()
 			mock := BankMock{MintCoinsFn: mintFn, SendCoinsFromModuleToAccountFn: sendFn}
-			keeperMock := handlerTgradeKeeperMock{}
+			var capturedTracked []sdk.Coin
+			keeperMock := handlerTgradeKeeperMock{
+				trackMintedTokensFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+					require.Equal(t, myContractAddr, contractAddr)
+					capturedTracked = append(capturedTracked, amount)
+					return spec.trackErr
+				},
+			}
 			spec.setup(&keeperMock)
-			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil)
+			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleMintToken(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
 			if spec.expErr != nil {
 				assert.Len(t, gotEvts, 0)
+				assert.Empty(t, *capturedMintedCoins)
 				return
 			}
+			assert.Equal(t, spec.expMintedCoins, sdk.NewCoins(capturedTracked...))
 			require.Len(t, *capturedMintedCoins, 1)
 			assert.Equal(t, spec.expMintedCoins, (*capturedMintedCoins)[0])
 			require.Len(t, *capturedSentCoins, 1)
@@ -607,11 +713,187 @@ func TestHandleMintToken(t *testing.T) {
 	}
 }
 
+func TestHandleBurnToken(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src            contract.BurnTokens
+		setup          func(k *handlerTgradeKeeperMock)
+		burnErr        error
+		expErr         *sdkerrors.Error
+		expBurnedCoins sdk.Coins
+	}{
+		"all good": {
+			src:            contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup:          withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expBurnedCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(123))),
+		},
+		"unauthorized contract": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenMinter),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"invalid denom": {
+			src:    contract.BurnTokens{Denom: "&&&foo", Amount: "123"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"invalid amount": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "not-a-number"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"zero amount": {
+			src:    contract.BurnTokens{Denom: "foo", Amount: "0"},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"insufficient funds": {
+			src:     contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup:   withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			burnErr: sdkerrors.ErrInsufficientFunds,
+			expErr:  sdkerrors.ErrInsufficientFunds,
+		},
+		"unknown origin contract": {
+			src: contract.BurnTokens{Denom: "foo", Amount: "123"},
+			setup: func(m *handlerTgradeKeeperMock) {
+				m.GetContractInfoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					return nil
+				}
+			},
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedBurnedCoins []sdk.Coins
+			sendFn, capturedSentCoins := CaptureSentCoinsFromAccountFn()
+			mock := BankMock{
+				SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+					if spec.burnErr != nil {
+						return spec.burnErr
+					}
+					require.Equal(t, myContractAddr, senderAddr)
+					return sendFn(ctx, senderAddr, recipientModule, amt)
+				},
+				BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+					require.Equal(t, types.ModuleName, moduleName)
+					capturedBurnedCoins = append(capturedBurnedCoins, amt)
+					return nil
+				},
+			}
+			keeperMock := handlerTgradeKeeperMock{}
+			spec.setup(&keeperMock)
+			h := NewTgradeHandler(nil, keeperMock, mock, nil, nil, nil)
+			var ctx sdk.Context
+
+			// when
+			gotEvts, gotErr := h.handleBurnToken(ctx, myContractAddr, &spec.src)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotEvts, 0)
+				assert.Empty(t, capturedBurnedCoins)
+				return
+			}
+			require.Len(t, *capturedSentCoins, 1)
+			assert.Equal(t, types.ModuleName, (*capturedSentCoins)[0].recipientModule)
+			assert.Equal(t, spec.expBurnedCoins, (*capturedSentCoins)[0].coins)
+			assert.Equal(t, []sdk.Coins{spec.expBurnedCoins}, capturedBurnedCoins)
+			require.Len(t, gotEvts, 1)
+			assert.Equal(t, types.EventTypeBurnTokens, gotEvts[0].Type)
+		})
+	}
+}
+
+func TestHandleFeeDenomRatios(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src       contract.FeeDenomRatios
+		setup     func(k *handlerTgradeKeeperMock)
+		updateErr error
+		expErr    *sdkerrors.Error
+		expRatios sdk.DecCoins
+	}{
+		"all good": {
+			src: contract.FeeDenomRatios{Ratios: []contract.DenomRatio{
+				{Denom: "foo", Ratio: "2"},
+				{Denom: "bar", Ratio: "0.5"},
+			}},
+			setup: withPrivilegeRegistered(types.PrivilegeTypeFeeOracle),
+			expRatios: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("bar", sdk.NewDecWithPrec(5, 1)),
+				sdk.NewDecCoinFromDec("foo", sdk.NewDec(2)),
+			),
+		},
+		"empty ratios": {
+			src:       contract.FeeDenomRatios{},
+			setup:     withPrivilegeRegistered(types.PrivilegeTypeFeeOracle),
+			expRatios: sdk.DecCoins{},
+		},
+		"unauthorized contract": {
+			src:    contract.FeeDenomRatios{Ratios: []contract.DenomRatio{{Denom: "foo", Ratio: "2"}}},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeTokenBurner),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"invalid ratio": {
+			src:    contract.FeeDenomRatios{Ratios: []contract.DenomRatio{{Denom: "foo", Ratio: "not-a-number"}}},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeFeeOracle),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		"rejected by updater": {
+			src:       contract.FeeDenomRatios{Ratios: []contract.DenomRatio{{Denom: "foo", Ratio: "2"}}},
+			setup:     withPrivilegeRegistered(types.PrivilegeTypeFeeOracle),
+			updateErr: wasmtypes.ErrInvalid,
+			expErr:    wasmtypes.ErrInvalid,
+		},
+		"unknown origin contract": {
+			src: contract.FeeDenomRatios{Ratios: []contract.DenomRatio{{Denom: "foo", Ratio: "2"}}},
+			setup: func(m *handlerTgradeKeeperMock) {
+				m.GetContractInfoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+					return nil
+				}
+			},
+			expErr: wasmtypes.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedRatios []sdk.DecCoins
+			updater := FeeDenomRatioUpdaterMock{SetDenomRatiosFn: func(ctx sdk.Context, ratios sdk.DecCoins) error {
+				if spec.updateErr != nil {
+					return spec.updateErr
+				}
+				capturedRatios = append(capturedRatios, ratios)
+				return nil
+			}}
+			keeperMock := handlerTgradeKeeperMock{}
+			spec.setup(&keeperMock)
+			h := NewTgradeHandler(nil, keeperMock, nil, nil, nil, updater)
+			var ctx sdk.Context
+
+			// when
+			gotEvts, gotErr := h.handleFeeDenomRatios(ctx, myContractAddr, &spec.src)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotEvts, 0)
+				assert.Empty(t, capturedRatios)
+				return
+			}
+			assert.Equal(t, []sdk.DecCoins{spec.expRatios}, capturedRatios)
+			require.Len(t, gotEvts, 1)
+			assert.Equal(t, types.EventTypeFeeDenomRatios, gotEvts[0].Type)
+		})
+	}
+}
+
 func TestHandleConsensusParamsUpdate(t *testing.T) {
 	var (
 		myContractAddr = RandomAddress(t)
 		// some integers
-		one, two, three, four, five int64 = 1, 2, 3, 4, 5
+		zero, one, two, three, four, five int64 = 0, 1, 2, 3, 4, 5
 	)
 	specs := map[string]struct {
 		src       contract.ConsensusParamsUpdate
@@ -631,7 +913,12 @@ func TestHandleConsensusParamsUpdate(t *testing.T) {
 					MaxBytes:        &five,
 				},
 			},
-			setup: withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			setup: func(k *handlerTgradeKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeConsensusParamChanger)(k)
+				k.GetTgradeParamsFn = func(ctx sdk.Context) types.TgradeParams {
+					return types.TgradeParams{}
+				}
+			},
 			expStored: types.ConsensusParamsFixture(func(c *abci.ConsensusParams) {
 				c.Block.MaxBytes = 1
 				c.Block.MaxGas = 2
@@ -659,6 +946,54 @@ func TestHandleConsensusParamsUpdate(t *testing.T) {
 			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
 			expErr: wasmtypes.ErrEmpty,
 		},
+		"block params out of bounds": {
+			src: contract.ConsensusParamsUpdate{
+				Block: &contract.BlockParams{
+					MaxGas: &one,
+				},
+			},
+			setup: func(k *handlerTgradeKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeConsensusParamChanger)(k)
+				k.GetTgradeParamsFn = func(ctx sdk.Context) types.TgradeParams {
+					return types.TgradeParams{ConsensusParamBounds: types.ConsensusParamBounds{MinBlockMaxGas: 2}}
+				}
+			},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"block params below default bounds": {
+			src: contract.ConsensusParamsUpdate{
+				Block: &contract.BlockParams{
+					MaxBytes: &one,
+				},
+			},
+			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"invalid block params": {
+			src: contract.ConsensusParamsUpdate{
+				Block: &contract.BlockParams{
+					MaxBytes: &zero,
+				},
+			},
+			setup:  withPrivilegeRegistered(types.PrivilegeConsensusParamChanger),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"evidence params without block bounds check": {
+			src: contract.ConsensusParamsUpdate{
+				Evidence: &contract.EvidenceParams{
+					MaxAgeNumBlocks: &one,
+				},
+			},
+			setup: func(k *handlerTgradeKeeperMock) {
+				withPrivilegeRegistered(types.PrivilegeConsensusParamChanger)(k)
+				k.GetTgradeParamsFn = func(ctx sdk.Context) types.TgradeParams {
+					panic("not expected to be called")
+				}
+			},
+			expStored: types.ConsensusParamsFixture(func(c *abci.ConsensusParams) {
+				c.Evidence.MaxAgeNumBlocks = 1
+			}),
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -671,7 +1006,7 @@ func TestHandleConsensusParamsUpdate(t *testing.T) {
 
 			keeperMock := handlerTgradeKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewTgradeHandler(cdc, keeperMock, nil, mock, nil)
+			h := NewTgradeHandler(cdc, keeperMock, nil, mock, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleConsensusParamsUpdate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -797,7 +1132,7 @@ func TestHandleDelegate(t *testing.T) {
 			mock := BankMock{DelegateCoinsFromAccountToModuleFn: delegateFn, SendCoinsFromModuleToAccountFn: sendFn}
 			keeperMock := handlerTgradeKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil)
+			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleDelegate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -932,7 +1267,7 @@ func TestHandleUndelegate(t *testing.T) {
 			mock := BankMock{UndelegateCoinsFromModuleToAccountFn: undelegateFn, SendCoinsFromAccountToModuleFn: sendFn}
 			keeperMock := handlerTgradeKeeperMock{}
 			spec.setup(&keeperMock)
-			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil)
+			h := NewTgradeHandler(cdc, keeperMock, mock, nil, nil, nil)
 			var ctx sdk.Context
 			gotEvts, gotErr := h.handleUndelegate(ctx, myContractAddr, &spec.src)
 			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
@@ -951,6 +1286,115 @@ func TestHandleUndelegate(t *testing.T) {
 	}
 }
 
+func TestHandleSchedule(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	myTime := time.Unix(1000000000, 0).UTC()
+	specs := map[string]struct {
+		src         contract.Schedule
+		setup       func(k *handlerTgradeKeeperMock)
+		expErr      *sdkerrors.Error
+		expCallback types.ScheduledCallback
+		expData     [][]byte
+	}{
+		"at height": {
+			src:         contract.Schedule{AtHeight: 10, Interval: 2, Payload: []byte(`{"foo":"bar"}`)},
+			setup:       withPrivilegeRegistered(types.PrivilegeTypeScheduler),
+			expCallback: types.ScheduledCallback{Height: 10, Interval: 2, Payload: []byte(`{"foo":"bar"}`)},
+			expData:     [][]byte{[]byte(`{"id":1}`)},
+		},
+		"at time": {
+			src:         contract.Schedule{AtTime: uint64(myTime.Unix())},
+			setup:       withPrivilegeRegistered(types.PrivilegeTypeScheduler),
+			expCallback: types.ScheduledCallback{Time: &myTime},
+			expData:     [][]byte{[]byte(`{"id":1}`)},
+		},
+		"height and time": {
+			src:    contract.Schedule{AtHeight: 10, AtTime: uint64(myTime.Unix())},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeScheduler),
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"empty": {
+			src:    contract.Schedule{},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeScheduler),
+			expErr: wasmtypes.ErrEmpty,
+		},
+		"unauthorized contract": {
+			src:    contract.Schedule{AtHeight: 10},
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeBeginBlock),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var captured []types.ScheduledCallback
+			keeperMock := handlerTgradeKeeperMock{
+				ScheduleCallbackFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) (uint64, error) {
+					require.Equal(t, myContractAddr, contractAddr)
+					captured = append(captured, callback)
+					return 1, nil
+				},
+			}
+			spec.setup(&keeperMock)
+			h := NewTgradeHandler(nil, keeperMock, nil, nil, nil, nil)
+			var ctx sdk.Context
+
+			// when
+			gotData, gotErr := h.handleSchedule(ctx, myContractAddr, &spec.src)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Empty(t, captured)
+				return
+			}
+			assert.Equal(t, []types.ScheduledCallback{spec.expCallback}, captured)
+			assert.Equal(t, spec.expData, gotData)
+		})
+	}
+}
+
+func TestHandleCancelSchedule(t *testing.T) {
+	myContractAddr := RandomAddress(t)
+	specs := map[string]struct {
+		setup  func(k *handlerTgradeKeeperMock)
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			setup: withPrivilegeRegistered(types.PrivilegeTypeScheduler),
+		},
+		"unauthorized contract": {
+			setup:  withPrivilegeRegistered(types.PrivilegeTypeBeginBlock),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var captured []uint64
+			keeperMock := handlerTgradeKeeperMock{
+				CancelScheduledCallbackFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error {
+					require.Equal(t, myContractAddr, contractAddr)
+					captured = append(captured, id)
+					return nil
+				},
+			}
+			spec.setup(&keeperMock)
+			h := NewTgradeHandler(nil, keeperMock, nil, nil, nil, nil)
+			var ctx sdk.Context
+
+			// when
+			gotErr := h.handleCancelSchedule(ctx, myContractAddr, &contract.CancelSchedule{ID: 7})
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Empty(t, captured)
+				return
+			}
+			assert.Equal(t, []uint64{7}, captured)
+		})
+	}
+}
+
 func withPrivilegeRegistered(p types.PrivilegeType) func(k *handlerTgradeKeeperMock) {
 	return func(k *handlerTgradeKeeperMock) {
 		k.GetContractInfoFn = func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
@@ -986,11 +1430,16 @@ func setupHandlerKeeperMock(m *handlerTgradeKeeperMock, mutators ...func(*wasmty
 var _ TgradeWasmHandlerKeeper = handlerTgradeKeeperMock{}
 
 type handlerTgradeKeeperMock struct {
-	IsPrivilegedFn                func(ctx sdk.Context, contract sdk.AccAddress) bool
-	appendToPrivilegedContractsFn func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddress sdk.AccAddress) (uint8, error)
-	removePrivilegeRegistrationFn func(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool
-	setContractDetailsFn          func(ctx sdk.Context, contract sdk.AccAddress, details *types.TgradeContractDetails) error
-	GetContractInfoFn             func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPrivilegedFn                   func(ctx sdk.Context, contract sdk.AccAddress) bool
+	appendToPrivilegedContractsFn    func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddress sdk.AccAddress) (uint8, error)
+	removePrivilegeRegistrationFn    func(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8, contractAddr sdk.AccAddress) bool
+	setContractDetailsFn             func(ctx sdk.Context, contract sdk.AccAddress, details *types.TgradeContractDetails) error
+	GetContractInfoFn                func(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	GetTgradeParamsFn                func(ctx sdk.Context) types.TgradeParams
+	assertCriticalPrivilegeRemainsFn func(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error
+	ScheduleCallbackFn               func(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) (uint64, error)
+	CancelScheduledCallbackFn        func(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error
+	trackMintedTokensFn              func(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error
 }
 
 func (m handlerTgradeKeeperMock) IsPrivileged(ctx sdk.Context, contract sdk.AccAddress) bool {
@@ -1028,11 +1477,47 @@ func (m handlerTgradeKeeperMock) GetContractInfo(ctx sdk.Context, contractAddres
 	return m.GetContractInfoFn(ctx, contractAddress)
 }
 
+func (m handlerTgradeKeeperMock) GetTgradeParams(ctx sdk.Context) types.TgradeParams {
+	if m.GetTgradeParamsFn == nil {
+		return types.DefaultTgradeParams()
+	}
+	return m.GetTgradeParamsFn(ctx)
+}
+
+func (m handlerTgradeKeeperMock) assertCriticalPrivilegeRemains(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+	if m.assertCriticalPrivilegeRemainsFn == nil {
+		return nil
+	}
+	return m.assertCriticalPrivilegeRemainsFn(ctx, privilegeType, contractAddr)
+}
+
+func (m handlerTgradeKeeperMock) ScheduleCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) (uint64, error) {
+	if m.ScheduleCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ScheduleCallbackFn(ctx, contractAddr, callback)
+}
+
+func (m handlerTgradeKeeperMock) CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error {
+	if m.CancelScheduledCallbackFn == nil {
+		panic("not expected to be called")
+	}
+	return m.CancelScheduledCallbackFn(ctx, contractAddr, id)
+}
+
+func (m handlerTgradeKeeperMock) trackMintedTokens(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	if m.trackMintedTokensFn == nil {
+		panic("not expected to be called")
+	}
+	return m.trackMintedTokensFn(ctx, contractAddr, amount)
+}
+
 // BankMock test helper that satisfies the `bankKeeper` interface
 type BankMock struct {
 	MintCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccountFn       func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModuleFn       func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModuleFn   func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccountFn func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -1058,6 +1543,13 @@ func (m BankMock) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.A
 	return m.SendCoinsFromAccountToModuleFn(ctx, senderAddr, recipientModule, amt)
 }
 
+func (m BankMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if m.BurnCoinsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BurnCoinsFn(ctx, moduleName, amt)
+}
+
 func (m BankMock) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	if m.UndelegateCoinsFromModuleToAccountFn == nil {
 		panic("not expected to be called")
@@ -1083,6 +1575,9 @@ func NoopBankMock() *BankMock {
 		SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 			return nil
 		},
+		BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+			return nil
+		},
 		DelegateCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 			return nil
 		},
@@ -1169,3 +1664,14 @@ func (m ConsensusParamsStoreMock) StoreConsensusParams(ctx sdk.Context, cp *abci
 	}
 	m.StoreConsensusParamsFn(ctx, cp)
 }
+
+type FeeDenomRatioUpdaterMock struct {
+	SetDenomRatiosFn func(ctx sdk.Context, ratios sdk.DecCoins) error
+}
+
+func (m FeeDenomRatioUpdaterMock) SetDenomRatios(ctx sdk.Context, ratios sdk.DecCoins) error {
+	if m.SetDenomRatiosFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetDenomRatiosFn(ctx, ratios)
+}
@@ -44,6 +44,10 @@ func NewKeeper(
 	availableCapabilities string,
 	opts ...wasmkeeper.Option,
 ) Keeper {
+	// set KeyTable with wasmd and tgrade params if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 	result := Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
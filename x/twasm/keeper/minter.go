@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+// GetMintedSupply returns the running totals of tokens minted by the contract for the denom.
+// Totals are zero when nothing was minted before.
+func (k Keeper) GetMintedSupply(ctx sdk.Context, contractAddr sdk.AccAddress, denom string) types.MintedSupply {
+	bz := ctx.KVStore(k.storeKey).Get(mintedSupplyKey(contractAddr, denom))
+	if bz == nil {
+		return types.MintedSupply{
+			ContractAddress: contractAddr.String(),
+			Denom:           denom,
+			Total:           sdk.ZeroInt(),
+			EpochTotal:      sdk.ZeroInt(),
+		}
+	}
+	var supply types.MintedSupply
+	k.cdc.MustUnmarshal(bz, &supply)
+	return supply
+}
+
+// ContractMintedSupply returns the running totals of tokens minted by the contract ordered by denom
+func (k Keeper) ContractMintedSupply(ctx sdk.Context, contractAddr sdk.AccAddress) []types.MintedSupply {
+	var result []types.MintedSupply
+	k.iterateMintedSupply(ctx, getContractMintedSupplyPrefix(contractAddr), func(supply types.MintedSupply) bool {
+		result = append(result, supply)
+		return false
+	})
+	return result
+}
+
+// IterateMintedSupply iterates over the running totals of all contracts. The callback returns true to stop early
+func (k Keeper) IterateMintedSupply(ctx sdk.Context, cb func(types.MintedSupply) bool) {
+	k.iterateMintedSupply(ctx, mintedSupplyPrefix, cb)
+}
+
+func (k Keeper) iterateMintedSupply(ctx sdk.Context, keyPrefix []byte, cb func(types.MintedSupply) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var supply types.MintedSupply
+		k.cdc.MustUnmarshal(iter.Value(), &supply)
+		// cb returns true to stop early
+		if cb(supply) {
+			return
+		}
+	}
+}
+
+// trackMintedTokens adds the amount to the running totals of the contract. Fails with ErrMintQuotaExceeded
+// when the new totals exceed the minter quota for the denom.
+func (k Keeper) trackMintedTokens(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	supply := k.GetMintedSupply(ctx, contractAddr, amount.Denom)
+	supply.Total = supply.Total.Add(amount.Amount)
+	quota := k.GetTgradeParams(ctx).MinterQuota(contractAddr, amount.Denom)
+	if quota == nil || quota.EpochLength == 0 {
+		supply.Epoch, supply.EpochTotal = 0, sdk.ZeroInt()
+	} else {
+		if epoch := quota.Epoch(ctx.BlockTime()); epoch != supply.Epoch {
+			supply.Epoch, supply.EpochTotal = epoch, sdk.ZeroInt()
+		}
+		supply.EpochTotal = supply.EpochTotal.Add(amount.Amount)
+	}
+	if quota != nil {
+		if quota.HasLifetimeCap() && supply.Total.GT(quota.LifetimeCap) {
+			return sdkerrors.Wrapf(types.ErrMintQuotaExceeded, "lifetime cap %s%s", quota.LifetimeCap, amount.Denom)
+		}
+		if quota.HasEpochCap() && supply.EpochTotal.GT(quota.EpochCap) {
+			return sdkerrors.Wrapf(types.ErrMintQuotaExceeded, "epoch cap %s%s", quota.EpochCap, amount.Denom)
+		}
+	}
+	return k.storeMintedSupply(ctx, contractAddr, supply)
+}
+
+// importMintedSupply stores the running totals from genesis
+func (k Keeper) importMintedSupply(ctx sdk.Context, supply types.MintedSupply) error {
+	if err := supply.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(supply.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if ctx.KVStore(k.storeKey).Has(mintedSupplyKey(contractAddr, supply.Denom)) {
+		return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "minted supply for denom %q", supply.Denom)
+	}
+	return k.storeMintedSupply(ctx, contractAddr, supply)
+}
+
+func (k Keeper) storeMintedSupply(ctx sdk.Context, contractAddr sdk.AccAddress, supply types.MintedSupply) error {
+	bz, err := k.cdc.Marshal(&supply)
+	if err != nil {
+		return sdkerrors.Wrap(err, "marshal minted supply")
+	}
+	ctx.KVStore(k.storeKey).Set(mintedSupplyKey(contractAddr, supply.Denom), bz)
+	return nil
+}
+
+// mintedSupplyKey returns the key for the running totals
+// `<prefix><len(contractAddr)><contractAddr><denom>`
+func mintedSupplyKey(contractAddr sdk.AccAddress, denom string) []byte {
+	return append(getContractMintedSupplyPrefix(contractAddr), []byte(denom)...)
+}
+
+// getContractMintedSupplyPrefix returns the prefix for all running totals of the contract
+// `<prefix><len(contractAddr)><contractAddr>`
+func getContractMintedSupplyPrefix(contractAddr sdk.AccAddress) []byte {
+	r := append([]byte{}, mintedSupplyPrefix...)
+	return append(r, address.MustLengthPrefix(contractAddr)...)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestTrackMintedTokens(t *testing.T) {
+	myTime := time.Unix(1000, 0).UTC()
+	myAddr := RandomAddress(t)
+	quota := func(mutators ...func(*types.MinterQuota)) []types.MinterQuota {
+		q := types.MinterQuota{ContractAddress: myAddr.String(), Denom: "alx", EpochCap: sdk.ZeroInt(), LifetimeCap: sdk.ZeroInt()}
+		for _, m := range mutators {
+			m(&q)
+		}
+		return []types.MinterQuota{q}
+	}
+	specs := map[string]struct {
+		quotas    []types.MinterQuota
+		existing  *types.MintedSupply
+		blockTime time.Time
+		amount    sdk.Coin
+		expErr    *sdkerrors.Error
+		exp       types.MintedSupply
+	}{
+		"first mint without quota": {
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("alx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(10), EpochTotal: sdk.ZeroInt()},
+		},
+		"added to total": {
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(5), EpochTotal: sdk.ZeroInt()},
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("alx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(15), EpochTotal: sdk.ZeroInt()},
+		},
+		"quota for other denom": {
+			quotas:    quota(func(q *types.MinterQuota) { q.LifetimeCap = sdk.NewInt(1) }),
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("blx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "blx", Total: sdk.NewInt(10), EpochTotal: sdk.ZeroInt()},
+		},
+		"lifetime cap reached": {
+			quotas:    quota(func(q *types.MinterQuota) { q.LifetimeCap = sdk.NewInt(15) }),
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(5), EpochTotal: sdk.ZeroInt()},
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("alx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(15), EpochTotal: sdk.ZeroInt()},
+		},
+		"lifetime cap exceeded": {
+			quotas:    quota(func(q *types.MinterQuota) { q.LifetimeCap = sdk.NewInt(14) }),
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(5), EpochTotal: sdk.ZeroInt()},
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("alx", 10),
+			expErr:    types.ErrMintQuotaExceeded,
+		},
+		"epoch total added in same epoch": {
+			quotas: quota(func(q *types.MinterQuota) {
+				q.EpochLength, q.EpochCap = 100, sdk.NewInt(15)
+			}),
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(20), Epoch: 10, EpochTotal: sdk.NewInt(5)},
+			blockTime: myTime.Add(99 * time.Second),
+			amount:    sdk.NewInt64Coin("alx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(30), Epoch: 10, EpochTotal: sdk.NewInt(15)},
+		},
+		"epoch cap exceeded": {
+			quotas: quota(func(q *types.MinterQuota) {
+				q.EpochLength, q.EpochCap = 100, sdk.NewInt(14)
+			}),
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(20), Epoch: 10, EpochTotal: sdk.NewInt(5)},
+			blockTime: myTime,
+			amount:    sdk.NewInt64Coin("alx", 10),
+			expErr:    types.ErrMintQuotaExceeded,
+		},
+		"epoch total reset in new epoch": {
+			quotas: quota(func(q *types.MinterQuota) {
+				q.EpochLength, q.EpochCap = 100, sdk.NewInt(14)
+			}),
+			existing:  &types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(20), Epoch: 10, EpochTotal: sdk.NewInt(5)},
+			blockTime: myTime.Add(100 * time.Second),
+			amount:    sdk.NewInt64Coin("alx", 10),
+			exp:       types.MintedSupply{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(30), Epoch: 11, EpochTotal: sdk.NewInt(10)},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t)
+			k := keepers.TWasmKeeper
+			ctx = ctx.WithBlockTime(spec.blockTime)
+			params := types.DefaultTgradeParams()
+			if spec.quotas != nil {
+				params.MinterQuotas = spec.quotas
+			}
+			k.SetTgradeParams(ctx, params)
+			if spec.existing != nil {
+				require.NoError(t, k.importMintedSupply(ctx, *spec.existing))
+			}
+			// when
+			gotErr := k.trackMintedTokens(ctx, myAddr, spec.amount)
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				if spec.existing != nil {
+					assert.Equal(t, *spec.existing, k.GetMintedSupply(ctx, myAddr, spec.amount.Denom))
+				}
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, k.GetMintedSupply(ctx, myAddr, spec.amount.Denom))
+		})
+	}
+}
+
+func TestContractMintedSupply(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	myAddr, otherAddr := RandomAddress(t), RandomAddress(t)
+	require.NoError(t, k.trackMintedTokens(ctx, myAddr, sdk.NewInt64Coin("blx", 2)))
+	require.NoError(t, k.trackMintedTokens(ctx, myAddr, sdk.NewInt64Coin("alx", 1)))
+	require.NoError(t, k.trackMintedTokens(ctx, otherAddr, sdk.NewInt64Coin("alx", 3)))
+
+	// when
+	got := k.ContractMintedSupply(ctx, myAddr)
+
+	// then
+	exp := []types.MintedSupply{
+		{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(1), EpochTotal: sdk.ZeroInt()},
+		{ContractAddress: myAddr.String(), Denom: "blx", Total: sdk.NewInt(2), EpochTotal: sdk.ZeroInt()},
+	}
+	assert.Equal(t, exp, got)
+	assert.Empty(t, k.ContractMintedSupply(ctx, RandomAddress(t)))
+	// and duplicates rejected on import
+	err := k.importMintedSupply(ctx, exp[0])
+	assert.True(t, wasmtypes.ErrDuplicate.Is(err))
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+// GetTgradeParams returns the tgrade specific params. Values that were not set before, return their zero value.
+func (k Keeper) GetTgradeParams(ctx sdk.Context) types.TgradeParams {
+	var params types.TgradeParams
+	for _, p := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, p.Key, p.Value)
+	}
+	return params
+}
+
+// SetTgradeParams stores the tgrade specific params
+func (k Keeper) SetTgradeParams(ctx sdk.Context, params types.TgradeParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetCallbackGasLimit returns the gas limit for an abci callback of the given privilege type to the contract.
+// Returns 0 when no limit applies.
+func (k Keeper) GetCallbackGasLimit(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) uint64 {
+	var registered uint64
+	if details, err := k.getContractDetails(ctx, contractAddr); err == nil {
+		registered = details.RegisteredGasLimit(privilegeType)
+	}
+	return k.GetTgradeParams(ctx).CallbackGasLimit(privilegeType, contractAddr, registered)
+}
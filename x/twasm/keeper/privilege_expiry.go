@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+// SetPrivilegeExpiry stores the deadline after which the privileged contract is demoted automatically.
+// An existing expiry is replaced. Nil removes the expiry.
+// An expiry is rejected when the contract is the last one registered for a critical privilege.
+func (k Keeper) SetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error {
+	if expiry != nil {
+		if err := expiry.ValidateBasic(); err != nil {
+			return err
+		}
+		if expiry.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+			return sdkerrors.Wrap(wasmtypes.ErrInvalid, "expiry must be in the future")
+		}
+		if err := k.assertCriticalPrivilegesRemain(ctx, contractAddr); err != nil {
+			return err
+		}
+	}
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	if details.Expiry != nil {
+		k.removePrivilegeExpiryIndex(ctx, contractAddr, *details.Expiry)
+	}
+	if expiry != nil {
+		k.storePrivilegeExpiryIndex(ctx, contractAddr, *expiry)
+	}
+	details.Expiry = expiry
+	return sdkerrors.Wrap(k.setContractDetails(ctx, contractAddr, details), "store contract info extension")
+}
+
+// GetPrivilegeExpiry returns the deadline for the privileged contract. Result is nil when none is set.
+func (k Keeper) GetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PrivilegeExpiry {
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return nil
+	}
+	return details.Expiry
+}
+
+// ExpiredPrivileged returns the addresses of all contracts with a deadline that is reached at the current
+// block height or time. Contracts with a height expiry are returned first.
+func (k Keeper) ExpiredPrivileged(ctx sdk.Context) []sdk.AccAddress {
+	var result []sdk.AccAddress
+	collect := func(prefixStore prefix.Store, end []byte) {
+		iter := prefixStore.Iterator(nil, sdk.PrefixEndBytes(end))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			result = append(result, iter.Value())
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	collect(prefix.NewStore(store, privilegeExpiryHeightIndexPrefix), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	collect(prefix.NewStore(store, privilegeExpiryTimeIndexPrefix), sdk.FormatTimeBytes(ctx.BlockTime()))
+	return result
+}
+
+// DemoteExpiredPrivileged demotes a contract with a reached expiry via the forced demotion so that the contract can
+// not block it. An error is returned while the contract is the last one registered for a critical privilege so that
+// the expiry stays pending and is retried in the next block.
+func (k Keeper) DemoteExpiredPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if err := k.assertCriticalPrivilegesRemain(ctx, contractAddr); err != nil {
+		return sdkerrors.Wrap(err, "expiry pending")
+	}
+	return k.ForceUnsetPrivileged(ctx, contractAddr)
+}
+
+// storePrivilegeExpiryIndex adds the contract to the expiry index
+func (k Keeper) storePrivilegeExpiryIndex(ctx sdk.Context, contractAddr sdk.AccAddress, expiry types.PrivilegeExpiry) {
+	ctx.KVStore(k.storeKey).Set(privilegeExpiryIndexKey(expiry, contractAddr), contractAddr)
+}
+
+// removePrivilegeExpiryIndex removes the contract from the expiry index
+func (k Keeper) removePrivilegeExpiryIndex(ctx sdk.Context, contractAddr sdk.AccAddress, expiry types.PrivilegeExpiry) {
+	ctx.KVStore(k.storeKey).Delete(privilegeExpiryIndexKey(expiry, contractAddr))
+}
+
+// privilegeExpiryIndexKey returns the key for the expiry index
+// `<heightPrefix><height><contractAddr>` or `<timePrefix><time><contractAddr>`
+func privilegeExpiryIndexKey(expiry types.PrivilegeExpiry, contractAddr sdk.AccAddress) []byte {
+	var r []byte
+	if expiry.Time != nil && !expiry.Time.IsZero() {
+		r = append(r, privilegeExpiryTimeIndexPrefix...)
+		r = append(r, sdk.FormatTimeBytes(*expiry.Time)...)
+	} else {
+		r = append(r, privilegeExpiryHeightIndexPrefix...)
+		r = append(r, sdk.Uint64ToBigEndian(expiry.Height)...)
+	}
+	return append(r, contractAddr...)
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	cosmwasm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/twasm/contract"
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestSetPrivilegeExpiry(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	laterTime := myTime.Add(time.Hour)
+	specs := map[string]struct {
+		existing  *types.PrivilegeExpiry
+		src       *types.PrivilegeExpiry
+		expErr    bool
+		expExpiry *types.PrivilegeExpiry
+		// block height and time to check for expired contracts
+		atHeight   int64
+		atTime     time.Time
+		expExpired bool
+	}{
+		"height": {
+			src:        &types.PrivilegeExpiry{Height: 101},
+			expExpiry:  &types.PrivilegeExpiry{Height: 101},
+			atHeight:   101,
+			atTime:     myTime,
+			expExpired: true,
+		},
+		"height not reached": {
+			src:       &types.PrivilegeExpiry{Height: 101},
+			expExpiry: &types.PrivilegeExpiry{Height: 101},
+			atHeight:  100,
+			atTime:    laterTime,
+		},
+		"time": {
+			src:        &types.PrivilegeExpiry{Time: &laterTime},
+			expExpiry:  &types.PrivilegeExpiry{Time: &laterTime},
+			atHeight:   100,
+			atTime:     laterTime,
+			expExpired: true,
+		},
+		"time not reached": {
+			src:       &types.PrivilegeExpiry{Time: &laterTime},
+			expExpiry: &types.PrivilegeExpiry{Time: &laterTime},
+			atHeight:  1000,
+			atTime:    laterTime.Add(-time.Nanosecond),
+		},
+		"replace existing": {
+			existing:  &types.PrivilegeExpiry{Height: 101},
+			src:       &types.PrivilegeExpiry{Time: &laterTime},
+			expExpiry: &types.PrivilegeExpiry{Time: &laterTime},
+			atHeight:  101,
+			atTime:    myTime,
+		},
+		"remove existing": {
+			existing: &types.PrivilegeExpiry{Height: 101},
+			atHeight: 101,
+			atTime:   myTime,
+		},
+		"height passed": {
+			src:      &types.PrivilegeExpiry{Height: 100},
+			expErr:   true,
+			atHeight: 100,
+			atTime:   myTime,
+		},
+		"time passed": {
+			src:      &types.PrivilegeExpiry{Time: &myTime},
+			expErr:   true,
+			atHeight: 100,
+			atTime:   myTime,
+		},
+		"invalid": {
+			src:      &types.PrivilegeExpiry{Height: 101, Time: &laterTime},
+			expErr:   true,
+			atHeight: 100,
+			atTime:   myTime,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(myTime)
+			_, contractAddr := seedTestContract(t, ctx, k)
+			if spec.existing != nil {
+				require.NoError(t, k.SetPrivilegeExpiry(ctx, contractAddr, spec.existing))
+			}
+
+			// when
+			gotErr := k.SetPrivilegeExpiry(ctx, contractAddr, spec.src)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expExpiry, k.GetPrivilegeExpiry(ctx, contractAddr))
+			gotExpired := k.ExpiredPrivileged(ctx.WithBlockHeight(spec.atHeight).WithBlockTime(spec.atTime))
+			if spec.expExpired {
+				assert.Equal(t, []sdk.AccAddress{contractAddr}, gotExpired)
+			} else {
+				assert.Empty(t, gotExpired)
+			}
+		})
+	}
+}
+
+func TestUnsetPrivilegedRemovesExpiry(t *testing.T) {
+	mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+		m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+		m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+		m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		}
+	})
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	_, contractAddr := seedTestContract(t, ctx, k)
+	require.NoError(t, k.SetPrivileged(ctx, contractAddr))
+	require.NoError(t, k.SetPrivilegeExpiry(ctx, contractAddr, &types.PrivilegeExpiry{Height: 101}))
+
+	// when
+	require.NoError(t, k.UnsetPrivileged(ctx, contractAddr))
+
+	// then
+	assert.Nil(t, k.GetPrivilegeExpiry(ctx, contractAddr))
+	assert.Empty(t, k.ExpiredPrivileged(ctx.WithBlockHeight(101)))
+}
+
+func TestDemoteExpiredPrivileged(t *testing.T) {
+	specs := map[string]struct {
+		privilegeType types.PrivilegeType
+		sudoErr       error
+		expErr        bool
+	}{
+		"demoted": {
+			privilegeType: types.PrivilegeTypeBeginBlock,
+		},
+		"demoted when sudo fails": {
+			privilegeType: types.PrivilegeTypeBeginBlock,
+			sudoErr:       errors.New("testing"),
+		},
+		"expiry pending for last critical privilege": {
+			privilegeType: types.PrivilegeTypeValidatorSetUpdate,
+			expErr:        true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					return &wasmvmtypes.Response{}, 0, spec.sudoErr
+				}
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			ctx = ctx.WithBlockHeight(100)
+			_, contractAddr := seedTestContract(t, ctx, k)
+			k.setPrivilegedFlag(ctx, contractAddr)
+			// set before the registration as an expiry for the last critical privilege is rejected
+			require.NoError(t, k.SetPrivilegeExpiry(ctx, contractAddr, &types.PrivilegeExpiry{Height: 101}))
+			h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+			require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: spec.privilegeType}))
+			ctx = ctx.WithBlockHeight(101)
+
+			// when
+			err := k.DemoteExpiredPrivileged(ctx, contractAddr)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.True(t, k.IsPrivileged(ctx, contractAddr))
+				assert.Equal(t, &types.PrivilegeExpiry{Height: 101}, k.GetPrivilegeExpiry(ctx, contractAddr))
+				assert.Equal(t, []sdk.AccAddress{contractAddr}, k.ExpiredPrivileged(ctx))
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, k.IsPrivileged(ctx, contractAddr))
+			assert.Nil(t, k.GetPrivilegeExpiry(ctx, contractAddr))
+			assert.Empty(t, k.ExpiredPrivileged(ctx))
+		})
+	}
+}
+
+func TestSetPrivilegeExpiryRejectsLastCriticalPrivilege(t *testing.T) {
+	mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+		m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+		m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+		m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		}
+	})
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+	var contractAddrs []sdk.AccAddress
+	for i := 0; i < 2; i++ {
+		_, contractAddr := seedTestContract(t, ctx, k)
+		k.setPrivilegedFlag(ctx, contractAddr)
+		require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: types.PrivilegeTypeGovProposalExecutor}))
+		contractAddrs = append(contractAddrs, contractAddr)
+	}
+	// when another contract is registered
+	err := k.SetPrivilegeExpiry(ctx, contractAddrs[0], &types.PrivilegeExpiry{Height: 101})
+	// then
+	require.NoError(t, err)
+
+	// when the contract is the last one registered
+	require.NoError(t, k.UnsetPrivileged(ctx, contractAddrs[0]))
+	err = k.SetPrivilegeExpiry(ctx, contractAddrs[1], &types.PrivilegeExpiry{Height: 101})
+	// then
+	require.Error(t, err)
+	assert.Nil(t, k.GetPrivilegeExpiry(ctx, contractAddrs[1]))
+}
+
+func TestImportPrivilegedRestoresExpiry(t *testing.T) {
+	mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+		m.PinFn = func(checksum cosmwasm.Checksum) error { return nil }
+	})
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+	k := keepers.TWasmKeeper
+	codeID, contractAddr := seedTestContract(t, ctx, k)
+	details := types.TgradeContractDetails{Expiry: &types.PrivilegeExpiry{Height: 101}}
+
+	// when
+	require.NoError(t, k.importPrivileged(ctx, contractAddr, codeID, details))
+
+	// then
+	assert.Empty(t, k.ExpiredPrivileged(ctx.WithBlockHeight(100)))
+	assert.Equal(t, []sdk.AccAddress{contractAddr}, k.ExpiredPrivileged(ctx.WithBlockHeight(101)))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"runtime/debug"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -14,6 +15,9 @@ import (
 	"github.com/confio/tgrade/x/twasm/types"
 )
 
+// ForceDemoteSudoGasLimit is the max gas that a contract can consume in the demotion callback on a forced demotion
+const ForceDemoteSudoGasLimit uint64 = 1_000_000
+
 // SetPrivileged does
 // - pin to cache
 // - set privileged flag
@@ -56,18 +60,65 @@ func (k Keeper) SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) erro
 // - remove contract from cache
 // - remove privileged flag
 // - remove all privileges for the contract
+// - remove the expiry
 func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if err := k.assertCriticalPrivilegesRemain(ctx, contractAddr); err != nil {
+		return err
+	}
 	// call contract to release privileges
+	if err := k.sudoDemoted(ctx, contractAddr); err != nil {
+		return sdkerrors.Wrap(err, "sudo")
+	}
+	return k.releasePrivileged(ctx, contractAddr)
+}
+
+// ForceUnsetPrivileged does the same as UnsetPrivileged but does not depend on the contract.
+// The Sudo call with PrivilegeChangeMsg{Demoted{}} is executed with a bounded gas limit and
+// any failure is ignored. State changes of a failed call are reverted.
+func (k Keeper) ForceUnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if err := k.assertCriticalPrivilegesRemain(ctx, contractAddr); err != nil {
+		return err
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(ForceDemoteSudoGasLimit))
+	if err := k.trySudoDemoted(cacheCtx, contractAddr); err != nil {
+		k.Logger(ctx).Error("Ignore failed demotion callback", "contractAddr", contractAddr.String(), "cause", err)
+	} else {
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "force demote sudo")
+	return k.releasePrivileged(ctx, contractAddr)
+}
+
+// trySudoDemoted calls sudoDemoted and converts any panic into an error so that the demotion can not be blocked
+func (k Keeper) trySudoDemoted(ctx sdk.Context, contractAddr sdk.AccAddress) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "in location: %v", oog.Descriptor)
+				return
+			}
+			k.Logger(ctx).Error("Recovered panic in demotion callback", "contractAddr", contractAddr.String(), "cause", r, "stacktrace", string(debug.Stack()))
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "demotion callback: %v", r)
+		}
+	}()
+	return k.sudoDemoted(ctx, contractAddr)
+}
+
+// sudoDemoted calls the contract with PrivilegeChangeMsg{Demoted{}}
+func (k Keeper) sudoDemoted(ctx sdk.Context, contractAddr sdk.AccAddress) error {
 	msg := contract.TgradeSudoMsg{PrivilegeChange: &contract.PrivilegeChangeMsg{Demoted: &struct{}{}}}
 	msgBz, err := json.Marshal(&msg)
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
+	_, err = k.Sudo(ctx, contractAddr, msgBz)
+	return err
+}
 
-	if _, err = k.Sudo(ctx, contractAddr, msgBz); err != nil {
-		return sdkerrors.Wrap(err, "sudo")
-	}
-
+// releasePrivileged removes the contract from cache, the privileged flag, all privileges and the expiry
+func (k Keeper) releasePrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
 	// load after sudo so that unregister messages were handled
 	contractInfo := k.GetContractInfo(ctx, contractAddr)
 	if contractInfo == nil {
@@ -103,6 +154,11 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 		details.RemoveRegisteredPrivilege(privilegeType, pos)
 		return false
 	})
+	details.AllowedPrivileges = nil
+	if details.Expiry != nil {
+		k.removePrivilegeExpiryIndex(ctx, contractAddr, *details.Expiry)
+		details.Expiry = nil
+	}
 	if err := k.setContractDetails(ctx, contractAddr, &details); err != nil {
 		return sdkerrors.Wrap(err, "store contract info extension")
 	}
@@ -116,6 +172,20 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 	return nil
 }
 
+// SetAllowedPrivileges stores the privilege types that the contract can register for.
+// All privilege types are allowed when empty.
+func (k Keeper) SetAllowedPrivileges(ctx sdk.Context, contractAddr sdk.AccAddress, allowed []string) error {
+	if err := types.ValidatePrivilegeTypeNames(allowed); err != nil {
+		return err
+	}
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	details.AllowedPrivileges = allowed
+	return sdkerrors.Wrap(k.setContractDetails(ctx, contractAddr, details), "store contract info extension")
+}
+
 // importPrivileged import from genesis
 func (k Keeper) importPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress, codeID uint64, details types.TgradeContractDetails) error {
 	// add to cache
@@ -142,6 +212,9 @@ func (k Keeper) importPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress, c
 		}
 		k.storeContractPrivilegeRegistration(ctx, *privilegeType, pos, contractAddr)
 	}
+	if details.Expiry != nil {
+		k.storePrivilegeExpiryIndex(ctx, contractAddr, *details.Expiry)
+	}
 	return nil
 }
 
@@ -221,6 +294,10 @@ func (k Keeper) removePrivilegeRegistration(ctx sdk.Context, privilegeType types
 		return false
 	}
 	store.Delete(key)
+	k.ResetCallbackFailures(ctx, privilegeType, contractAddr)
+	if privilegeType == types.PrivilegeTypeScheduler {
+		k.removeScheduledCallbacks(ctx, contractAddr)
+	}
 	k.Logger(ctx).Info("Remove privilege", "contractAddr", contractAddr.String(), "type", privilegeType.String())
 	event := sdk.NewEvent(
 		types.EventTypeReleasePrivilege,
@@ -274,6 +351,48 @@ func (k Keeper) HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddre
 	return d.HasRegisteredPrivilege(privilegeType), nil
 }
 
+// IncludesLiveness returns true when the contract registration for the privilege type opted in to liveness data.
+// Returns false for unknown contracts.
+func (k Keeper) IncludesLiveness(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) bool {
+	d, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return false
+	}
+	return d.IncludesLiveness(privilegeType)
+}
+
+// assertCriticalPrivilegesRemain returns an error when the contract is the last one registered for a critical
+// privilege type. Returns error for unknown contract addresses.
+func (k Keeper) assertCriticalPrivilegesRemain(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	details, err := k.getContractDetails(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	for _, p := range details.RegisteredPrivileges {
+		if err := k.assertCriticalPrivilegeRemains(ctx, *types.PrivilegeTypeFrom(p.PrivilegeType), contractAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assertCriticalPrivilegeRemains returns an error when the privilege type is critical and no other contract
+// than the given one is registered for it.
+func (k Keeper) assertCriticalPrivilegeRemains(ctx sdk.Context, privilegeType types.PrivilegeType, contractAddr sdk.AccAddress) error {
+	if !privilegeType.IsCritical() {
+		return nil
+	}
+	var otherFound bool
+	k.IteratePrivilegedContractsByType(ctx, privilegeType, func(_ uint8, addr sdk.AccAddress) bool {
+		otherFound = !contractAddr.Equals(addr)
+		return otherFound
+	})
+	if !otherFound {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can not remove last contract with critical privilege: %s", privilegeType.String())
+	}
+	return nil
+}
+
 func privilegedContractsSecondaryIndexKey(contractAddr sdk.AccAddress) []byte {
 	return append(privilegedContractsSecondaryIndexPrefix, contractAddr...)
 }
@@ -3,6 +3,8 @@ package keeper
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -168,7 +170,7 @@ func TestUnsetPrivileged(t *testing.T) {
 			codeID, contractAddr := seedTestContract(t, ctx, k)
 			spec.setup(t, ctx, keepers, mock)
 
-			h := NewTgradeHandler(nil, k, nil, nil, nil)
+			h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
 			// and privileged with a type
 			k.setPrivilegedFlag(ctx, contractAddr)
 			err := h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{
@@ -213,6 +215,134 @@ func TestUnsetPrivileged(t *testing.T) {
 	}
 }
 
+func TestForceUnsetPrivileged(t *testing.T) {
+	specs := map[string]struct {
+		sudoFn   func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
+		expState bool
+	}{
+		"sudo succeeds": {
+			sudoFn: func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("foo"), []byte("bar"))
+				return &wasmvmtypes.Response{}, 0, nil
+			},
+			expState: true,
+		},
+		"sudo fails": {
+			sudoFn: func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("foo"), []byte("bar"))
+				return nil, 0, errors.New("test, ignore")
+			},
+		},
+		"sudo out of gas": {
+			sudoFn: func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("foo"), []byte("bar"))
+				return &wasmvmtypes.Response{}, math.MaxUint64, nil
+			},
+		},
+		"sudo panics": {
+			sudoFn: func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("foo"), []byte("bar"))
+				panic("testing")
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+				m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+				m.SudoFn = spec.sudoFn
+			})
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+			k := keepers.TWasmKeeper
+			_, contractAddr := seedTestContract(t, ctx, k)
+
+			h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+			k.setPrivilegedFlag(ctx, contractAddr)
+			err := h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{
+				Request: types.PrivilegeTypeBeginBlock,
+			})
+			require.NoError(t, err)
+
+			// when
+			err = k.ForceUnsetPrivileged(ctx, contractAddr)
+
+			// then
+			require.NoError(t, err)
+			assert.False(t, k.IsPrivileged(ctx, contractAddr))
+			assert.False(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeBeginBlock))
+			details, err := k.getContractDetails(ctx, contractAddr)
+			require.NoError(t, err)
+			assert.Empty(t, details.RegisteredPrivileges)
+			// and contract state only persisted on success
+			assert.Equal(t, spec.expState, k.QueryRaw(ctx, contractAddr, []byte("foo")) != nil)
+		})
+	}
+}
+
+func TestDemoteLastCriticalPrivilegeRejected(t *testing.T) {
+	specs := map[string]struct {
+		privilegeType types.PrivilegeType
+		otherContract bool
+		expErr        bool
+	}{
+		"last validator set updater": {
+			privilegeType: types.PrivilegeTypeValidatorSetUpdate,
+			expErr:        true,
+		},
+		"last gov proposal executor": {
+			privilegeType: types.PrivilegeTypeGovProposalExecutor,
+			expErr:        true,
+		},
+		"other gov proposal executor remains": {
+			privilegeType: types.PrivilegeTypeGovProposalExecutor,
+			otherContract: true,
+		},
+		"non critical privilege": {
+			privilegeType: types.PrivilegeTypeBeginBlock,
+		},
+	}
+	for name, spec := range specs {
+		for _, force := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s - force: %t", name, force), func(t *testing.T) {
+				mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+					m.UnpinFn = func(checksum cosmwasm.Checksum) error { return nil }
+					m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+						return &wasmvmtypes.Response{}, 0, nil
+					}
+				})
+				ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+				k := keepers.TWasmKeeper
+				h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+				_, contractAddr := seedTestContract(t, ctx, k)
+				k.setPrivilegedFlag(ctx, contractAddr)
+				require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: spec.privilegeType}))
+				if spec.otherContract {
+					_, otherAddr := seedTestContract(t, ctx, k)
+					k.setPrivilegedFlag(ctx, otherAddr)
+					require.NoError(t, h.handlePrivilege(ctx, otherAddr, &contract.PrivilegeMsg{Request: spec.privilegeType}))
+				}
+
+				// when
+				var gotErr error
+				if force {
+					gotErr = k.ForceUnsetPrivileged(ctx, contractAddr)
+				} else {
+					gotErr = k.UnsetPrivileged(ctx, contractAddr)
+				}
+
+				// then
+				if spec.expErr {
+					require.True(t, sdkerrors.ErrInvalidRequest.Is(gotErr), "got %#+v", gotErr)
+					assert.True(t, k.IsPrivileged(ctx, contractAddr))
+					return
+				}
+				require.NoError(t, gotErr)
+				assert.False(t, k.IsPrivileged(ctx, contractAddr))
+			})
+		}
+	}
+}
+
 func TestIteratePrivileged(t *testing.T) {
 	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
 	k := keepers.TWasmKeeper
@@ -13,7 +13,10 @@ import (
 // govKeeper is a subset of Keeper that is needed for the gov proposal handling
 type govKeeper interface {
 	SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetAllowedPrivileges(ctx sdk.Context, contractAddr sdk.AccAddress, allowed []string) error
+	SetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error
 	UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	ForceUnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error
 }
 
 // NewProposalHandler creates a new governance Handler for wasm proposals
@@ -55,8 +58,14 @@ func handlePromoteContractProposal(ctx sdk.Context, k govKeeper, p types.Promote
 	if err != nil {
 		return sdkerrors.Wrap(err, "contract address")
 	}
-
-	return k.SetPrivileged(ctx, contractAddr)
+	// set before the promotion so that the contract can register for allowed privileges only
+	if err := k.SetAllowedPrivileges(ctx, contractAddr, p.AllowedPrivileges); err != nil {
+		return sdkerrors.Wrap(err, "allowed privileges")
+	}
+	if err := k.SetPrivileged(ctx, contractAddr); err != nil {
+		return err
+	}
+	return sdkerrors.Wrap(k.SetPrivilegeExpiry(ctx, contractAddr, p.Expiry), "expiry")
 }
 
 func handleDemoteContractProposal(ctx sdk.Context, k govKeeper, p types.DemotePrivilegedContractProposal) error {
@@ -67,6 +76,18 @@ func handleDemoteContractProposal(ctx sdk.Context, k govKeeper, p types.DemotePr
 	if err != nil {
 		return sdkerrors.Wrap(err, "contract address")
 	}
-
+	if p.Replacement != "" {
+		replacementAddr, err := sdk.AccAddressFromBech32(p.Replacement)
+		if err != nil {
+			return sdkerrors.Wrap(err, "replacement address")
+		}
+		// promoted first so that it can register for the critical privileges of the demoted contract
+		if err := k.SetPrivileged(ctx, replacementAddr); err != nil {
+			return sdkerrors.Wrap(err, "replacement")
+		}
+	}
+	if p.Force {
+		return k.ForceUnsetPrivileged(ctx, contractAddr)
+	}
 	return k.UnsetPrivileged(ctx, contractAddr)
 }
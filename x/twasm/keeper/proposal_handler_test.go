@@ -17,7 +17,10 @@ import (
 func TestGovHandler(t *testing.T) {
 	var (
 		myAddr                sdk.AccAddress = rand.Bytes(address.Len)
+		myReplacementAddr     sdk.AccAddress = rand.Bytes(address.Len)
 		capturedContractAddrs []sdk.AccAddress
+		capturedAllowed       []string
+		capturedExpiry        *types.PrivilegeExpiry
 	)
 	notHandler := func(ctx sdk.Context, content govtypes.Content) error {
 		return sdkerrors.ErrUnknownRequest
@@ -29,6 +32,8 @@ func TestGovHandler(t *testing.T) {
 		srcProposal           govtypes.Content
 		expErr                *sdkerrors.Error
 		expCapturedAddrs      []sdk.AccAddress
+		expCapturedAllowed    []string
+		expCapturedExpiry     *types.PrivilegeExpiry
 		expCapturedGovContent []govtypes.Content
 	}{
 		"handled in wasm": {
@@ -60,6 +65,52 @@ func TestGovHandler(t *testing.T) {
 			}),
 			expCapturedAddrs: []sdk.AccAddress{myAddr},
 		},
+		"promote proposal with allowed privileges": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal: types.PromoteProposalFixture(func(proposal *types.PromoteToPrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.AllowedPrivileges = []string{"begin_blocker", "end_blocker"}
+			}),
+			expCapturedAddrs:   []sdk.AccAddress{myAddr},
+			expCapturedAllowed: []string{"begin_blocker", "end_blocker"},
+		},
+		"promote proposal with expiry": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal: types.PromoteProposalFixture(func(proposal *types.PromoteToPrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.Expiry = &types.PrivilegeExpiry{Height: 100}
+			}),
+			expCapturedAddrs:  []sdk.AccAddress{myAddr},
+			expCapturedExpiry: &types.PrivilegeExpiry{Height: 100},
+		},
+		"promote proposal fails": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					return sdkerrors.ErrInvalidRequest
+				}
+				m.SetPrivilegeExpiryFn = func(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error {
+					panic("not expected to be called")
+				}
+			},
+			srcProposal: types.PromoteProposalFixture(func(proposal *types.PromoteToPrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.Expiry = &types.PrivilegeExpiry{Height: 100}
+			}),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
 		"invalid promote proposal rejected": {
 			wasmHandler: notHandler,
 			srcProposal: &types.PromoteToPrivilegedContractProposal{},
@@ -78,6 +129,53 @@ func TestGovHandler(t *testing.T) {
 			}),
 			expCapturedAddrs: []sdk.AccAddress{myAddr},
 		},
+		"force demote proposal": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.ForceUnsetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal: types.DemoteProposalFixture(func(proposal *types.DemotePrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.Force = true
+			}),
+			expCapturedAddrs: []sdk.AccAddress{myAddr},
+		},
+		"force demote proposal with replacement": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+				m.ForceUnsetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					capturedContractAddrs = append(capturedContractAddrs, contractAddr)
+					return nil
+				}
+			},
+			srcProposal: types.DemoteProposalFixture(func(proposal *types.DemotePrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.Force = true
+				proposal.Replacement = myReplacementAddr.String()
+			}),
+			expCapturedAddrs: []sdk.AccAddress{myReplacementAddr, myAddr},
+		},
+		"replacement promotion fails": {
+			wasmHandler: notHandler,
+			setupGovKeeper: func(m *MockGovKeeper) {
+				m.SetPrivilegedFn = func(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+					return sdkerrors.ErrInvalidRequest
+				}
+			},
+			srcProposal: types.DemoteProposalFixture(func(proposal *types.DemotePrivilegedContractProposal) {
+				proposal.Contract = myAddr.String()
+				proposal.Force = true
+				proposal.Replacement = myReplacementAddr.String()
+			}),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
 		"invalid demote proposal rejected": {
 			wasmHandler: notHandler,
 			srcProposal: &types.DemotePrivilegedContractProposal{},
@@ -91,8 +189,19 @@ func TestGovHandler(t *testing.T) {
 	var ctx sdk.Context
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			capturedContractAddrs = nil
-			var mock MockGovKeeper
+			capturedContractAddrs, capturedAllowed, capturedExpiry = nil, nil, nil
+			mock := MockGovKeeper{
+				SetAllowedPrivilegesFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, allowed []string) error {
+					require.Equal(t, myAddr, contractAddr)
+					capturedAllowed = allowed
+					return nil
+				},
+				SetPrivilegeExpiryFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error {
+					require.Equal(t, myAddr, contractAddr)
+					capturedExpiry = expiry
+					return nil
+				},
+			}
 			if spec.setupGovKeeper != nil {
 				spec.setupGovKeeper(&mock)
 			}
@@ -103,14 +212,19 @@ func TestGovHandler(t *testing.T) {
 			// then
 			require.True(t, spec.expErr.Is(gotErr), "exp %v but got #+v", spec.expErr, gotErr)
 			assert.Equal(t, spec.expCapturedAddrs, capturedContractAddrs)
+			assert.Equal(t, spec.expCapturedAllowed, capturedAllowed)
+			assert.Equal(t, spec.expCapturedExpiry, capturedExpiry)
 			assert.Equal(t, spec.expCapturedGovContent, router.captured)
 		})
 	}
 }
 
 type MockGovKeeper struct {
-	SetPrivilegedFn   func(ctx sdk.Context, contractAddr sdk.AccAddress) error
-	UnsetPrivilegedFn func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetPrivilegedFn        func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	UnsetPrivilegedFn      func(ctx sdk.Context, contractAddr sdk.AccAddress) error
+	SetAllowedPrivilegesFn func(ctx sdk.Context, contractAddr sdk.AccAddress, allowed []string) error
+	SetPrivilegeExpiryFn   func(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error
+	ForceUnsetPrivilegedFn func(ctx sdk.Context, contractAddr sdk.AccAddress) error
 }
 
 func (m MockGovKeeper) SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
@@ -120,6 +234,20 @@ func (m MockGovKeeper) SetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddres
 	return m.SetPrivilegedFn(ctx, contractAddr)
 }
 
+func (m MockGovKeeper) SetAllowedPrivileges(ctx sdk.Context, contractAddr sdk.AccAddress, allowed []string) error {
+	if m.SetAllowedPrivilegesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetAllowedPrivilegesFn(ctx, contractAddr, allowed)
+}
+
+func (m MockGovKeeper) SetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress, expiry *types.PrivilegeExpiry) error {
+	if m.SetPrivilegeExpiryFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetPrivilegeExpiryFn(ctx, contractAddr, expiry)
+}
+
 func (m MockGovKeeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
 	if m.UnsetPrivilegedFn == nil {
 		panic("not expected to be called")
@@ -127,6 +255,13 @@ func (m MockGovKeeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddr
 	return m.UnsetPrivilegedFn(ctx, contractAddr)
 }
 
+func (m MockGovKeeper) ForceUnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if m.ForceUnsetPrivilegedFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ForceUnsetPrivilegedFn(ctx, contractAddr)
+}
+
 type CapturingGovRouter struct {
 	govtypes.Router
 	captured []govtypes.Content
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,7 +16,11 @@ var _ types.QueryServer = &Querier{}
 // queryKeeper is a subset of the keeper's methods
 type queryKeeper interface {
 	IteratePrivileged(ctx sdk.Context, cb func(sdk.AccAddress) bool)
+	GetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PrivilegeExpiry
 	IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool)
+	PaginatedScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ScheduledCallback, *query.PageResponse, error)
+	ContractMintedSupply(ctx sdk.Context, contractAddr sdk.AccAddress) []types.MintedSupply
 }
 type Querier struct {
 	keeper queryKeeper
@@ -27,8 +32,15 @@ func NewQuerier(keeper queryKeeper) *Querier {
 
 func (q Querier) PrivilegedContracts(c context.Context, _ *types.QueryPrivilegedContractsRequest) (*types.QueryPrivilegedContractsResponse, error) {
 	var result types.QueryPrivilegedContractsResponse
-	q.keeper.IteratePrivileged(sdk.UnwrapSDKContext(c), func(address sdk.AccAddress) bool {
+	ctx := sdk.UnwrapSDKContext(c)
+	q.keeper.IteratePrivileged(ctx, func(address sdk.AccAddress) bool {
 		result.Contracts = append(result.Contracts, address.String())
+		if expiry := q.keeper.GetPrivilegeExpiry(ctx, address); expiry != nil {
+			result.Expiries = append(result.Expiries, types.PrivilegedContractExpiry{
+				ContractAddress: address.String(),
+				Expiry:          *expiry,
+			})
+		}
 		return false
 	})
 	return &result, nil
@@ -46,3 +58,42 @@ func (q Querier) ContractsByPrivilegeType(c context.Context, req *types.QueryCon
 	})
 	return &result, nil
 }
+
+func (q Querier) CallbackFailures(c context.Context, _ *types.QueryCallbackFailuresRequest) (*types.QueryCallbackFailuresResponse, error) {
+	var result types.QueryCallbackFailuresResponse
+	q.keeper.IterateCallbackFailures(sdk.UnwrapSDKContext(c), func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool {
+		result.Counters = append(result.Counters, types.CallbackFailureCounter{
+			ContractAddress: contractAddr.String(),
+			PrivilegeType:   privilegeType.String(),
+			Failures:        failures,
+		})
+		return false
+	})
+	return &result, nil
+}
+
+func (q Querier) ScheduledCallbacks(c context.Context, req *types.QueryScheduledCallbacksRequest) (*types.QueryScheduledCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "contract address")
+	}
+	callbacks, pageRes, err := q.keeper.PaginatedScheduledCallbacks(sdk.UnwrapSDKContext(c), contractAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryScheduledCallbacksResponse{Callbacks: callbacks, Pagination: pageRes}, nil
+}
+
+func (q Querier) MintedSupply(c context.Context, req *types.QueryMintedSupplyRequest) (*types.QueryMintedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "contract address")
+	}
+	return &types.QueryMintedSupplyResponse{Supply: q.keeper.ContractMintedSupply(sdk.UnwrapSDKContext(c), contractAddr)}, nil
+}
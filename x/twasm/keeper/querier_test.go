@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,8 +17,9 @@ func TestQueryPrivilegedContracts(t *testing.T) {
 	addr2 := RandomAddress(t)
 
 	specs := map[string]struct {
-		state  []sdk.AccAddress
-		expRsp *types.QueryPrivilegedContractsResponse
+		state    []sdk.AccAddress
+		expiries map[string]*types.PrivilegeExpiry
+		expRsp   *types.QueryPrivilegedContractsResponse
 	}{
 		"none found": {
 			expRsp: &types.QueryPrivilegedContractsResponse{},
@@ -34,6 +36,16 @@ func TestQueryPrivilegedContracts(t *testing.T) {
 				Contracts: []string{addr1.String(), addr2.String()},
 			},
 		},
+		"with expiry": {
+			state:    []sdk.AccAddress{addr1, addr2},
+			expiries: map[string]*types.PrivilegeExpiry{addr2.String(): {Height: 100}},
+			expRsp: &types.QueryPrivilegedContractsResponse{
+				Contracts: []string{addr1.String(), addr2.String()},
+				Expiries: []types.PrivilegedContractExpiry{
+					{ContractAddress: addr2.String(), Expiry: types.PrivilegeExpiry{Height: 100}},
+				},
+			},
+		},
 	}
 	ctx := sdk.Context{}.WithContext(context.Background())
 	for name, spec := range specs {
@@ -46,6 +58,9 @@ func TestQueryPrivilegedContracts(t *testing.T) {
 						}
 					}
 				},
+				GetPrivilegeExpiryFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PrivilegeExpiry {
+					return spec.expiries[contractAddr.String()]
+				},
 			}
 
 			q := NewQuerier(mock)
@@ -131,9 +146,177 @@ func TestQueryContractsByPrivilegeType(t *testing.T) {
 	}
 }
 
+func TestQueryCallbackFailures(t *testing.T) {
+	addr1 := RandomAddress(t)
+	addr2 := RandomAddress(t)
+
+	type counter struct {
+		privilegeType types.PrivilegeType
+		addr          sdk.AccAddress
+		failures      uint64
+	}
+	specs := map[string]struct {
+		state  []counter
+		expRsp *types.QueryCallbackFailuresResponse
+	}{
+		"none found": {
+			expRsp: &types.QueryCallbackFailuresResponse{},
+		},
+		"multiple found": {
+			state: []counter{{types.PrivilegeTypeBeginBlock, addr1, 1}, {types.PrivilegeTypeEndBlock, addr2, 2}},
+			expRsp: &types.QueryCallbackFailuresResponse{
+				Counters: []types.CallbackFailureCounter{
+					{ContractAddress: addr1.String(), PrivilegeType: "begin_blocker", Failures: 1},
+					{ContractAddress: addr2.String(), PrivilegeType: "end_blocker", Failures: 2},
+				},
+			},
+		},
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockQueryKeeper{
+				IterateCallbackFailuresFn: func(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool) {
+					for _, c := range spec.state {
+						if cb(c.privilegeType, c.addr, c.failures) {
+							return
+						}
+					}
+				},
+			}
+
+			q := NewQuerier(mock)
+			// when
+			gotRsp, gotErr := q.CallbackFailures(sdk.WrapSDKContext(ctx), nil)
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryScheduledCallbacks(t *testing.T) {
+	myAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src    *types.QueryScheduledCallbacksRequest
+		state  []types.ScheduledCallback
+		expRsp *types.QueryScheduledCallbacksResponse
+		expErr bool
+	}{
+		"none found": {
+			src:    &types.QueryScheduledCallbacksRequest{ContractAddress: myAddr.String()},
+			expRsp: &types.QueryScheduledCallbacksResponse{Pagination: &query.PageResponse{}},
+		},
+		"multiple found": {
+			src: &types.QueryScheduledCallbacksRequest{ContractAddress: myAddr.String()},
+			state: []types.ScheduledCallback{
+				{ID: 1, ContractAddress: myAddr.String(), Height: 10},
+				{ID: 2, ContractAddress: myAddr.String(), Height: 20, Interval: 5},
+			},
+			expRsp: &types.QueryScheduledCallbacksResponse{
+				Callbacks: []types.ScheduledCallback{
+					{ID: 1, ContractAddress: myAddr.String(), Height: 10},
+					{ID: 2, ContractAddress: myAddr.String(), Height: 20, Interval: 5},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"invalid address": {
+			src:    &types.QueryScheduledCallbacksRequest{ContractAddress: "invalid"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockQueryKeeper{
+				PaginatedScheduledCallbacksFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ScheduledCallback, *query.PageResponse, error) {
+					require.Equal(t, myAddr, contractAddr)
+					return spec.state, &query.PageResponse{}, nil
+				},
+			}
+
+			q := NewQuerier(mock)
+			// when
+			gotRsp, gotErr := q.ScheduledCallbacks(sdk.WrapSDKContext(ctx), spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryMintedSupply(t *testing.T) {
+	myAddr := RandomAddress(t)
+	specs := map[string]struct {
+		src    *types.QueryMintedSupplyRequest
+		state  []types.MintedSupply
+		expRsp *types.QueryMintedSupplyResponse
+		expErr bool
+	}{
+		"none found": {
+			src:    &types.QueryMintedSupplyRequest{ContractAddress: myAddr.String()},
+			expRsp: &types.QueryMintedSupplyResponse{},
+		},
+		"multiple found": {
+			src: &types.QueryMintedSupplyRequest{ContractAddress: myAddr.String()},
+			state: []types.MintedSupply{
+				{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(1), EpochTotal: sdk.ZeroInt()},
+				{ContractAddress: myAddr.String(), Denom: "blx", Total: sdk.NewInt(3), Epoch: 2, EpochTotal: sdk.NewInt(2)},
+			},
+			expRsp: &types.QueryMintedSupplyResponse{
+				Supply: []types.MintedSupply{
+					{ContractAddress: myAddr.String(), Denom: "alx", Total: sdk.NewInt(1), EpochTotal: sdk.ZeroInt()},
+					{ContractAddress: myAddr.String(), Denom: "blx", Total: sdk.NewInt(3), Epoch: 2, EpochTotal: sdk.NewInt(2)},
+				},
+			},
+		},
+		"invalid address": {
+			src:    &types.QueryMintedSupplyRequest{ContractAddress: "invalid"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := MockQueryKeeper{
+				ContractMintedSupplyFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) []types.MintedSupply {
+					require.Equal(t, myAddr, contractAddr)
+					return spec.state
+				},
+			}
+
+			q := NewQuerier(mock)
+			// when
+			gotRsp, gotErr := q.MintedSupply(sdk.WrapSDKContext(ctx), spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
 type MockQueryKeeper struct {
 	IteratePrivilegedFn              func(ctx sdk.Context, cb func(sdk.AccAddress) bool)
 	IterateContractCallbacksByTypeFn func(ctx sdk.Context, privilegeType types.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool)
+	IterateCallbackFailuresFn        func(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool)
+	GetPrivilegeExpiryFn             func(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PrivilegeExpiry
+	PaginatedScheduledCallbacksFn    func(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ScheduledCallback, *query.PageResponse, error)
+	ContractMintedSupplyFn           func(ctx sdk.Context, contractAddr sdk.AccAddress) []types.MintedSupply
 }
 
 func (m MockQueryKeeper) IteratePrivileged(ctx sdk.Context, cb func(sdk.AccAddress) bool) {
@@ -149,3 +332,31 @@ func (m MockQueryKeeper) IteratePrivilegedContractsByType(ctx sdk.Context, privi
 	}
 	m.IterateContractCallbacksByTypeFn(ctx, privilegeType, cb)
 }
+
+func (m MockQueryKeeper) IterateCallbackFailures(ctx sdk.Context, cb func(privilegeType types.PrivilegeType, contractAddr sdk.AccAddress, failures uint64) bool) {
+	if m.IterateCallbackFailuresFn == nil {
+		panic("not expected to be called")
+	}
+	m.IterateCallbackFailuresFn(ctx, cb)
+}
+
+func (m MockQueryKeeper) GetPrivilegeExpiry(ctx sdk.Context, contractAddr sdk.AccAddress) *types.PrivilegeExpiry {
+	if m.GetPrivilegeExpiryFn == nil {
+		return nil
+	}
+	return m.GetPrivilegeExpiryFn(ctx, contractAddr)
+}
+
+func (m MockQueryKeeper) PaginatedScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ScheduledCallback, *query.PageResponse, error) {
+	if m.PaginatedScheduledCallbacksFn == nil {
+		panic("not expected to be called")
+	}
+	return m.PaginatedScheduledCallbacksFn(ctx, contractAddr, pagination)
+}
+
+func (m MockQueryKeeper) ContractMintedSupply(ctx sdk.Context, contractAddr sdk.AccAddress) []types.MintedSupply {
+	if m.ContractMintedSupplyFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ContractMintedSupplyFn(ctx, contractAddr)
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+const (
+	// MaxScheduledCallbacksPerContract is the max number of pending callbacks a contract can have
+	MaxScheduledCallbacksPerContract = 100
+	// MaxScheduledCallbacksPerBlock is the max number of due callbacks that are delivered in a block
+	MaxScheduledCallbacksPerBlock = 100
+)
+
+// ScheduleCallback stores a new callback for the contract and returns the assigned ID.
+// The callback must be due in a future block.
+func (k Keeper) ScheduleCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) (uint64, error) {
+	callback.ContractAddress = contractAddr.String()
+	callback.ID = k.peekScheduledCallbackID(ctx)
+	if err := callback.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if callback.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+		return 0, sdkerrors.Wrap(wasmtypes.ErrInvalid, "callback must be scheduled for a future block")
+	}
+	var pending int
+	k.IterateContractScheduledCallbacks(ctx, contractAddr, func(types.ScheduledCallback) bool {
+		pending++
+		return false
+	})
+	if pending >= MaxScheduledCallbacksPerContract {
+		return 0, sdkerrors.Wrapf(wasmtypes.ErrLimit, "max %d pending callbacks", MaxScheduledCallbacksPerContract)
+	}
+	k.setScheduledCallbackSequence(ctx, callback.ID)
+	if err := k.storeScheduledCallback(ctx, contractAddr, callback); err != nil {
+		return 0, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeScheduleCallback,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, callback.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(callback.ID, 10)),
+	))
+	return callback.ID, nil
+}
+
+// CancelScheduledCallback removes the pending callback with the given ID from the contract
+func (k Keeper) CancelScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error {
+	callback := k.GetScheduledCallback(ctx, contractAddr, id)
+	if callback == nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrNotFound, "scheduled callback %d", id)
+	}
+	k.removeScheduledCallback(ctx, contractAddr, *callback)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, callback.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+	))
+	return nil
+}
+
+// GetScheduledCallback returns the pending callback of the contract. Result is nil when none exists
+func (k Keeper) GetScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) *types.ScheduledCallback {
+	bz := ctx.KVStore(k.storeKey).Get(scheduledCallbackKey(contractAddr, id))
+	if bz == nil {
+		return nil
+	}
+	var callback types.ScheduledCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return &callback
+}
+
+// DueScheduledCallbacks returns the callbacks that are due at the current block height or time, up to
+// MaxScheduledCallbacksPerBlock. Height based callbacks are returned first. Callbacks above the limit stay due
+// and are returned in the next block.
+func (k Keeper) DueScheduledCallbacks(ctx sdk.Context) []types.ScheduledCallback {
+	var result []types.ScheduledCallback
+	collect := func(prefixStore prefix.Store, end []byte) {
+		iter := prefixStore.Iterator(nil, sdk.PrefixEndBytes(end))
+		defer iter.Close()
+		for ; iter.Valid() && len(result) < MaxScheduledCallbacksPerBlock; iter.Next() {
+			key := iter.Key()
+			id := sdk.BigEndianToUint64(key[len(key)-8:])
+			if callback := k.GetScheduledCallback(ctx, iter.Value(), id); callback != nil {
+				result = append(result, *callback)
+			}
+		}
+	}
+	store := ctx.KVStore(k.storeKey)
+	collect(prefix.NewStore(store, scheduledCallbackHeightIndexPrefix), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	collect(prefix.NewStore(store, scheduledCallbackTimeIndexPrefix), sdk.FormatTimeBytes(ctx.BlockTime()))
+	return result
+}
+
+// CompleteScheduledCallback removes the delivered callback. Recurring callbacks are stored again with
+// their next occurrence after the current block.
+func (k Keeper) CompleteScheduledCallback(ctx sdk.Context, callback types.ScheduledCallback) error {
+	contractAddr, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	k.removeScheduledCallback(ctx, contractAddr, callback)
+	next, ok := callback.Next(ctx.BlockHeight(), ctx.BlockTime())
+	if !ok {
+		return nil
+	}
+	return k.storeScheduledCallback(ctx, contractAddr, next)
+}
+
+// IterateContractScheduledCallbacks iterates over the pending callbacks of the contract ordered by ID.
+// The callback returns true to stop early
+func (k Keeper) IterateContractScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(types.ScheduledCallback) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), getContractScheduledCallbacksPrefix(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.ScheduledCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+// IterateScheduledCallbacks iterates over the pending callbacks of all contracts. The callback returns true to stop early
+func (k Keeper) IterateScheduledCallbacks(ctx sdk.Context, cb func(types.ScheduledCallback) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), scheduledCallbacksPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.ScheduledCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+// PaginatedScheduledCallbacks returns a page of the pending callbacks of the contract ordered by ID
+func (k Keeper) PaginatedScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ScheduledCallback, *query.PageResponse, error) {
+	var result []types.ScheduledCallback
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), getContractScheduledCallbacksPrefix(contractAddr))
+	pageRes, err := query.Paginate(prefixStore, pagination, func(_, value []byte) error {
+		var callback types.ScheduledCallback
+		if err := k.cdc.Unmarshal(value, &callback); err != nil {
+			return err
+		}
+		result = append(result, callback)
+		return nil
+	})
+	return result, pageRes, err
+}
+
+// importScheduledCallback stores the callback from genesis and moves the ID sequence forward when required
+func (k Keeper) importScheduledCallback(ctx sdk.Context, callback types.ScheduledCallback) error {
+	if err := callback.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callback.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if k.GetScheduledCallback(ctx, contractAddr, callback.ID) != nil {
+		return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "scheduled callback %d", callback.ID)
+	}
+	if callback.ID >= k.peekScheduledCallbackID(ctx) {
+		k.setScheduledCallbackSequence(ctx, callback.ID)
+	}
+	return k.storeScheduledCallback(ctx, contractAddr, callback)
+}
+
+// removeScheduledCallbacks removes all pending callbacks of the contract
+func (k Keeper) removeScheduledCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	var callbacks []types.ScheduledCallback
+	k.IterateContractScheduledCallbacks(ctx, contractAddr, func(callback types.ScheduledCallback) bool {
+		callbacks = append(callbacks, callback)
+		return false
+	})
+	for _, callback := range callbacks {
+		k.removeScheduledCallback(ctx, contractAddr, callback)
+	}
+}
+
+// storeScheduledCallback persists the callback and adds it to the due index
+func (k Keeper) storeScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) error {
+	bz, err := k.cdc.Marshal(&callback)
+	if err != nil {
+		return sdkerrors.Wrap(err, "marshal scheduled callback")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(scheduledCallbackKey(contractAddr, callback.ID), bz)
+	store.Set(scheduledCallbackIndexKey(callback), contractAddr)
+	return nil
+}
+
+// removeScheduledCallback deletes the callback and removes it from the due index
+func (k Keeper) removeScheduledCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.ScheduledCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(scheduledCallbackKey(contractAddr, callback.ID))
+	store.Delete(scheduledCallbackIndexKey(callback))
+}
+
+// peekScheduledCallbackID returns the next ID without incrementing the sequence
+func (k Keeper) peekScheduledCallbackID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(scheduledCallbackSequenceKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz) + 1
+}
+
+// setScheduledCallbackSequence stores the last assigned ID
+func (k Keeper) setScheduledCallbackSequence(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(scheduledCallbackSequenceKey, sdk.Uint64ToBigEndian(id))
+}
+
+// scheduledCallbackKey returns the key for the callback
+// `<prefix><len(contractAddr)><contractAddr><id>`
+func scheduledCallbackKey(contractAddr sdk.AccAddress, id uint64) []byte {
+	return append(getContractScheduledCallbacksPrefix(contractAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// getContractScheduledCallbacksPrefix returns the prefix for all callbacks of the contract
+// `<prefix><len(contractAddr)><contractAddr>`
+func getContractScheduledCallbacksPrefix(contractAddr sdk.AccAddress) []byte {
+	r := append([]byte{}, scheduledCallbacksPrefix...)
+	return append(r, address.MustLengthPrefix(contractAddr)...)
+}
+
+// scheduledCallbackIndexKey returns the key for the due index
+// `<heightPrefix><height><id>` or `<timePrefix><time><id>`
+func scheduledCallbackIndexKey(callback types.ScheduledCallback) []byte {
+	var r []byte
+	if callback.Time != nil && !callback.Time.IsZero() {
+		r = append(r, scheduledCallbackTimeIndexPrefix...)
+		r = append(r, sdk.FormatTimeBytes(*callback.Time)...)
+	} else {
+		r = append(r, scheduledCallbackHeightIndexPrefix...)
+		r = append(r, sdk.Uint64ToBigEndian(callback.Height)...)
+	}
+	return append(r, sdk.Uint64ToBigEndian(callback.ID)...)
+}
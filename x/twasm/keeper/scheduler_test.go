@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/twasm/contract"
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestScheduleCallback(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	laterTime := myTime.Add(time.Hour)
+	specs := map[string]struct {
+		src      types.ScheduledCallback
+		existing int
+		expErr   *sdkerrors.Error
+		expID    uint64
+	}{
+		"height": {
+			src:   types.ScheduledCallback{Height: 101},
+			expID: 1,
+		},
+		"time": {
+			src:   types.ScheduledCallback{Time: &laterTime, Interval: 60},
+			expID: 1,
+		},
+		"sequence continues": {
+			src:      types.ScheduledCallback{Height: 101},
+			existing: 2,
+			expID:    3,
+		},
+		"height reached": {
+			src:    types.ScheduledCallback{Height: 100},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"time reached": {
+			src:    types.ScheduledCallback{Time: &myTime},
+			expErr: wasmtypes.ErrInvalid,
+		},
+		"empty": {
+			src:    types.ScheduledCallback{},
+			expErr: wasmtypes.ErrEmpty,
+		},
+		"max pending reached": {
+			src:      types.ScheduledCallback{Height: 101},
+			existing: MaxScheduledCallbacksPerContract,
+			expErr:   wasmtypes.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t)
+			k := keepers.TWasmKeeper
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(myTime)
+			myAddr := RandomAddress(t)
+			for i := 0; i < spec.existing; i++ {
+				_, err := k.ScheduleCallback(ctx, myAddr, types.ScheduledCallback{Height: 200})
+				require.NoError(t, err)
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			gotID, gotErr := k.ScheduleCallback(ctx, myAddr, spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %#+v", spec.expErr, gotErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expID, gotID)
+			exp := spec.src
+			exp.ID, exp.ContractAddress = spec.expID, myAddr.String()
+			assert.Equal(t, &exp, k.GetScheduledCallback(ctx, myAddr, gotID))
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeScheduleCallback, em.Events()[0].Type)
+		})
+	}
+}
+
+func TestCancelScheduledCallback(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	myAddr, otherAddr := RandomAddress(t), RandomAddress(t)
+	id, err := k.ScheduleCallback(ctx, myAddr, types.ScheduledCallback{Height: 101})
+	require.NoError(t, err)
+
+	// when canceled by other contract
+	gotErr := k.CancelScheduledCallback(ctx, otherAddr, id)
+	// then
+	require.True(t, wasmtypes.ErrNotFound.Is(gotErr))
+	assert.NotNil(t, k.GetScheduledCallback(ctx, myAddr, id))
+
+	// when canceled by owner
+	require.NoError(t, k.CancelScheduledCallback(ctx, myAddr, id))
+	// then
+	assert.Nil(t, k.GetScheduledCallback(ctx, myAddr, id))
+	assert.Empty(t, k.DueScheduledCallbacks(ctx.WithBlockHeight(101)))
+}
+
+func TestDueScheduledCallbacks(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	laterTime := myTime.Add(time.Hour)
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(myTime)
+	myAddr := RandomAddress(t)
+	for _, c := range []types.ScheduledCallback{
+		{Time: &laterTime},
+		{Height: 102},
+		{Height: 101, Interval: 10},
+	} {
+		_, err := k.ScheduleCallback(ctx, myAddr, c)
+		require.NoError(t, err)
+	}
+	specs := map[string]struct {
+		height    int64
+		blockTime time.Time
+		expIDs    []uint64
+	}{
+		"none due": {
+			height:    100,
+			blockTime: myTime,
+		},
+		"height": {
+			height:    101,
+			blockTime: myTime,
+			expIDs:    []uint64{3},
+		},
+		"heights in order": {
+			height:    102,
+			blockTime: myTime,
+			expIDs:    []uint64{3, 2},
+		},
+		"heights before time": {
+			height:    102,
+			blockTime: laterTime,
+			expIDs:    []uint64{3, 2, 1},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotIDs []uint64
+			for _, c := range k.DueScheduledCallbacks(ctx.WithBlockHeight(spec.height).WithBlockTime(spec.blockTime)) {
+				gotIDs = append(gotIDs, c.ID)
+			}
+			assert.Equal(t, spec.expIDs, gotIDs)
+		})
+	}
+}
+
+func TestDueScheduledCallbacksLimitPerBlock(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	// more callbacks than a single contract can have
+	for i := 0; i < MaxScheduledCallbacksPerBlock+1; i++ {
+		_, err := k.ScheduleCallback(ctx, RandomAddress(t), types.ScheduledCallback{Height: 101})
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(101)
+
+	// when
+	got := k.DueScheduledCallbacks(ctx)
+
+	// then
+	require.Len(t, got, MaxScheduledCallbacksPerBlock)
+	for _, c := range got {
+		require.NoError(t, k.CompleteScheduledCallback(ctx, c))
+	}
+	// and the remaining one is due in the next block
+	got = k.DueScheduledCallbacks(ctx.WithBlockHeight(102))
+	require.Len(t, got, 1)
+	assert.Equal(t, uint64(MaxScheduledCallbacksPerBlock+1), got[0].ID)
+}
+
+func TestCompleteScheduledCallback(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	myAddr := RandomAddress(t)
+	oneShotID, err := k.ScheduleCallback(ctx, myAddr, types.ScheduledCallback{Height: 101})
+	require.NoError(t, err)
+	recurringID, err := k.ScheduleCallback(ctx, myAddr, types.ScheduledCallback{Height: 101, Interval: 10})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(101)
+
+	// when
+	for _, c := range k.DueScheduledCallbacks(ctx) {
+		require.NoError(t, k.CompleteScheduledCallback(ctx, c))
+	}
+
+	// then
+	assert.Nil(t, k.GetScheduledCallback(ctx, myAddr, oneShotID))
+	exp := types.ScheduledCallback{ID: recurringID, ContractAddress: myAddr.String(), Height: 111, Interval: 10}
+	assert.Equal(t, &exp, k.GetScheduledCallback(ctx, myAddr, recurringID))
+	assert.Empty(t, k.DueScheduledCallbacks(ctx.WithBlockHeight(110)))
+	assert.Equal(t, []types.ScheduledCallback{exp}, k.DueScheduledCallbacks(ctx.WithBlockHeight(111)))
+}
+
+func TestReleaseSchedulerPrivilegeRemovesCallbacks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	_, contractAddr := seedTestContract(t, ctx, k)
+	k.setPrivilegedFlag(ctx, contractAddr)
+	h := NewTgradeHandler(nil, k, nil, nil, nil, nil)
+	require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: types.PrivilegeTypeScheduler}))
+	otherAddr := RandomAddress(t)
+	_, err := k.ScheduleCallback(ctx, contractAddr, types.ScheduledCallback{Height: 101})
+	require.NoError(t, err)
+	otherID, err := k.ScheduleCallback(ctx, otherAddr, types.ScheduledCallback{Height: 101})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Release: types.PrivilegeTypeScheduler}))
+
+	// then
+	due := k.DueScheduledCallbacks(ctx.WithBlockHeight(101))
+	require.Len(t, due, 1)
+	assert.Equal(t, otherID, due[0].ID)
+}
+
+func TestImportScheduledCallback(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.TWasmKeeper
+	ctx = ctx.WithBlockHeight(100)
+	myAddr := RandomAddress(t)
+	src := types.ScheduledCallback{ID: 5, ContractAddress: myAddr.String(), Height: 90}
+
+	// when
+	require.NoError(t, k.importScheduledCallback(ctx, src))
+
+	// then
+	assert.Equal(t, []types.ScheduledCallback{src}, k.DueScheduledCallbacks(ctx))
+	// and duplicates rejected
+	require.Error(t, k.importScheduledCallback(ctx, src))
+	// and sequence continues after imported ID
+	gotID, err := k.ScheduleCallback(ctx, myAddr, types.ScheduledCallback{Height: 101})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), gotID)
+}
@@ -6,4 +6,12 @@ var (
 
 	privilegedContractsSecondaryIndexPrefix = []byte{0xa0}
 	contractCallbacksSecondaryIndexPrefix   = []byte{0xa1}
+	callbackFailuresPrefix                  = []byte{0xa2}
+	privilegeExpiryHeightIndexPrefix        = []byte{0xa3}
+	privilegeExpiryTimeIndexPrefix          = []byte{0xa4}
+	scheduledCallbackSequenceKey            = []byte{0xa5}
+	scheduledCallbacksPrefix                = []byte{0xa6}
+	scheduledCallbackHeightIndexPrefix      = []byte{0xa7}
+	scheduledCallbackTimeIndexPrefix        = []byte{0xa8}
+	mintedSupplyPrefix                      = []byte{0xa9}
 )
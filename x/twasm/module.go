@@ -60,7 +60,8 @@ func (AppModuleBasic) Name() string {
 // module.
 func (b AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&types.GenesisState{
-		Params: wasmtypes.DefaultParams(),
+		Params:       wasmtypes.DefaultParams(),
+		TgradeParams: types.DefaultTgradeParams(),
 	})
 }
 
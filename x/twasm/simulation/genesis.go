@@ -19,6 +19,7 @@ func RandomizedGenState(simstate *module.SimulationState) {
 		GenMsgs:                     nil,
 		PrivilegedContractAddresses: nil,
 		PinnedCodeIDs:               nil,
+		TgradeParams:                types.DefaultTgradeParams(),
 	}
 
 	simstate.GenState[wasmtypes.ModuleName] = simstate.Cdc.MustMarshalJSON(&twasmGenesis)
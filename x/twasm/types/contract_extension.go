@@ -1,28 +1,33 @@
 package types
 
 import (
+	"fmt"
 	"math"
+	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // AddRegisteredPrivilege add privilege type to list
 func (d *TgradeContractDetails) AddRegisteredPrivilege(t PrivilegeType, pos uint8) {
+	d.AddRegisteredPrivilegeWithGasLimit(t, pos, 0)
+}
+
+// AddRegisteredPrivilegeWithGasLimit add privilege type with a custom callback gas limit to list
+func (d *TgradeContractDetails) AddRegisteredPrivilegeWithGasLimit(t PrivilegeType, pos uint8, gasLimit uint64) {
 	d.RegisteredPrivileges = append(d.RegisteredPrivileges, RegisteredPrivilege{
 		PrivilegeType: t.String(),
 		Position:      uint32(pos),
+		GasLimit:      gasLimit,
 	})
 }
 
 // RemoveRegisteredPrivilege remove privilege type from list
 func (d *TgradeContractDetails) RemoveRegisteredPrivilege(t PrivilegeType, pos uint8) {
-	src := &RegisteredPrivilege{
-		PrivilegeType: t.String(),
-		Position:      uint32(pos),
-	}
 	for i, v := range d.RegisteredPrivileges {
-		if src.Equal(v) {
+		if v.PrivilegeType == t.String() && v.Position == uint32(pos) {
 			d.RegisteredPrivileges = append(d.RegisteredPrivileges[0:i], d.RegisteredPrivileges[i+1:]...)
 		}
 	}
@@ -38,6 +43,49 @@ func (d *TgradeContractDetails) HasRegisteredPrivilege(c PrivilegeType) bool {
 	return false
 }
 
+// RegisteredGasLimit returns the callback gas limit set on registration for the given type. 0 when none set
+func (d TgradeContractDetails) RegisteredGasLimit(c PrivilegeType) uint64 {
+	for _, v := range d.RegisteredPrivileges {
+		if v.PrivilegeType == c.String() {
+			return v.GasLimit
+		}
+	}
+	return 0
+}
+
+// EnableLiveness opts the registration for the privilege type in to liveness data in the callbacks
+func (d *TgradeContractDetails) EnableLiveness(c PrivilegeType) {
+	for i, v := range d.RegisteredPrivileges {
+		if v.PrivilegeType == c.String() {
+			d.RegisteredPrivileges[i].IncludeLiveness = true
+		}
+	}
+}
+
+// IncludesLiveness returns true when the registration for the privilege type opted in to liveness data
+func (d TgradeContractDetails) IncludesLiveness(c PrivilegeType) bool {
+	for _, v := range d.RegisteredPrivileges {
+		if v.PrivilegeType == c.String() {
+			return v.IncludeLiveness
+		}
+	}
+	return false
+}
+
+// IsPrivilegeAllowed returns true when the contract can register for the given type. All types are allowed
+// when no allow list is set.
+func (d TgradeContractDetails) IsPrivilegeAllowed(c PrivilegeType) bool {
+	if len(d.AllowedPrivileges) == 0 {
+		return true
+	}
+	for _, v := range d.AllowedPrivileges {
+		if v == c.String() {
+			return true
+		}
+	}
+	return false
+}
+
 func (d TgradeContractDetails) IterateRegisteredPrivileges(cb func(c PrivilegeType, pos uint8) bool) {
 	for _, v := range d.RegisteredPrivileges {
 		if cb(*PrivilegeTypeFrom(v.PrivilegeType), uint8(v.Position)) {
@@ -59,6 +107,26 @@ func (d TgradeContractDetails) ValidateBasic() error {
 		}
 		unique[privilegeType] = struct{}{}
 	}
+	if d.Expiry != nil {
+		if err := d.Expiry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "expiry")
+		}
+	}
+	return sdkerrors.Wrap(ValidatePrivilegeTypeNames(d.AllowedPrivileges), "allowed privileges")
+}
+
+// ValidatePrivilegeTypeNames ensures that all names are known privilege types and unique
+func ValidatePrivilegeTypeNames(names []string) error {
+	unique := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		if PrivilegeTypeFrom(n) == nil {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "privilege type %q", n)
+		}
+		if _, exists := unique[n]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "privilege type %q", n)
+		}
+		unique[n] = struct{}{}
+	}
 	return nil
 }
 
@@ -74,5 +142,138 @@ func (r RegisteredPrivilege) ValidateBasic() error {
 	if tp == nil {
 		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "privilege type")
 	}
+	if r.IncludeLiveness && *tp != PrivilegeTypeBeginBlock {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "liveness data only supported for begin blocker")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (e PrivilegeExpiry) ValidateBasic() error {
+	hasTime := e.Time != nil && !e.Time.IsZero()
+	switch {
+	case e.Height == 0 && !hasTime:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "height or time")
+	case e.Height != 0 && hasTime:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "either height or time must be set")
+	}
+	return nil
+}
+
+// IsExpired returns true when the deadline is reached for the given block height and time
+func (e PrivilegeExpiry) IsExpired(height int64, blockTime time.Time) bool {
+	if e.Time != nil && !e.Time.IsZero() {
+		return !blockTime.Before(*e.Time)
+	}
+	return height >= 0 && uint64(height) >= e.Height
+}
+
+// Description returns a short human-readable representation
+func (e PrivilegeExpiry) Description() string {
+	if e.Time != nil && !e.Time.IsZero() {
+		return fmt.Sprintf("time %s", e.Time.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("height %d", e.Height)
+}
+
+const (
+	// MaxScheduledCallbackHeight is the highest block height and height interval for a scheduled callback
+	MaxScheduledCallbackHeight uint64 = math.MaxInt64
+	// MaxScheduledCallbackInterval is the longest time interval in seconds for a scheduled callback
+	MaxScheduledCallbackInterval = uint64(math.MaxInt64 / int64(time.Second))
+)
+
+// MaxScheduledCallbackTime is the latest block time for a scheduled callback. Later times can not be stored as
+// protobuf timestamps.
+var MaxScheduledCallbackTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// ValidateBasic syntax checks
+func (c ScheduledCallback) ValidateBasic() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	hasTime := c.Time != nil && !c.Time.IsZero()
+	switch {
+	case c.Height == 0 && !hasTime:
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "height or time")
+	case c.Height != 0 && hasTime:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "either height or time must be set")
+	case hasTime && c.Time.After(MaxScheduledCallbackTime):
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "time exceeds max")
+	case hasTime && c.Interval > MaxScheduledCallbackInterval:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "interval exceeds max duration")
+	case c.Height > MaxScheduledCallbackHeight:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "height exceeds max")
+	case !hasTime && c.Interval > MaxScheduledCallbackHeight:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "interval exceeds max height")
+	}
+	return nil
+}
+
+// IsDue returns true when the callback is due for the given block height and time
+func (c ScheduledCallback) IsDue(height int64, blockTime time.Time) bool {
+	if c.Time != nil && !c.Time.IsZero() {
+		return !blockTime.Before(*c.Time)
+	}
+	return height >= 0 && uint64(height) >= c.Height
+}
+
+// Next returns the following occurrence of a recurring callback after the given block height and time.
+// Occurrences that were missed are skipped. False is returned for one-shot callbacks and when the following
+// occurrence exceeds the max height or time.
+func (c ScheduledCallback) Next(height int64, blockTime time.Time) (ScheduledCallback, bool) {
+	if c.Interval == 0 {
+		return c, false
+	}
+	next := c
+	if c.Time != nil && !c.Time.IsZero() {
+		if c.Interval > MaxScheduledCallbackInterval {
+			return c, false
+		}
+		interval := time.Duration(c.Interval) * time.Second
+		t := c.Time.Add(interval)
+		if !t.After(blockTime) {
+			t = t.Add((blockTime.Sub(t)/interval + 1) * interval)
+		}
+		if t.After(MaxScheduledCallbackTime) {
+			return c, false
+		}
+		next.Time = &t
+		return next, true
+	}
+	if c.Height > MaxScheduledCallbackHeight || c.Interval > MaxScheduledCallbackHeight-c.Height {
+		return c, false
+	}
+	next.Height = c.Height + c.Interval
+	if height >= 0 && next.Height <= uint64(height) {
+		steps := (uint64(height)-next.Height)/c.Interval + 1
+		if steps > (MaxScheduledCallbackHeight-next.Height)/c.Interval {
+			return c, false
+		}
+		next.Height += steps * c.Interval
+	}
+	return next, true
+}
+
+// ValidateBasic syntax checks
+func (m MintedSupply) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "denom")
+	}
+	if m.Total.IsNil() || m.Total.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "total")
+	}
+	if m.EpochTotal.IsNil() || m.EpochTotal.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "epoch total")
+	}
+	if m.EpochTotal.GT(m.Total) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "epoch total exceeds total")
+	}
 	return nil
 }
@@ -4,14 +4,19 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -20,6 +25,7 @@ var _ = proto.Marshal
 var (
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -31,6 +37,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // TgradeContractDetails is a custom extension to the wasmd ContractInfo
 type TgradeContractDetails struct {
 	RegisteredPrivileges []RegisteredPrivilege `protobuf:"bytes,1,rep,name=registered_privileges,json=registeredPrivileges,proto3" json:"registered_privileges"`
+	// AllowedPrivileges is an optional list of privilege types that the contract
+	// can register for. All privilege types are allowed when empty.
+	AllowedPrivileges []string `protobuf:"bytes,2,rep,name=allowed_privileges,json=allowedPrivileges,proto3" json:"allowed_privileges,omitempty"`
+	// Expiry is an optional deadline after which the contract is demoted
+	// automatically
+	Expiry *PrivilegeExpiry `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
 func (m *TgradeContractDetails) Reset()         { *m = TgradeContractDetails{} }
@@ -75,6 +87,13 @@ var xxx_messageInfo_TgradeContractDetails proto.InternalMessageInfo
 type RegisteredPrivilege struct {
 	Position      uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
 	PrivilegeType string `protobuf:"bytes,2,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	// GasLimit optional gas limit for the abci callbacks of this registration.
+	// It overrides the default limit for the privilege type and can not exceed
+	// the max registered callback gas limit param.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// IncludeLiveness opts in to the last commit votes and proposer address in
+	// the begin block callback. Only supported for begin_blocker.
+	IncludeLiveness bool `protobuf:"varint,4,opt,name=include_liveness,json=includeLiveness,proto3" json:"include_liveness,omitempty"`
 }
 
 func (m *RegisteredPrivilege) Reset()         { *m = RegisteredPrivilege{} }
@@ -115,9 +134,168 @@ func (m *RegisteredPrivilege) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisteredPrivilege proto.InternalMessageInfo
 
+// PrivilegeExpiry is a deadline for the privileged status of a contract. Either
+// height or time is set.
+type PrivilegeExpiry struct {
+	// Height is the block height from which on the contract is demoted
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time from which on the contract is demoted
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *PrivilegeExpiry) Reset()         { *m = PrivilegeExpiry{} }
+func (m *PrivilegeExpiry) String() string { return proto.CompactTextString(m) }
+func (*PrivilegeExpiry) ProtoMessage()    {}
+func (*PrivilegeExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbb24c05a9eda05e, []int{2}
+}
+
+func (m *PrivilegeExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PrivilegeExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivilegeExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PrivilegeExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegeExpiry.Merge(m, src)
+}
+
+func (m *PrivilegeExpiry) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PrivilegeExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegeExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivilegeExpiry proto.InternalMessageInfo
+
+// ScheduledCallback is a sudo callback to a contract with the scheduler
+// privilege. It is delivered in the begin block of the given height or time.
+// Either height or time is set.
+type ScheduledCallback struct {
+	// ID is the unique identifier of the callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ContractAddress is the address of the contract that receives the callback
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Height is the block height from which on the callback is due
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time from which on the callback is due
+	Time *time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Interval is optional for recurring callbacks. It is in blocks for height
+	// or in seconds for time based callbacks. Zero is a one-shot callback.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Payload is passed to the contract with the callback
+	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *ScheduledCallback) Reset()         { *m = ScheduledCallback{} }
+func (m *ScheduledCallback) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCallback) ProtoMessage()    {}
+func (*ScheduledCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbb24c05a9eda05e, []int{3}
+}
+
+func (m *ScheduledCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ScheduledCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ScheduledCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCallback.Merge(m, src)
+}
+
+func (m *ScheduledCallback) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ScheduledCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCallback proto.InternalMessageInfo
+
+// MintedSupply is the running total of tokens minted by a token minter contract
+// for a denom
+type MintedSupply struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Denom           string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Total is the amount minted over the lifetime of the contract
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// Epoch is the number of the current epoch of the minter quota. Zero when
+	// no epoch cap is set.
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// EpochTotal is the amount minted within the epoch
+	EpochTotal github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=epoch_total,json=epochTotal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_total"`
+}
+
+func (m *MintedSupply) Reset()         { *m = MintedSupply{} }
+func (m *MintedSupply) String() string { return proto.CompactTextString(m) }
+func (*MintedSupply) ProtoMessage()    {}
+func (*MintedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbb24c05a9eda05e, []int{4}
+}
+
+func (m *MintedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MintedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MintedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintedSupply.Merge(m, src)
+}
+
+func (m *MintedSupply) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MintedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintedSupply proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TgradeContractDetails)(nil), "confio.twasm.v1beta1.TgradeContractDetails")
 	proto.RegisterType((*RegisteredPrivilege)(nil), "confio.twasm.v1beta1.RegisteredPrivilege")
+	proto.RegisterType((*PrivilegeExpiry)(nil), "confio.twasm.v1beta1.PrivilegeExpiry")
+	proto.RegisterType((*ScheduledCallback)(nil), "confio.twasm.v1beta1.ScheduledCallback")
+	proto.RegisterType((*MintedSupply)(nil), "confio.twasm.v1beta1.MintedSupply")
 }
 
 func init() {
@@ -125,28 +303,49 @@ func init() {
 }
 
 var fileDescriptor_cbb24c05a9eda05e = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0x02, 0x41,
-	0x10, 0xc6, 0x6f, 0xd5, 0x18, 0x3d, 0x83, 0xc5, 0x09, 0x09, 0x50, 0xac, 0x84, 0x44, 0x83, 0x05,
-	0xb7, 0x41, 0x3b, 0x4b, 0xd4, 0xc2, 0xc4, 0xc2, 0x5c, 0x28, 0x8c, 0xcd, 0xe5, 0x38, 0x86, 0x75,
-	0x13, 0xd8, 0xb9, 0xec, 0xae, 0x08, 0x6f, 0x61, 0xe9, 0x23, 0xf8, 0x00, 0x3e, 0x04, 0x25, 0xb1,
-	0xa2, 0x32, 0x72, 0xbc, 0x88, 0xe1, 0xfe, 0x35, 0xd2, 0xed, 0xec, 0xf7, 0x9b, 0xfd, 0x66, 0xe7,
-	0xb3, 0xdb, 0x21, 0xca, 0xa1, 0x40, 0x66, 0xde, 0x02, 0x3d, 0x66, 0x93, 0x4e, 0x1f, 0x4c, 0xd0,
-	0x61, 0x21, 0x4a, 0xa3, 0x82, 0xd0, 0xf8, 0x30, 0x35, 0x20, 0xb5, 0x40, 0xe9, 0x46, 0x0a, 0x0d,
-	0x3a, 0xe5, 0x14, 0x77, 0x13, 0xdc, 0xcd, 0xf0, 0x7a, 0x99, 0x23, 0xc7, 0x04, 0x60, 0x9b, 0x53,
-	0xca, 0xd6, 0x6b, 0x21, 0xea, 0x31, 0x6a, 0x3f, 0x15, 0xd2, 0x22, 0x95, 0x9a, 0x1f, 0xc4, 0xae,
-	0xf4, 0xb8, 0x0a, 0x06, 0x70, 0x93, 0x39, 0xdd, 0x82, 0x09, 0xc4, 0x48, 0x3b, 0x03, 0xbb, 0xa2,
-	0x80, 0x0b, 0x6d, 0x40, 0xc1, 0xc0, 0x8f, 0x94, 0x98, 0x88, 0x11, 0x70, 0xd0, 0x55, 0xd2, 0xd8,
-	0x6d, 0x1d, 0x5d, 0x5e, 0xb8, 0xdb, 0x06, 0x70, 0xbd, 0xa2, 0xe5, 0x31, 0xef, 0xe8, 0xee, 0xcd,
-	0x7f, 0x4e, 0x2d, 0xaf, 0xac, 0xfe, 0x4b, 0xfa, 0xba, 0xf6, 0xfd, 0xd5, 0xae, 0xe4, 0xd6, 0xf7,
-	0x72, 0x88, 0x77, 0xf9, 0x3f, 0x9b, 0x4f, 0xf6, 0xc9, 0x96, 0xd7, 0x9c, 0xba, 0x7d, 0x10, 0xa1,
-	0x16, 0x46, 0xa0, 0xac, 0x92, 0x06, 0x69, 0x95, 0xbc, 0xa2, 0x76, 0xce, 0xec, 0xe3, 0x62, 0x50,
-	0xdf, 0xcc, 0x22, 0xa8, 0xee, 0x34, 0x48, 0xeb, 0xd0, 0x2b, 0x15, 0xb7, 0xbd, 0x59, 0x04, 0xdd,
-	0x87, 0xf9, 0x8a, 0x5a, 0xcb, 0x15, 0x25, 0x9f, 0x31, 0x25, 0xf3, 0x98, 0x92, 0x45, 0x4c, 0xc9,
-	0x6f, 0x4c, 0xc9, 0xfb, 0x9a, 0x5a, 0x8b, 0x35, 0xb5, 0x96, 0x6b, 0x6a, 0x3d, 0x9f, 0x73, 0x61,
-	0x5e, 0x5e, 0xfb, 0x6e, 0x88, 0x63, 0x96, 0x67, 0x93, 0x6c, 0x8a, 0x4d, 0xb3, 0x90, 0x36, 0x0e,
-	0xba, 0xbf, 0x9f, 0x6c, 0xf2, 0xea, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x96, 0x22, 0xa5, 0xa2, 0xc1,
-	0x01, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xdb, 0x30,
+	0x18, 0xc7, 0xeb, 0x36, 0xed, 0xa8, 0x0b, 0x63, 0x64, 0x05, 0x85, 0x4e, 0x4a, 0xab, 0x4a, 0x4c,
+	0xe5, 0xd0, 0x44, 0xb0, 0x9d, 0x26, 0xed, 0xb0, 0x02, 0x07, 0x24, 0xa6, 0x4d, 0xa1, 0xa7, 0x5d,
+	0x22, 0x37, 0x36, 0xa9, 0x85, 0x1b, 0x47, 0xb1, 0x5b, 0xe8, 0x0b, 0xec, 0xcc, 0x23, 0xec, 0xb8,
+	0x07, 0xd8, 0x43, 0x70, 0x44, 0x3b, 0x21, 0x0e, 0x6c, 0x94, 0xd7, 0xd8, 0x61, 0x8a, 0x9d, 0x54,
+	0xb0, 0xf5, 0xb2, 0x9d, 0x9a, 0xff, 0xe7, 0xff, 0xf7, 0xf9, 0xf7, 0xd9, 0x5f, 0x0d, 0xbb, 0x01,
+	0x8f, 0x4e, 0x28, 0x77, 0xe5, 0x19, 0x12, 0x23, 0x77, 0xb2, 0x33, 0x20, 0x12, 0xed, 0xb8, 0x01,
+	0x8f, 0x64, 0x82, 0x02, 0xe9, 0x93, 0x73, 0x49, 0x22, 0x41, 0x79, 0xe4, 0xc4, 0x09, 0x97, 0xdc,
+	0xac, 0x6b, 0xbb, 0xa3, 0xec, 0x4e, 0x66, 0x6f, 0xd4, 0x43, 0x1e, 0x72, 0x65, 0x70, 0xd3, 0x2f,
+	0xed, 0x6d, 0x6c, 0x06, 0x5c, 0x8c, 0xb8, 0xf0, 0xf5, 0x82, 0x16, 0xd9, 0x52, 0x33, 0xe4, 0x3c,
+	0x64, 0xc4, 0x55, 0x6a, 0x30, 0x3e, 0x71, 0x25, 0x1d, 0x11, 0x21, 0xd1, 0x28, 0xd6, 0x86, 0xf6,
+	0xe7, 0x22, 0x5c, 0xef, 0x87, 0x09, 0xc2, 0x64, 0x2f, 0x43, 0xd9, 0x27, 0x12, 0x51, 0x26, 0x4c,
+	0x0c, 0xd7, 0x13, 0x12, 0x52, 0x21, 0x49, 0x42, 0xb0, 0x1f, 0x27, 0x74, 0x42, 0x19, 0x09, 0x89,
+	0xb0, 0x40, 0xab, 0xd4, 0xa9, 0xed, 0x6e, 0x3b, 0x8b, 0x08, 0x1d, 0x6f, 0x9e, 0xf2, 0x31, 0xcf,
+	0xe8, 0x19, 0x97, 0xb7, 0xcd, 0x82, 0x57, 0x4f, 0xfe, 0x5e, 0x12, 0x66, 0x17, 0x9a, 0x88, 0x31,
+	0x7e, 0xf6, 0x78, 0x8b, 0x62, 0xab, 0xd4, 0xa9, 0x7a, 0x6b, 0xd9, 0xca, 0x03, 0xfb, 0x5b, 0x58,
+	0x21, 0xe7, 0x31, 0x4d, 0xa6, 0x56, 0xa9, 0x05, 0x3a, 0xb5, 0xdd, 0xad, 0xc5, 0x14, 0xf3, 0x8c,
+	0x03, 0x65, 0xf6, 0xb2, 0xa4, 0x37, 0x9b, 0xdf, 0xbf, 0x75, 0xd7, 0xf3, 0x46, 0x0f, 0xa3, 0x13,
+	0x7e, 0x90, 0x1f, 0x7b, 0xfb, 0x0b, 0x80, 0xcf, 0x17, 0xc0, 0x9b, 0x0d, 0xb8, 0x14, 0x73, 0x41,
+	0x25, 0xe5, 0x91, 0x05, 0x5a, 0xa0, 0xb3, 0xe2, 0xcd, 0xb5, 0xb9, 0x05, 0x9f, 0xce, 0xa1, 0x7d,
+	0x39, 0x8d, 0x89, 0x55, 0x6c, 0x81, 0x4e, 0xd5, 0x5b, 0x99, 0x47, 0xfb, 0xd3, 0x98, 0x98, 0x2f,
+	0x60, 0x35, 0x44, 0xc2, 0x67, 0x74, 0x44, 0xa5, 0xe2, 0x36, 0xbc, 0xa5, 0x10, 0x89, 0xa3, 0x54,
+	0x9b, 0xdb, 0xf0, 0x19, 0x8d, 0x02, 0x36, 0xc6, 0xc4, 0x67, 0x74, 0x42, 0x22, 0x22, 0x84, 0x65,
+	0xb4, 0x40, 0x67, 0xc9, 0x5b, 0xcd, 0xe2, 0x47, 0x59, 0xb8, 0xed, 0xc3, 0xd5, 0x3f, 0x1a, 0x33,
+	0x37, 0x60, 0x65, 0x48, 0x68, 0x38, 0x94, 0x8a, 0xcd, 0xf0, 0x32, 0x65, 0xbe, 0x86, 0x46, 0x7a,
+	0xd3, 0x8a, 0xa7, 0xb6, 0xdb, 0x70, 0xf4, 0x18, 0x38, 0xf9, 0x18, 0x38, 0xfd, 0x7c, 0x0c, 0x7a,
+	0xc6, 0xc5, 0x8f, 0x26, 0xf0, 0x94, 0xbb, 0x7d, 0x03, 0xe0, 0xda, 0x71, 0x30, 0x24, 0x78, 0xcc,
+	0x08, 0xde, 0x43, 0x8c, 0x0d, 0x50, 0x70, 0x6a, 0x6e, 0xc0, 0x22, 0xc5, 0xba, 0x7e, 0xaf, 0x32,
+	0xbb, 0x6d, 0x16, 0x0f, 0xf7, 0xbd, 0x22, 0xc5, 0x29, 0xf9, 0x7c, 0x7c, 0x11, 0xc6, 0x49, 0x4a,
+	0xae, 0xfb, 0x5f, 0xcd, 0xe3, 0xef, 0x74, 0xf8, 0x01, 0x66, 0x69, 0x21, 0xa6, 0xf1, 0x2f, 0x98,
+	0xe9, 0x95, 0xd0, 0x48, 0x92, 0x64, 0x82, 0x98, 0x55, 0xd6, 0xc7, 0x99, 0x6b, 0xd3, 0x82, 0x4f,
+	0x62, 0x34, 0x65, 0x1c, 0x61, 0xab, 0xd2, 0x02, 0x9d, 0x65, 0x2f, 0x97, 0xed, 0x5f, 0x00, 0x2e,
+	0xbf, 0x4f, 0x7d, 0xf8, 0x78, 0x1c, 0xc7, 0x6c, 0xba, 0x90, 0x1f, 0x2c, 0xe6, 0xaf, 0xc3, 0x32,
+	0x26, 0x11, 0x1f, 0x65, 0xfd, 0x69, 0x61, 0xee, 0xc3, 0xb2, 0xe4, 0x12, 0x31, 0xd5, 0x54, 0xb5,
+	0xe7, 0xa4, 0x63, 0x7e, 0x73, 0xdb, 0x7c, 0x19, 0x52, 0x39, 0x1c, 0x0f, 0x9c, 0x80, 0x8f, 0xb2,
+	0x3f, 0x63, 0xf6, 0xd3, 0x15, 0xf8, 0xd4, 0x4d, 0xc7, 0x44, 0x38, 0x87, 0x91, 0xf4, 0x74, 0x72,
+	0x5a, 0x9b, 0xc4, 0x3c, 0x18, 0xaa, 0x43, 0x30, 0x3c, 0x2d, 0xcc, 0x0f, 0xb0, 0xa6, 0x3e, 0x7c,
+	0xbd, 0x43, 0xf9, 0xbf, 0x76, 0x80, 0xaa, 0x44, 0x3f, 0xad, 0xd0, 0x3b, 0xba, 0xbc, 0xb3, 0x0b,
+	0xd7, 0x77, 0x36, 0xf8, 0x3a, 0xb3, 0xc1, 0xe5, 0xcc, 0x06, 0x57, 0x33, 0x1b, 0xfc, 0x9c, 0xd9,
+	0xe0, 0xe2, 0xde, 0x2e, 0x5c, 0xdd, 0xdb, 0x85, 0xeb, 0x7b, 0xbb, 0xf0, 0xe9, 0x71, 0x65, 0xfd,
+	0x60, 0xa9, 0xd7, 0xc1, 0x3d, 0xcf, 0x5e, 0x2e, 0x55, 0x7d, 0x50, 0x51, 0x57, 0xf4, 0xea, 0xf7,
+	0x00, 0xf5, 0x87, 0x37, 0xc7, 0xd6, 0x04, 0x00, 0x00,
 }
 
 func (this *TgradeContractDetails) Equal(that interface{}) bool {
@@ -176,6 +375,17 @@ func (this *TgradeContractDetails) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.AllowedPrivileges) != len(that1.AllowedPrivileges) {
+		return false
+	}
+	for i := range this.AllowedPrivileges {
+		if this.AllowedPrivileges[i] != that1.AllowedPrivileges[i] {
+			return false
+		}
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
 	return true
 }
 
@@ -204,6 +414,125 @@ func (this *RegisteredPrivilege) Equal(that interface{}) bool {
 	if this.PrivilegeType != that1.PrivilegeType {
 		return false
 	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.IncludeLiveness != that1.IncludeLiveness {
+		return false
+	}
+	return true
+}
+
+func (this *PrivilegeExpiry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrivilegeExpiry)
+	if !ok {
+		that2, ok := that.(PrivilegeExpiry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	return true
+}
+
+func (this *ScheduledCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledCallback)
+	if !ok {
+		that2, ok := that.(ScheduledCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+
+func (this *MintedSupply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintedSupply)
+	if !ok {
+		that2, ok := that.(MintedSupply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Total.Equal(that1.Total) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !this.EpochTotal.Equal(that1.EpochTotal) {
+		return false
+	}
 	return true
 }
 
@@ -227,6 +556,27 @@ func (m *TgradeContractDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintContractExtension(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedPrivileges) > 0 {
+		for iNdEx := len(m.AllowedPrivileges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPrivileges[iNdEx])
+			copy(dAtA[i:], m.AllowedPrivileges[iNdEx])
+			i = encodeVarintContractExtension(dAtA, i, uint64(len(m.AllowedPrivileges[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.RegisteredPrivileges) > 0 {
 		for iNdEx := len(m.RegisteredPrivileges) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +614,21 @@ func (m *RegisteredPrivilege) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeLiveness {
+		i--
+		if m.IncludeLiveness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.PrivilegeType) > 0 {
 		i -= len(m.PrivilegeType)
 		copy(dAtA[i:], m.PrivilegeType)
@@ -279,61 +644,309 @@ func (m *RegisteredPrivilege) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintContractExtension(dAtA []byte, offset int, v uint64) int {
-	offset -= sovContractExtension(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PrivilegeExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *TgradeContractDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RegisteredPrivileges) > 0 {
-		for _, e := range m.RegisteredPrivileges {
-			l = e.Size()
-			n += 1 + l + sovContractExtension(uint64(l))
-		}
-	}
-	return n
+func (m *PrivilegeExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisteredPrivilege) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PrivilegeExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Position != 0 {
-		n += 1 + sovContractExtension(uint64(m.Position))
+	if m.Time != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintContractExtension(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PrivilegeType)
-	if l > 0 {
-		n += 1 + l + sovContractExtension(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovContractExtension(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ScheduledCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sozContractExtension(x uint64) (n int) {
-	return sovContractExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ScheduledCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TgradeContractDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *ScheduledCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintContractExtension(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Time != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintContractExtension(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintContractExtension(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochTotal.Size()
+		i -= size
+		if _, err := m.EpochTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContractExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Epoch != 0 {
+		i = encodeVarintContractExtension(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContractExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintContractExtension(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintContractExtension(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TgradeContractDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredPrivileges) > 0 {
+		for _, e := range m.RegisteredPrivileges {
+			l = e.Size()
+			n += 1 + l + sovContractExtension(uint64(l))
+		}
+	}
+	if len(m.AllowedPrivileges) > 0 {
+		for _, s := range m.AllowedPrivileges {
+			l = len(s)
+			n += 1 + l + sovContractExtension(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredPrivilege) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovContractExtension(uint64(m.Position))
+	}
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovContractExtension(uint64(m.GasLimit))
+	}
+	if m.IncludeLiveness {
+		n += 2
+	}
+	return n
+}
+
+func (m *PrivilegeExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovContractExtension(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovContractExtension(uint64(m.ID))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovContractExtension(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovContractExtension(uint64(m.Interval))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *MintedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovContractExtension(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovContractExtension(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovContractExtension(uint64(m.Epoch))
+	}
+	l = m.EpochTotal.Size()
+	n += 1 + l + sovContractExtension(uint64(l))
+	return n
+}
+
+func sovContractExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozContractExtension(x uint64) (n int) {
+	return sovContractExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TgradeContractDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -393,6 +1006,74 @@ func (m *TgradeContractDetails) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPrivileges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPrivileges = append(m.AllowedPrivileges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &PrivilegeExpiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContractExtension(dAtA[iNdEx:])
@@ -495,6 +1176,563 @@ func (m *RegisteredPrivilege) Unmarshal(dAtA []byte) error {
 			}
 			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLiveness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeLiveness = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PrivilegeExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivilegeExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivilegeExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ScheduledCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MintedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContractExtension(dAtA[iNdEx:])
@@ -3,8 +3,10 @@ package types
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTgradeContractDetailsValidation(t *testing.T) {
@@ -55,12 +57,51 @@ func TestTgradeContractDetailsValidation(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"liveness for begin blocker": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.RegisteredPrivileges = []RegisteredPrivilege{{Position: 1, PrivilegeType: "begin_blocker", IncludeLiveness: true}}
+			}),
+		},
+		"liveness for other privilege type": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.RegisteredPrivileges = []RegisteredPrivilege{{Position: 1, PrivilegeType: "end_blocker", IncludeLiveness: true}}
+			}),
+			expErr: true,
+		},
 		"empty callback position": {
 			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
 				d.RegisteredPrivileges = []RegisteredPrivilege{{PrivilegeType: "begin_blocker"}}
 			}),
 			expErr: true,
 		},
+		"allowed privileges": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.AllowedPrivileges = []string{"begin_blocker", "end_blocker"}
+			}),
+		},
+		"unknown allowed privilege": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.AllowedPrivileges = []string{"unknown"}
+			}),
+			expErr: true,
+		},
+		"duplicate allowed privileges": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.AllowedPrivileges = []string{"begin_blocker", "begin_blocker"}
+			}),
+			expErr: true,
+		},
+		"expiry": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.Expiry = &PrivilegeExpiry{Height: 1}
+			}),
+		},
+		"invalid expiry": {
+			src: TgradeContractDetailsFixture(t, func(d *TgradeContractDetails) {
+				d.Expiry = &PrivilegeExpiry{}
+			}),
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -73,3 +114,173 @@ func TestTgradeContractDetailsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestPrivilegeExpiryIsExpired(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	specs := map[string]struct {
+		src       PrivilegeExpiry
+		height    int64
+		blockTime time.Time
+		exp       bool
+	}{
+		"height reached": {
+			src:    PrivilegeExpiry{Height: 10},
+			height: 10,
+			exp:    true,
+		},
+		"height passed": {
+			src:    PrivilegeExpiry{Height: 10},
+			height: 11,
+			exp:    true,
+		},
+		"height not reached": {
+			src:       PrivilegeExpiry{Height: 10},
+			height:    9,
+			blockTime: myTime,
+		},
+		"time reached": {
+			src:       PrivilegeExpiry{Time: &myTime},
+			blockTime: myTime,
+			exp:       true,
+		},
+		"time not reached": {
+			src:       PrivilegeExpiry{Time: &myTime},
+			height:    100,
+			blockTime: myTime.Add(-time.Second),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.src.IsExpired(spec.height, spec.blockTime))
+		})
+	}
+}
+
+func TestScheduledCallbackNext(t *testing.T) {
+	myTime := time.Unix(1000000000, 0).UTC()
+	specs := map[string]struct {
+		src       ScheduledCallback
+		height    int64
+		blockTime time.Time
+		exp       *ScheduledCallback
+	}{
+		"one-shot height": {
+			src:    ScheduledCallback{Height: 10},
+			height: 10,
+		},
+		"recurring height": {
+			src:    ScheduledCallback{Height: 10, Interval: 5},
+			height: 10,
+			exp:    &ScheduledCallback{Height: 15, Interval: 5},
+		},
+		"recurring height with missed occurrences": {
+			src:    ScheduledCallback{Height: 10, Interval: 5},
+			height: 21,
+			exp:    &ScheduledCallback{Height: 25, Interval: 5},
+		},
+		"one-shot time": {
+			src:       ScheduledCallback{Time: &myTime},
+			blockTime: myTime,
+		},
+		"recurring time": {
+			src:       ScheduledCallback{Time: &myTime, Interval: 60},
+			blockTime: myTime,
+			exp:       &ScheduledCallback{Time: timePtr(myTime.Add(time.Minute)), Interval: 60},
+		},
+		"recurring time with missed occurrences": {
+			src:       ScheduledCallback{Time: &myTime, Interval: 60},
+			blockTime: myTime.Add(2 * time.Minute),
+			exp:       &ScheduledCallback{Time: timePtr(myTime.Add(3 * time.Minute)), Interval: 60},
+		}, "recurring height exceeds max": {
+			src:    ScheduledCallback{Height: MaxScheduledCallbackHeight - 1, Interval: 2},
+			height: 10,
+		},
+		"recurring height with max interval": {
+			src:    ScheduledCallback{Height: math.MaxUint64, Interval: math.MaxUint64},
+			height: 10,
+		},
+		"recurring height with missed occurrences exceeds max": {
+			src:    ScheduledCallback{Height: 1, Interval: MaxScheduledCallbackHeight - 2},
+			height: math.MaxInt64,
+		},
+		"recurring time exceeds max": {
+			src:       ScheduledCallback{Time: &MaxScheduledCallbackTime, Interval: 1},
+			blockTime: MaxScheduledCallbackTime,
+		},
+		"recurring time with invalid interval": {
+			src:       ScheduledCallback{Time: &myTime, Interval: math.MaxUint64},
+			blockTime: myTime,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, ok := spec.src.Next(spec.height, spec.blockTime)
+			if spec.exp == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, *spec.exp, got)
+		})
+	}
+}
+
+func TestScheduledCallbackValidateBasic(t *testing.T) {
+	myAddr := RandomAddress(t).String()
+	myTime := time.Unix(1000000000, 0).UTC()
+	specs := map[string]struct {
+		src    ScheduledCallback
+		expErr bool
+	}{
+		"height": {
+			src: ScheduledCallback{ID: 1, ContractAddress: myAddr, Height: 1, Interval: 1},
+		},
+		"time": {
+			src: ScheduledCallback{ID: 1, ContractAddress: myAddr, Time: &myTime, Interval: 1},
+		},
+		"max height": {
+			src: ScheduledCallback{ID: 1, ContractAddress: myAddr, Height: MaxScheduledCallbackHeight, Interval: MaxScheduledCallbackHeight},
+		},
+		"max time": {
+			src: ScheduledCallback{ID: 1, ContractAddress: myAddr, Time: &MaxScheduledCallbackTime, Interval: MaxScheduledCallbackInterval},
+		},
+		"empty id": {
+			src:    ScheduledCallback{ContractAddress: myAddr, Height: 1},
+			expErr: true,
+		},
+		"height and time": {
+			src:    ScheduledCallback{ID: 1, ContractAddress: myAddr, Height: 1, Time: &myTime},
+			expErr: true,
+		},
+		"height exceeds max": {
+			src:    ScheduledCallback{ID: 1, ContractAddress: myAddr, Height: MaxScheduledCallbackHeight + 1},
+			expErr: true,
+		},
+		"height interval exceeds max": {
+			src:    ScheduledCallback{ID: 1, ContractAddress: myAddr, Height: 1, Interval: MaxScheduledCallbackHeight + 1},
+			expErr: true,
+		},
+		"time exceeds max": {
+			src:    ScheduledCallback{ID: 1, ContractAddress: myAddr, Time: timePtr(MaxScheduledCallbackTime.Add(time.Second))},
+			expErr: true,
+		},
+		"time interval exceeds max": {
+			src:    ScheduledCallback{ID: 1, ContractAddress: myAddr, Time: &myTime, Interval: MaxScheduledCallbackInterval + 1},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,8 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// Codespace is the codespace for tgrade specific errors. The wasm codespace is owned by wasmd.
+const Codespace = "twasm"
+
+var ErrMintQuotaExceeded = sdkerrors.Register(Codespace, 2, "mint quota exceeded")
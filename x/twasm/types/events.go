@@ -6,12 +6,22 @@ const (
 	EventTypeRegisterPrivilege = "register_privilege"
 	EventTypeReleasePrivilege  = "release_privilege"
 	EventTypeMintTokens        = "mint"
+	EventTypeBurnTokens        = "burn_tokens"
 	EventTypeDelegateTokens    = "delegate"
 	EventTypeUndelegateTokens  = "undelegate"
+	EventTypeCallbackOutOfGas  = "privileged_callback_out_of_gas"
+	EventTypeSuspendPrivilege  = "privilege_suspended"
+	EventTypeScheduleCallback  = "schedule_callback"
+	EventTypeCancelCallback    = "cancel_scheduled_callback"
+	EventTypeFeeDenomRatios    = "fee_denom_ratios"
 )
 
 const ( // event attributes
 	AttributeKeyCallbackType = "privilege_type"
 	AttributeKeyRecipient    = "recipient"
 	AttributeKeySender       = "sender"
+	AttributeKeyGasLimit     = "gas_limit"
+	AttributeKeyFailures     = "failures"
+	AttributeKeyScheduleID   = "schedule_id"
+	AttributeKeyDenomRatios  = "denom_ratios"
 )
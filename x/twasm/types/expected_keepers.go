@@ -10,6 +10,12 @@ type Minter interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
+// Burner for contracts with the token burner privilege
+type Burner interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
 // Staker for vesting account staking
 type Staker interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -21,5 +27,6 @@ type Staker interface {
 type BankKeeper interface {
 	wasmtypes.BankKeeper
 	Minter
+	Burner
 	Staker
 }
@@ -16,6 +16,9 @@ func (g GenesisState) ValidateBasic() error {
 	if err := wasmState.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "wasm")
 	}
+	if err := g.TgradeParams.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "tgrade params")
+	}
 	for _, c := range wasmState.Contracts {
 		if c.ContractInfo.Extension != nil {
 			if tgradeExtType != c.ContractInfo.Extension.TypeUrl {
@@ -59,6 +62,29 @@ func (g GenesisState) ValidateBasic() error {
 		return sdkerrors.Wrapf(wasmtypes.ErrInvalidGenesis, "%d privileged contract addresses not found in genesis contract addresses", len(uniqueAddr))
 	}
 
+	uniqueCallbackIDs := make(map[uint64]struct{}, len(g.ScheduledCallbacks))
+	for i, c := range g.ScheduledCallbacks {
+		if err := c.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "scheduled callback %d", i)
+		}
+		if _, exists := uniqueCallbackIDs[c.ID]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "scheduled callback id %d", c.ID)
+		}
+		uniqueCallbackIDs[c.ID] = struct{}{}
+	}
+
+	uniqueMintedSupply := make(map[string]struct{}, len(g.MintedSupply))
+	for i, m := range g.MintedSupply {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "minted supply %d", i)
+		}
+		key := m.ContractAddress + "/" + m.Denom
+		if _, exists := uniqueMintedSupply[key]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "minted supply of contract %s with denom %q", m.ContractAddress, m.Denom)
+		}
+		uniqueMintedSupply[key] = struct{}{}
+	}
+
 	return nil
 }
 
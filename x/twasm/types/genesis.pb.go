@@ -46,6 +46,13 @@ type GenesisState struct {
 	PrivilegedContractAddresses []string `protobuf:"bytes,6,rep,name=privileged_contract_addresses,json=privilegedContractAddresses,proto3" json:"privileged_contract_addresses,omitempty"`
 	// PinnedCodeIDs has codeInfo ids for wasm codes that are pinned in cache
 	PinnedCodeIDs []uint64 `protobuf:"varint,7,rep,packed,name=pinned_code_ids,json=pinnedCodeIds,proto3" json:"pinned_code_ids,omitempty"`
+	// TgradeParams are the tgrade specific params
+	TgradeParams TgradeParams `protobuf:"bytes,8,opt,name=tgrade_params,json=tgradeParams,proto3" json:"tgrade_params"`
+	// ScheduledCallbacks are the pending callbacks of contracts with the
+	// scheduler privilege
+	ScheduledCallbacks []ScheduledCallback `protobuf:"bytes,9,rep,name=scheduled_callbacks,json=scheduledCallbacks,proto3" json:"scheduled_callbacks,omitempty"`
+	// MintedSupply are the running totals of tokens minted by contracts
+	MintedSupply []MintedSupply `protobuf:"bytes,10,rep,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -135,6 +142,27 @@ func (m *GenesisState) GetPinnedCodeIDs() []uint64 {
 	return nil
 }
 
+func (m *GenesisState) GetTgradeParams() TgradeParams {
+	if m != nil {
+		return m.TgradeParams
+	}
+	return TgradeParams{}
+}
+
+func (m *GenesisState) GetScheduledCallbacks() []ScheduledCallback {
+	if m != nil {
+		return m.ScheduledCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintedSupply() []MintedSupply {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress string             `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -358,49 +386,56 @@ func init() {
 }
 
 var fileDescriptor_89c4cd47eb0533ed = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x5f, 0x4f, 0xd3, 0x50,
-	0x14, 0xc0, 0x37, 0x36, 0x36, 0x76, 0x37, 0x84, 0x5c, 0x89, 0x94, 0x21, 0xdd, 0xdc, 0x03, 0xce,
-	0x68, 0xda, 0x80, 0xd1, 0x44, 0x13, 0x13, 0x2c, 0xfe, 0x23, 0x86, 0x48, 0x46, 0xc4, 0x68, 0xa2,
-	0x4b, 0x77, 0x7b, 0xb9, 0x36, 0xd0, 0xde, 0xba, 0x73, 0x19, 0xf0, 0x2d, 0x7c, 0xf2, 0x33, 0xf1,
-	0xc8, 0xa3, 0x4f, 0x8b, 0x19, 0x0f, 0x26, 0x7c, 0x04, 0x9f, 0x4c, 0x6f, 0x6f, 0xbb, 0x42, 0xa7,
-	0x6f, 0x6d, 0xcf, 0xef, 0xfc, 0xce, 0xbd, 0x67, 0x67, 0x07, 0xb5, 0x08, 0xf7, 0xf7, 0x5d, 0x6e,
-	0x8a, 0x63, 0x1b, 0x3c, 0x73, 0xb0, 0xd6, 0xa3, 0xc2, 0x5e, 0x33, 0x19, 0xf5, 0x29, 0xb8, 0x60,
-	0x04, 0x7d, 0x2e, 0x38, 0x5e, 0x88, 0x18, 0x43, 0x32, 0x86, 0x62, 0xea, 0x0b, 0x8c, 0x33, 0x2e,
-	0x01, 0x33, 0x7c, 0x8a, 0xd8, 0xba, 0x4e, 0x38, 0x78, 0x1c, 0xcc, 0x9e, 0x0d, 0x34, 0xd1, 0x11,
-	0xee, 0xfa, 0xe9, 0xb8, 0xac, 0xa5, 0x0a, 0x5e, 0xad, 0x55, 0xbf, 0x9d, 0x89, 0x8b, 0xd3, 0x80,
-	0xc6, 0xd1, 0xa5, 0x6c, 0xf4, 0x24, 0x0a, 0xb5, 0x7e, 0x17, 0x51, 0xed, 0x75, 0xa4, 0xda, 0x15,
-	0xb6, 0xa0, 0xf8, 0x31, 0x2a, 0x05, 0x76, 0xdf, 0xf6, 0x40, 0xcb, 0x37, 0xf3, 0xed, 0xea, 0xba,
-	0x66, 0xc4, 0xc9, 0x86, 0xba, 0x87, 0xb1, 0x23, 0xe3, 0x56, 0xf1, 0x6c, 0xd8, 0xc8, 0x75, 0x14,
-	0x8d, 0x5f, 0xa2, 0x69, 0xc2, 0x1d, 0x0a, 0xda, 0x54, 0xb3, 0xd0, 0xae, 0xae, 0xdf, 0xca, 0xa6,
-	0x6d, 0x72, 0x87, 0x5a, 0x8b, 0x61, 0xd2, 0xe5, 0xb0, 0x31, 0x27, 0xe1, 0x07, 0xdc, 0x73, 0x05,
-	0xf5, 0x02, 0x71, 0xda, 0x89, 0xb2, 0xf1, 0x47, 0x54, 0x21, 0xdc, 0x17, 0x7d, 0x9b, 0x08, 0xd0,
-	0x0a, 0x52, 0xa5, 0x1b, 0x93, 0x1a, 0x69, 0x6c, 0x2a, 0xcc, 0x5a, 0x56, 0xca, 0x9b, 0x49, 0x62,
-	0x4a, 0x3b, 0xb6, 0xe1, 0xf7, 0xa8, 0x02, 0xf4, 0xdb, 0x11, 0xf5, 0x09, 0x05, 0xad, 0x28, 0xd5,
-	0xf5, 0xec, 0x29, 0x77, 0x15, 0x32, 0xd6, 0x26, 0x49, 0x69, 0x6d, 0xf2, 0x11, 0x7f, 0x46, 0x33,
-	0x8c, 0xfa, 0x5d, 0x0f, 0x18, 0x68, 0xd3, 0xd2, 0xba, 0x9a, 0xb5, 0xa6, 0x5b, 0x1c, 0xbe, 0x6c,
-	0x03, 0x03, 0xab, 0xae, 0x2a, 0xe0, 0x38, 0x3f, 0x55, 0xa0, 0xcc, 0x22, 0x08, 0x73, 0xb4, 0x12,
-	0xf4, 0xdd, 0x81, 0x7b, 0x48, 0x19, 0x75, 0xba, 0xf1, 0x6d, 0xba, 0xb6, 0xe3, 0xf4, 0x29, 0x00,
-	0x05, 0xad, 0xd4, 0x2c, 0xb4, 0x2b, 0xd6, 0xfd, 0xcb, 0x61, 0xe3, 0xee, 0x7f, 0xc1, 0x94, 0x7c,
-	0x79, 0x0c, 0xc6, 0x5d, 0x7c, 0x1e, 0x63, 0x78, 0x0f, 0xcd, 0x05, 0xae, 0xef, 0x4b, 0x87, 0x43,
-	0xbb, 0xae, 0x03, 0x5a, 0xb9, 0x59, 0x68, 0x17, 0x2d, 0x63, 0x34, 0x6c, 0xcc, 0xee, 0xc8, 0x50,
-	0xf8, 0x53, 0x6e, 0xbd, 0x80, 0xcb, 0x61, 0x63, 0xe9, 0x1a, 0x9b, 0xaa, 0x32, 0x1b, 0x8c, 0x59,
-	0x07, 0x5a, 0x3f, 0xa6, 0xd0, 0x4c, 0x5c, 0x0d, 0xdf, 0x43, 0xf3, 0xd7, 0x4f, 0x28, 0xe7, 0xad,
-	0xd2, 0x99, 0x23, 0x57, 0x4f, 0x84, 0xb7, 0xd0, 0x6c, 0x82, 0xba, 0xfe, 0x3e, 0xd7, 0xa6, 0xe4,
-	0x5c, 0xea, 0x93, 0x06, 0x2c, 0xc2, 0xb6, 0xfc, 0x7d, 0xae, 0xa6, 0xb3, 0x46, 0x52, 0xdf, 0xf0,
-	0x53, 0x34, 0x73, 0x30, 0xe8, 0x7a, 0xdc, 0xa1, 0x87, 0x5a, 0x41, 0x5a, 0x56, 0x26, 0xcf, 0xd6,
-	0xdb, 0xbd, 0xed, 0x10, 0x7a, 0x93, 0xeb, 0x94, 0x0f, 0x06, 0xf2, 0x11, 0xbf, 0x42, 0x35, 0x72,
-	0x04, 0x82, 0x7b, 0x2a, 0xbf, 0x28, 0xf3, 0xef, 0xfc, 0x63, 0x36, 0x25, 0x19, 0x3b, 0xaa, 0x64,
-	0xfc, 0x6a, 0xcd, 0xa3, 0x1b, 0xc9, 0x75, 0x20, 0x1c, 0x87, 0xd6, 0x06, 0x2a, 0xab, 0x7a, 0xf8,
-	0x11, 0x2a, 0x49, 0x7b, 0xd8, 0x8c, 0x70, 0x92, 0x16, 0xb3, 0x97, 0x8c, 0x2c, 0xea, 0xbf, 0x17,
-	0xc1, 0xad, 0x2f, 0xa8, 0x9a, 0xaa, 0x88, 0xdf, 0xa1, 0x82, 0x07, 0x4c, 0x9b, 0x6e, 0xe6, 0xdb,
-	0x35, 0xeb, 0xd9, 0x9f, 0x61, 0xe3, 0x09, 0x73, 0xc5, 0xd7, 0xa3, 0x9e, 0x41, 0xb8, 0x67, 0x6e,
-	0x72, 0xf0, 0x3e, 0xc4, 0xab, 0xc0, 0x31, 0x4f, 0xa2, 0x95, 0x10, 0x6d, 0x8b, 0x8e, 0x7d, 0x1c,
-	0xf7, 0x70, 0x9b, 0x02, 0xd8, 0x8c, 0x76, 0x42, 0x93, 0xb5, 0x71, 0x36, 0xd2, 0xf3, 0xe7, 0x23,
-	0x3d, 0xff, 0x6b, 0xa4, 0xe7, 0xbf, 0x5f, 0xe8, 0xb9, 0xf3, 0x0b, 0x3d, 0xf7, 0xf3, 0x42, 0xcf,
-	0x7d, 0x5a, 0x4d, 0x99, 0xe3, 0x95, 0xc8, 0xfa, 0xb6, 0x43, 0xcd, 0x13, 0xb5, 0x1b, 0xa5, 0xb9,
-	0x57, 0x92, 0xdb, 0xe6, 0xe1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x37, 0x09, 0x76, 0x38,
-	0x05, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0x80, 0x21, 0x10, 0x7e, 0x06, 0xb8, 0x89, 0x26, 0xd1, 0x8d, 0x43, 0x6e, 0x0c, 0x41, 0xba,
+	0x09, 0x57, 0xb7, 0xb5, 0x95, 0x54, 0xad, 0xd4, 0x4a, 0x95, 0x52, 0xd3, 0xbf, 0xa8, 0x42, 0x8d,
+	0xa0, 0x4d, 0xd5, 0x4a, 0x2d, 0x32, 0xf6, 0xc4, 0xb1, 0x82, 0x3d, 0x2e, 0x67, 0x20, 0x64, 0xd5,
+	0x57, 0xe8, 0xaa, 0xfb, 0xbe, 0x4d, 0x96, 0x59, 0x76, 0x85, 0x2a, 0xb2, 0xcb, 0x23, 0x74, 0x55,
+	0x79, 0x3c, 0x06, 0x13, 0x48, 0x57, 0xe0, 0x39, 0xdf, 0xf9, 0xce, 0xcc, 0x9c, 0x99, 0x41, 0x15,
+	0x83, 0xba, 0xc7, 0x36, 0x55, 0xd9, 0x99, 0x0e, 0x8e, 0xda, 0xdf, 0x6d, 0x13, 0xa6, 0xef, 0xaa,
+	0x16, 0x71, 0x09, 0xd8, 0xa0, 0x78, 0x5d, 0xca, 0x28, 0x5e, 0x0d, 0x18, 0x85, 0x33, 0x8a, 0x60,
+	0x8a, 0xab, 0x16, 0xb5, 0x28, 0x07, 0x54, 0xff, 0x5f, 0xc0, 0x16, 0x65, 0x83, 0x82, 0x43, 0x41,
+	0x6d, 0xeb, 0x40, 0xc6, 0x3a, 0x83, 0xda, 0x6e, 0x34, 0xce, 0x6b, 0x89, 0x82, 0xd3, 0xb5, 0x8a,
+	0xff, 0xcc, 0xc4, 0xd9, 0xb9, 0x47, 0xc2, 0xe8, 0xfa, 0x6c, 0x74, 0x20, 0x42, 0x5b, 0x73, 0x17,
+	0xe2, 0xe9, 0x5d, 0xdd, 0x09, 0xb3, 0xef, 0xce, 0x45, 0x0c, 0xea, 0xb2, 0xae, 0x6e, 0xb0, 0x16,
+	0x19, 0x30, 0xe2, 0x82, 0x4d, 0xc5, 0x54, 0x2b, 0xdf, 0xd3, 0x28, 0xff, 0x22, 0x98, 0x5c, 0x93,
+	0xe9, 0x8c, 0xe0, 0x07, 0x28, 0x15, 0xf8, 0xa4, 0x78, 0x39, 0x5e, 0xcd, 0xed, 0x49, 0x4a, 0x38,
+	0x1d, 0x45, 0xec, 0x8c, 0x72, 0xc8, 0xe3, 0x5a, 0xf2, 0x62, 0x58, 0x8a, 0x35, 0x04, 0x8d, 0x9f,
+	0xa1, 0x45, 0x83, 0x9a, 0x04, 0xa4, 0x85, 0x72, 0xa2, 0x9a, 0xdb, 0xfb, 0x7b, 0x36, 0xad, 0x46,
+	0x4d, 0xa2, 0xad, 0xf9, 0x49, 0xd7, 0xc3, 0xd2, 0x12, 0x87, 0xef, 0x50, 0xc7, 0x66, 0xc4, 0xf1,
+	0xd8, 0x79, 0x23, 0xc8, 0xc6, 0xef, 0x51, 0x36, 0x9c, 0x2b, 0x48, 0x09, 0xae, 0x92, 0x95, 0x79,
+	0xad, 0x51, 0x6a, 0x02, 0xd3, 0x36, 0x84, 0x72, 0x65, 0x9c, 0x18, 0xd1, 0x4e, 0x6c, 0xf8, 0x2d,
+	0xca, 0x02, 0xf9, 0xdc, 0x23, 0xae, 0x41, 0x40, 0x4a, 0x72, 0x75, 0x71, 0x76, 0x96, 0x4d, 0x81,
+	0x4c, 0xb4, 0xe3, 0xa4, 0xa8, 0x76, 0x3c, 0x88, 0x3f, 0xa2, 0x8c, 0x45, 0xdc, 0x96, 0x03, 0x16,
+	0x48, 0x8b, 0xdc, 0xba, 0x3d, 0x6b, 0x8d, 0x6e, 0xb1, 0xff, 0x51, 0x07, 0x0b, 0xb4, 0xa2, 0xa8,
+	0x80, 0xc3, 0xfc, 0x48, 0x81, 0xb4, 0x15, 0x40, 0x98, 0xa2, 0x4d, 0xaf, 0x6b, 0xf7, 0xed, 0x0e,
+	0xb1, 0x88, 0xd9, 0x1a, 0xf7, 0x51, 0x37, 0xcd, 0x2e, 0x01, 0x20, 0x20, 0xa5, 0xca, 0x89, 0x6a,
+	0x56, 0xfb, 0xff, 0x7a, 0x58, 0xda, 0xf9, 0x23, 0x18, 0x91, 0x6f, 0x4c, 0xc0, 0x70, 0x17, 0x9f,
+	0x84, 0x18, 0x3e, 0x42, 0x4b, 0x9e, 0xed, 0xba, 0xdc, 0x61, 0x92, 0x96, 0x6d, 0x82, 0x94, 0x2e,
+	0x27, 0xaa, 0x49, 0x4d, 0x19, 0x0d, 0x4b, 0x85, 0x43, 0x1e, 0xf2, 0x5b, 0x79, 0xf0, 0x14, 0xae,
+	0x87, 0xa5, 0xf5, 0x1b, 0x6c, 0xa4, 0x4a, 0xc1, 0x9b, 0xb0, 0x26, 0xe0, 0x3a, 0x2a, 0x30, 0xab,
+	0xab, 0x9b, 0xa4, 0x25, 0xce, 0x57, 0x86, 0x9f, 0xaf, 0xca, 0xfc, 0xee, 0xbe, 0xe1, 0xe8, 0xd4,
+	0x49, 0xcb, 0xb3, 0xc8, 0x18, 0xfe, 0x82, 0x56, 0xc0, 0x38, 0x21, 0x66, 0xaf, 0xe3, 0x57, 0xd7,
+	0x3b, 0x9d, 0xb6, 0x6e, 0x9c, 0x82, 0x94, 0xe5, 0x1d, 0xd8, 0x99, 0x2f, 0x6d, 0x86, 0x09, 0x35,
+	0xc1, 0x6b, 0xff, 0x8a, 0x16, 0x6c, 0xce, 0x71, 0x45, 0x96, 0x82, 0xe1, 0x66, 0x26, 0xe0, 0x13,
+	0x54, 0x70, 0x6c, 0x97, 0x11, 0xb3, 0x05, 0x3d, 0xcf, 0xeb, 0x9c, 0x4b, 0xa8, 0x9c, 0xb8, 0x7d,
+	0x3d, 0x75, 0x8e, 0x36, 0x39, 0xa9, 0x95, 0x44, 0xd5, 0xb5, 0x29, 0x41, 0xa4, 0x5e, 0xde, 0x89,
+	0xe0, 0x95, 0x6f, 0x0b, 0x28, 0x13, 0xf6, 0x09, 0xff, 0x87, 0x96, 0x6f, 0xf6, 0x96, 0xdf, 0xd4,
+	0x6c, 0x63, 0xc9, 0x98, 0xee, 0x25, 0x3e, 0x40, 0x85, 0x31, 0x6a, 0xbb, 0xc7, 0x54, 0x5a, 0x28,
+	0xc7, 0xc5, 0x7d, 0x9a, 0xb9, 0x9a, 0x01, 0x76, 0xe0, 0x1e, 0xd3, 0x70, 0xb7, 0x8d, 0xc8, 0x18,
+	0x7e, 0x84, 0x32, 0xa7, 0xfd, 0x96, 0x43, 0x4d, 0xd2, 0x91, 0x12, 0xdc, 0xb2, 0x39, 0x7f, 0x9d,
+	0xaf, 0x8e, 0xea, 0x3e, 0xf4, 0x32, 0xd6, 0x48, 0x9f, 0xf6, 0xf9, 0x5f, 0xfc, 0x1c, 0xe5, 0x8d,
+	0x1e, 0x30, 0xea, 0x88, 0xfc, 0x24, 0xcf, 0xdf, 0xba, 0xe5, 0x56, 0x73, 0x32, 0x74, 0xe4, 0x8c,
+	0xc9, 0xa7, 0xb6, 0x8c, 0xfe, 0x1a, 0x2f, 0x07, 0xfc, 0x8b, 0x54, 0xd9, 0x47, 0x69, 0x51, 0x0f,
+	0xdf, 0x47, 0x29, 0x6e, 0xf7, 0x37, 0xc3, 0x6f, 0xc3, 0xda, 0xec, 0x22, 0x03, 0x8b, 0x78, 0xb5,
+	0x02, 0xb8, 0xf2, 0x09, 0xe5, 0x22, 0x15, 0xf1, 0x6b, 0x94, 0x70, 0xc0, 0x92, 0x16, 0xcb, 0xf1,
+	0x6a, 0x5e, 0x7b, 0xfc, 0x6b, 0x58, 0x7a, 0x68, 0xd9, 0xec, 0xa4, 0xd7, 0x56, 0x0c, 0xea, 0xa8,
+	0x35, 0x0a, 0xce, 0xbb, 0xf0, 0x59, 0x36, 0xd5, 0x01, 0xff, 0x15, 0x2f, 0x77, 0x43, 0x3f, 0x0b,
+	0xf7, 0xb0, 0x4e, 0x00, 0x74, 0x8b, 0x34, 0x7c, 0x93, 0xb6, 0x7f, 0x31, 0x92, 0xe3, 0x97, 0x23,
+	0x39, 0xfe, 0x73, 0x24, 0xc7, 0xbf, 0x5e, 0xc9, 0xb1, 0xcb, 0x2b, 0x39, 0xf6, 0xe3, 0x4a, 0x8e,
+	0x7d, 0xd8, 0x8e, 0x98, 0xc3, 0x27, 0x9b, 0x9f, 0x6f, 0x75, 0xa0, 0xb2, 0x89, 0xb9, 0x9d, 0xe2,
+	0xef, 0xf4, 0xbd, 0xdf, 0x03, 0x00, 0x1d, 0x8d, 0x7f, 0xd6, 0xc4, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -423,21 +458,59 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintedSupply) > 0 {
+		for iNdEx := len(m.MintedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ScheduledCallbacks) > 0 {
+		for iNdEx := len(m.ScheduledCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.TgradeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.PinnedCodeIDs) > 0 {
-		dAtA2 := make([]byte, len(m.PinnedCodeIDs)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.PinnedCodeIDs)*10)
+		var j2 int
 		for _, num := range m.PinnedCodeIDs {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -736,6 +809,20 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	l = m.TgradeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledCallbacks) > 0 {
+		for _, e := range m.ScheduledCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintedSupply) > 0 {
+		for _, e := range m.MintedSupply {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1125,6 +1212,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PinnedCodeIDs", wireType)
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TgradeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TgradeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCallbacks = append(m.ScheduledCallbacks, ScheduledCallback{})
+			if err := m.ScheduledCallbacks[len(m.ScheduledCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedSupply = append(m.MintedSupply, MintedSupply{})
+			if err := m.MintedSupply[len(m.MintedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
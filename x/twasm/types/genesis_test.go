@@ -49,6 +49,29 @@ func TestGenesisValidate(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"scheduled callbacks": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{
+					{ID: 1, ContractAddress: RandomBech32Address(t), Height: 10},
+					{ID: 2, ContractAddress: RandomBech32Address(t), Height: 20, Interval: 5},
+				}
+			}),
+		},
+		"invalid scheduled callback": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{{ID: 1, ContractAddress: RandomBech32Address(t)}}
+			}),
+			expErr: true,
+		},
+		"duplicate scheduled callback ids": {
+			state: GenesisStateFixture(t, func(state *GenesisState) {
+				state.ScheduledCallbacks = []ScheduledCallback{
+					{ID: 1, ContractAddress: RandomBech32Address(t), Height: 10},
+					{ID: 1, ContractAddress: RandomBech32Address(t), Height: 20},
+				}
+			}),
+			expErr: true,
+		},
 		"unique pinned codeIDs": {
 			state: GenesisStateFixture(t, func(state *GenesisState) {
 				state.PinnedCodeIDs = []uint64{1, 2, 3}
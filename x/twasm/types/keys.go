@@ -21,4 +21,12 @@ var (
 
 	PrivilegedContractsSecondaryIndexPrefix = []byte{0xa0}
 	ContractCallbacksSecondaryIndexPrefix   = []byte{0xa1}
+	CallbackFailuresPrefix                  = []byte{0xa2}
+	PrivilegeExpiryHeightIndexPrefix        = []byte{0xa3}
+	PrivilegeExpiryTimeIndexPrefix          = []byte{0xa4}
+	ScheduledCallbackSequenceKey            = []byte{0xa5}
+	ScheduledCallbacksPrefix                = []byte{0xa6}
+	ScheduledCallbackHeightIndexPrefix      = []byte{0xa7}
+	ScheduledCallbackTimeIndexPrefix        = []byte{0xa8}
+	MintedSupplyPrefix                      = []byte{0xa9}
 )
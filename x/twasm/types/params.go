@@ -1,12 +1,318 @@
 package types
 
-import wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+import (
+	"fmt"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	yaml "gopkg.in/yaml.v2"
+)
 
 const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace = ModuleName
 )
 
+var (
+	KeyCallbackGasLimits         = []byte("CallbackGasLimits")
+	KeyContractCallbackGasLimits = []byte("ContractCallbackGasLimits")
+	KeyCallbackFailureThreshold  = []byte("CallbackFailureThreshold")
+	KeyConsensusParamBounds      = []byte("ConsensusParamBounds")
+	KeyMinterQuotas              = []byte("MinterQuotas")
+	KeyMaxRegisteredGasLimit     = []byte("MaxRegisteredCallbackGasLimit")
+)
+
+const (
+	// DefaultMinBlockMaxBytes is the default lower bound for block max bytes updates by privileged contracts
+	DefaultMinBlockMaxBytes int64 = 100_000
+	// DefaultMinBlockMaxGas is the default lower bound for block max gas updates by privileged contracts
+	DefaultMinBlockMaxGas int64 = 1_000_000
+)
+
 func DefaultParams() wasmtypes.Params {
 	return wasmtypes.DefaultParams()
 }
+
+var _ paramtypes.ParamSet = (*TgradeParams)(nil)
+
+// ParamKeyTable returns the key table for the shared wasm param subspace with wasmd and tgrade params
+func ParamKeyTable() paramtypes.KeyTable {
+	return wasmtypes.ParamKeyTable().RegisterParamSet(&TgradeParams{})
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *TgradeParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCallbackGasLimits, &p.CallbackGasLimits, validateCallbackGasLimits),
+		paramtypes.NewParamSetPair(KeyContractCallbackGasLimits, &p.ContractCallbackGasLimits, validateContractCallbackGasLimits),
+		paramtypes.NewParamSetPair(KeyCallbackFailureThreshold, &p.CallbackFailureThreshold, validateUint32),
+		paramtypes.NewParamSetPair(KeyConsensusParamBounds, &p.ConsensusParamBounds, validateConsensusParamBounds),
+		paramtypes.NewParamSetPair(KeyMinterQuotas, &p.MinterQuotas, validateMinterQuotas),
+		paramtypes.NewParamSetPair(KeyMaxRegisteredGasLimit, &p.MaxRegisteredCallbackGasLimit, validateUint64),
+	}
+}
+
+// DefaultTgradeParams returns a default set of tgrade parameters. No gas limits, failure threshold, max consensus
+// param bounds or minter quotas are set by default. The min block bounds prevent a privileged contract from
+// setting block limits that no transaction fits into.
+func DefaultTgradeParams() TgradeParams {
+	return TgradeParams{
+		CallbackGasLimits:         []PrivilegeGasLimit{},
+		ContractCallbackGasLimits: []ContractGasLimit{},
+		ConsensusParamBounds: ConsensusParamBounds{
+			MinBlockMaxBytes: DefaultMinBlockMaxBytes,
+			MinBlockMaxGas:   DefaultMinBlockMaxGas,
+		},
+		MinterQuotas: []MinterQuota{},
+	}
+}
+
+// String returns a human-readable string representation of the parameters.
+func (p TgradeParams) String() string {
+	out, err := yaml.Marshal(p)
+	if err != nil {
+		out = []byte(fmt.Sprintf("failed to serialize: %s", err))
+	}
+	return string(out)
+}
+
+// ValidateBasic syntax checks
+func (p TgradeParams) ValidateBasic() error {
+	if err := validateCallbackGasLimits(p.CallbackGasLimits); err != nil {
+		return sdkerrors.Wrap(err, "callback gas limits")
+	}
+	if err := validateContractCallbackGasLimits(p.ContractCallbackGasLimits); err != nil {
+		return sdkerrors.Wrap(err, "contract callback gas limits")
+	}
+	if err := validateMinterQuotas(p.MinterQuotas); err != nil {
+		return sdkerrors.Wrap(err, "minter quotas")
+	}
+	return sdkerrors.Wrap(p.ConsensusParamBounds.ValidateBasic(), "consensus param bounds")
+}
+
+// CallbackGasLimit returns the gas limit for the given contract and privilege type.
+// A contract specific limit takes precedence. Otherwise, the registered gas limit overrides the default limit
+// for the privilege type. Returns 0 when no limit applies.
+func (p TgradeParams) CallbackGasLimit(privilegeType PrivilegeType, contractAddr sdk.AccAddress, registered uint64) uint64 {
+	for _, v := range p.ContractCallbackGasLimits {
+		if v.PrivilegeType == privilegeType.String() && v.ContractAddress == contractAddr.String() {
+			return v.GasLimit
+		}
+	}
+	if registered != 0 {
+		return registered
+	}
+	for _, v := range p.CallbackGasLimits {
+		if v.PrivilegeType == privilegeType.String() {
+			return v.GasLimit
+		}
+	}
+	return 0
+}
+
+// ValidateRegisteredGasLimit ensures that a gas limit set on privilege registration does not exceed the
+// max registered callback gas limit. Zero stands for no custom gas limit and is always valid.
+func (p TgradeParams) ValidateRegisteredGasLimit(gasLimit uint64) error {
+	if gasLimit != 0 && gasLimit > p.MaxRegisteredCallbackGasLimit {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "gas limit %d exceeds max %d", gasLimit, p.MaxRegisteredCallbackGasLimit)
+	}
+	return nil
+}
+
+// MinterQuota returns the quota for the given contract and denom. Result is nil when none is set.
+func (p TgradeParams) MinterQuota(contractAddr sdk.AccAddress, denom string) *MinterQuota {
+	for _, v := range p.MinterQuotas {
+		if v.Denom == denom && v.ContractAddress == contractAddr.String() {
+			q := v
+			return &q
+		}
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (l PrivilegeGasLimit) ValidateBasic() error {
+	if PrivilegeTypeFrom(l.PrivilegeType) == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "privilege type")
+	}
+	if l.GasLimit == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (l ContractGasLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if PrivilegeTypeFrom(l.PrivilegeType) == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "privilege type")
+	}
+	if l.GasLimit == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+func validateCallbackGasLimits(i interface{}) error {
+	v, ok := i.([]PrivilegeGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(v))
+	for i, l := range v {
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "gas limit %d", i)
+		}
+		if _, exists := unique[l.PrivilegeType]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "privilege type %q", l.PrivilegeType)
+		}
+		unique[l.PrivilegeType] = struct{}{}
+	}
+	return nil
+}
+
+func validateContractCallbackGasLimits(i interface{}) error {
+	v, ok := i.([]ContractGasLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(v))
+	for i, l := range v {
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "gas limit %d", i)
+		}
+		key := l.ContractAddress + "/" + l.PrivilegeType
+		if _, exists := unique[key]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "contract %s with privilege type %q", l.ContractAddress, l.PrivilegeType)
+		}
+		unique[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (b ConsensusParamBounds) ValidateBasic() error {
+	if b.MinBlockMaxBytes < 0 || b.MaxBlockMaxBytes < 0 || b.MinBlockMaxGas < 0 || b.MaxBlockMaxGas < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "must not be negative")
+	}
+	if b.MaxBlockMaxBytes > tmtypes.MaxBlockSizeBytes {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max block max bytes exceeds %d", tmtypes.MaxBlockSizeBytes)
+	}
+	if b.MaxBlockMaxBytes != 0 && b.MinBlockMaxBytes > b.MaxBlockMaxBytes {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "min block max bytes exceeds max")
+	}
+	if b.MaxBlockMaxGas != 0 && b.MinBlockMaxGas > b.MaxBlockMaxGas {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "min block max gas exceeds max")
+	}
+	return nil
+}
+
+// ValidateBlockParams ensures that the block params are valid for tendermint and within the bounds.
+// A max gas value of -1 stands for unlimited. Zero max gas is always rejected as no transaction would fit into a block.
+func (b ConsensusParamBounds) ValidateBlockParams(maxBytes, maxGas int64) error {
+	switch {
+	case maxBytes <= 0 || maxBytes > tmtypes.MaxBlockSizeBytes:
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max bytes must be in range 1..%d", tmtypes.MaxBlockSizeBytes)
+	case maxGas < -1:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max gas must not be below -1")
+	case maxGas == 0:
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max gas must not be zero")
+	case b.MinBlockMaxBytes != 0 && maxBytes < b.MinBlockMaxBytes:
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max bytes %d below min bound %d", maxBytes, b.MinBlockMaxBytes)
+	case b.MaxBlockMaxBytes != 0 && maxBytes > b.MaxBlockMaxBytes:
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max bytes %d above max bound %d", maxBytes, b.MaxBlockMaxBytes)
+	case b.MinBlockMaxGas != 0 && maxGas != -1 && maxGas < b.MinBlockMaxGas:
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max gas %d below min bound %d", maxGas, b.MinBlockMaxGas)
+	case b.MaxBlockMaxGas != 0 && (maxGas == -1 || maxGas > b.MaxBlockMaxGas):
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "max gas %d above max bound %d", maxGas, b.MaxBlockMaxGas)
+	}
+	return nil
+}
+
+func validateConsensusParamBounds(i interface{}) error {
+	v, ok := i.(ConsensusParamBounds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+func validateUint32(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (q MinterQuota) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "denom")
+	}
+	if !q.EpochCap.IsNil() && q.EpochCap.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "epoch cap must not be negative")
+	}
+	if !q.LifetimeCap.IsNil() && q.LifetimeCap.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "lifetime cap must not be negative")
+	}
+	if q.HasEpochCap() && q.EpochLength == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "epoch length")
+	}
+	if q.HasEpochCap() && q.HasLifetimeCap() && q.EpochCap.GT(q.LifetimeCap) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "epoch cap exceeds lifetime cap")
+	}
+	return nil
+}
+
+// HasEpochCap returns true when the amount minted within an epoch is limited
+func (q MinterQuota) HasEpochCap() bool {
+	return !q.EpochCap.IsNil() && q.EpochCap.IsPositive()
+}
+
+// HasLifetimeCap returns true when the total amount minted is limited
+func (q MinterQuota) HasLifetimeCap() bool {
+	return !q.LifetimeCap.IsNil() && q.LifetimeCap.IsPositive()
+}
+
+// Epoch returns the number of the epoch for the given block time. Returns 0 when no epoch length is set.
+func (q MinterQuota) Epoch(blockTime time.Time) uint64 {
+	if q.EpochLength == 0 || blockTime.Unix() < 0 {
+		return 0
+	}
+	return uint64(blockTime.Unix()) / q.EpochLength
+}
+
+func validateMinterQuotas(i interface{}) error {
+	v, ok := i.([]MinterQuota)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(v))
+	for i, q := range v {
+		if err := q.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "minter quota %d", i)
+		}
+		key := q.ContractAddress + "/" + q.Denom
+		if _, exists := unique[key]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "contract %s with denom %q", q.ContractAddress, q.Denom)
+		}
+		unique[key] = struct{}{}
+	}
+	return nil
+}
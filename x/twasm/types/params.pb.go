@@ -0,0 +1,1934 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: confio/twasm/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TgradeParams are the tgrade specific params that are stored next to the
+// wasmd params
+type TgradeParams struct {
+	// CallbackGasLimits is the default gas limit per privilege type for the abci
+	// callbacks into privileged contracts. A gas limit set on registration
+	// overrides it. No limit when not set or zero.
+	CallbackGasLimits []PrivilegeGasLimit `protobuf:"bytes,1,rep,name=callback_gas_limits,json=callbackGasLimits,proto3" json:"callback_gas_limits" yaml:"callback_gas_limits"`
+	// ContractCallbackGasLimits are gas limits for a single contract and
+	// privilege type. They take precedence over any other limit.
+	ContractCallbackGasLimits []ContractGasLimit `protobuf:"bytes,2,rep,name=contract_callback_gas_limits,json=contractCallbackGasLimits,proto3" json:"contract_callback_gas_limits" yaml:"contract_callback_gas_limits"`
+	// CallbackFailureThreshold is the number of consecutive failed begin/end
+	// block callbacks after which the privilege is released from the contract.
+	// Disabled when zero.
+	CallbackFailureThreshold uint32 `protobuf:"varint,3,opt,name=callback_failure_threshold,json=callbackFailureThreshold,proto3" json:"callback_failure_threshold,omitempty" yaml:"callback_failure_threshold"`
+	// ConsensusParamBounds are the limits for block consensus param updates by
+	// privileged contracts.
+	ConsensusParamBounds ConsensusParamBounds `protobuf:"bytes,4,opt,name=consensus_param_bounds,json=consensusParamBounds,proto3" json:"consensus_param_bounds" yaml:"consensus_param_bounds"`
+	// MinterQuotas are the mint limits for a single contract and denom. Minting
+	// is not limited for contracts or denoms without a quota.
+	MinterQuotas []MinterQuota `protobuf:"bytes,5,rep,name=minter_quotas,json=minterQuotas,proto3" json:"minter_quotas" yaml:"minter_quotas"`
+	// MaxRegisteredCallbackGasLimit is the upper bound for the gas limit that a
+	// contract can set on privilege registration. Registrations above it are
+	// rejected. Custom gas limits are not accepted when zero.
+	MaxRegisteredCallbackGasLimit uint64 `protobuf:"varint,6,opt,name=max_registered_callback_gas_limit,json=maxRegisteredCallbackGasLimit,proto3" json:"max_registered_callback_gas_limit,omitempty" yaml:"max_registered_callback_gas_limit"`
+}
+
+func (m *TgradeParams) Reset()      { *m = TgradeParams{} }
+func (*TgradeParams) ProtoMessage() {}
+func (*TgradeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{0}
+}
+
+func (m *TgradeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TgradeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TgradeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TgradeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TgradeParams.Merge(m, src)
+}
+
+func (m *TgradeParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TgradeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TgradeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TgradeParams proto.InternalMessageInfo
+
+func (m *TgradeParams) GetCallbackGasLimits() []PrivilegeGasLimit {
+	if m != nil {
+		return m.CallbackGasLimits
+	}
+	return nil
+}
+
+func (m *TgradeParams) GetContractCallbackGasLimits() []ContractGasLimit {
+	if m != nil {
+		return m.ContractCallbackGasLimits
+	}
+	return nil
+}
+
+func (m *TgradeParams) GetCallbackFailureThreshold() uint32 {
+	if m != nil {
+		return m.CallbackFailureThreshold
+	}
+	return 0
+}
+
+func (m *TgradeParams) GetConsensusParamBounds() ConsensusParamBounds {
+	if m != nil {
+		return m.ConsensusParamBounds
+	}
+	return ConsensusParamBounds{}
+}
+
+func (m *TgradeParams) GetMinterQuotas() []MinterQuota {
+	if m != nil {
+		return m.MinterQuotas
+	}
+	return nil
+}
+
+func (m *TgradeParams) GetMaxRegisteredCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxRegisteredCallbackGasLimit
+	}
+	return 0
+}
+
+// PrivilegeGasLimit is the gas limit for a privilege type
+type PrivilegeGasLimit struct {
+	PrivilegeType string `protobuf:"bytes,1,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty" yaml:"privilege_type"`
+	GasLimit      uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *PrivilegeGasLimit) Reset()         { *m = PrivilegeGasLimit{} }
+func (m *PrivilegeGasLimit) String() string { return proto.CompactTextString(m) }
+func (*PrivilegeGasLimit) ProtoMessage()    {}
+func (*PrivilegeGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{1}
+}
+
+func (m *PrivilegeGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PrivilegeGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivilegeGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PrivilegeGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegeGasLimit.Merge(m, src)
+}
+
+func (m *PrivilegeGasLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PrivilegeGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegeGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivilegeGasLimit proto.InternalMessageInfo
+
+func (m *PrivilegeGasLimit) GetPrivilegeType() string {
+	if m != nil {
+		return m.PrivilegeType
+	}
+	return ""
+}
+
+func (m *PrivilegeGasLimit) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// ContractGasLimit is the gas limit for a privilege type of a single contract
+type ContractGasLimit struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	PrivilegeType   string `protobuf:"bytes,2,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty" yaml:"privilege_type"`
+	GasLimit        uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *ContractGasLimit) Reset()         { *m = ContractGasLimit{} }
+func (m *ContractGasLimit) String() string { return proto.CompactTextString(m) }
+func (*ContractGasLimit) ProtoMessage()    {}
+func (*ContractGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{2}
+}
+
+func (m *ContractGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGasLimit.Merge(m, src)
+}
+
+func (m *ContractGasLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGasLimit proto.InternalMessageInfo
+
+func (m *ContractGasLimit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractGasLimit) GetPrivilegeType() string {
+	if m != nil {
+		return m.PrivilegeType
+	}
+	return ""
+}
+
+func (m *ContractGasLimit) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// ConsensusParamBounds are the min/max values for the block consensus params
+// that can be set by privileged contracts. A bound is not enforced when zero.
+type ConsensusParamBounds struct {
+	MinBlockMaxBytes int64 `protobuf:"varint,1,opt,name=min_block_max_bytes,json=minBlockMaxBytes,proto3" json:"min_block_max_bytes,omitempty" yaml:"min_block_max_bytes"`
+	MaxBlockMaxBytes int64 `protobuf:"varint,2,opt,name=max_block_max_bytes,json=maxBlockMaxBytes,proto3" json:"max_block_max_bytes,omitempty" yaml:"max_block_max_bytes"`
+	MinBlockMaxGas   int64 `protobuf:"varint,3,opt,name=min_block_max_gas,json=minBlockMaxGas,proto3" json:"min_block_max_gas,omitempty" yaml:"min_block_max_gas"`
+	MaxBlockMaxGas   int64 `protobuf:"varint,4,opt,name=max_block_max_gas,json=maxBlockMaxGas,proto3" json:"max_block_max_gas,omitempty" yaml:"max_block_max_gas"`
+}
+
+func (m *ConsensusParamBounds) Reset()         { *m = ConsensusParamBounds{} }
+func (m *ConsensusParamBounds) String() string { return proto.CompactTextString(m) }
+func (*ConsensusParamBounds) ProtoMessage()    {}
+func (*ConsensusParamBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{3}
+}
+
+func (m *ConsensusParamBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ConsensusParamBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusParamBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ConsensusParamBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusParamBounds.Merge(m, src)
+}
+
+func (m *ConsensusParamBounds) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ConsensusParamBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusParamBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusParamBounds proto.InternalMessageInfo
+
+func (m *ConsensusParamBounds) GetMinBlockMaxBytes() int64 {
+	if m != nil {
+		return m.MinBlockMaxBytes
+	}
+	return 0
+}
+
+func (m *ConsensusParamBounds) GetMaxBlockMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBlockMaxBytes
+	}
+	return 0
+}
+
+func (m *ConsensusParamBounds) GetMinBlockMaxGas() int64 {
+	if m != nil {
+		return m.MinBlockMaxGas
+	}
+	return 0
+}
+
+func (m *ConsensusParamBounds) GetMaxBlockMaxGas() int64 {
+	if m != nil {
+		return m.MaxBlockMaxGas
+	}
+	return 0
+}
+
+// MinterQuota is the mint limit for a token minter contract and denom. A cap is
+// not enforced when zero.
+type MinterQuota struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	Denom           string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// EpochLength is the duration of an epoch in seconds. Required with an
+	// epoch cap.
+	EpochLength uint64 `protobuf:"varint,3,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+	// EpochCap is the max amount that can be minted within an epoch
+	EpochCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=epoch_cap,json=epochCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_cap" yaml:"epoch_cap"`
+	// LifetimeCap is the max total amount that can be minted
+	LifetimeCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=lifetime_cap,json=lifetimeCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lifetime_cap" yaml:"lifetime_cap"`
+}
+
+func (m *MinterQuota) Reset()         { *m = MinterQuota{} }
+func (m *MinterQuota) String() string { return proto.CompactTextString(m) }
+func (*MinterQuota) ProtoMessage()    {}
+func (*MinterQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_758df640b2d86bed, []int{4}
+}
+
+func (m *MinterQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MinterQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MinterQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterQuota.Merge(m, src)
+}
+
+func (m *MinterQuota) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MinterQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterQuota proto.InternalMessageInfo
+
+func (m *MinterQuota) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MinterQuota) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MinterQuota) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TgradeParams)(nil), "confio.twasm.v1beta1.TgradeParams")
+	proto.RegisterType((*PrivilegeGasLimit)(nil), "confio.twasm.v1beta1.PrivilegeGasLimit")
+	proto.RegisterType((*ContractGasLimit)(nil), "confio.twasm.v1beta1.ContractGasLimit")
+	proto.RegisterType((*ConsensusParamBounds)(nil), "confio.twasm.v1beta1.ConsensusParamBounds")
+	proto.RegisterType((*MinterQuota)(nil), "confio.twasm.v1beta1.MinterQuota")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/params.proto", fileDescriptor_758df640b2d86bed) }
+
+var fileDescriptor_758df640b2d86bed = []byte{
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0x63, 0xd5, 0x4c, 0xd2, 0x25, 0x75, 0x03, 0xeb, 0x2d, 0xdd, 0x38, 0x19, 0xb4,
+	0x25, 0xe2, 0x47, 0xa2, 0x2e, 0xb7, 0x9e, 0x16, 0x47, 0x6c, 0x84, 0xd4, 0x4a, 0xc5, 0xea, 0x89,
+	0x8b, 0x35, 0xb1, 0xa7, 0x8e, 0x55, 0xdb, 0x63, 0x3c, 0x93, 0xe2, 0x88, 0x3f, 0x00, 0x89, 0x03,
+	0xe2, 0xc0, 0x81, 0x63, 0xff, 0x9c, 0x1e, 0x38, 0xf4, 0x82, 0x84, 0x38, 0x58, 0xa8, 0xbd, 0x70,
+	0xf6, 0x8d, 0x1b, 0xf2, 0x8c, 0x9d, 0x9f, 0x06, 0x54, 0xc1, 0x29, 0xf6, 0x7b, 0xdf, 0xfb, 0xde,
+	0xf7, 0xcd, 0x7b, 0xf1, 0x80, 0x9e, 0x49, 0xfc, 0x4b, 0x87, 0x0c, 0xd9, 0xd7, 0x88, 0x7a, 0xc3,
+	0xeb, 0xe3, 0x09, 0x66, 0xe8, 0x78, 0x18, 0xa0, 0x10, 0x79, 0x74, 0x10, 0x84, 0x84, 0x11, 0xb9,
+	0x2d, 0x20, 0x03, 0x0e, 0x19, 0x64, 0x90, 0x83, 0xb6, 0x4d, 0x6c, 0xc2, 0x01, 0xc3, 0xf4, 0x49,
+	0x60, 0xe1, 0x9f, 0x35, 0xd0, 0xbc, 0xb0, 0x43, 0x64, 0xe1, 0x73, 0x4e, 0x21, 0x7f, 0x03, 0xf6,
+	0x4d, 0xe4, 0xba, 0x13, 0x64, 0x5e, 0x19, 0x36, 0xa2, 0x86, 0xeb, 0x78, 0x0e, 0xa3, 0x8a, 0xd4,
+	0xad, 0xf4, 0x1b, 0xaf, 0xde, 0x1f, 0x14, 0x51, 0x0f, 0xce, 0x43, 0xe7, 0xda, 0x71, 0xb1, 0x8d,
+	0xc7, 0x88, 0x9e, 0xa6, 0x78, 0x0d, 0xde, 0xc6, 0x6a, 0x29, 0x89, 0xd5, 0x83, 0x39, 0xf2, 0xdc,
+	0x13, 0x58, 0xc0, 0x08, 0xf5, 0xbd, 0x3c, 0x9a, 0x57, 0x51, 0xf9, 0x47, 0x09, 0x1c, 0x9a, 0xc4,
+	0x67, 0x21, 0x32, 0x99, 0x51, 0x24, 0xa3, 0xcc, 0x65, 0x1c, 0x15, 0xcb, 0x18, 0x65, 0x95, 0x0b,
+	0x15, 0x1f, 0x66, 0x2a, 0xde, 0xcb, 0x54, 0xfc, 0x03, 0x33, 0xd4, 0x9f, 0xe7, 0xe9, 0xd1, 0x96,
+	0x2c, 0x13, 0x1c, 0x2c, 0x4a, 0x2e, 0x91, 0xe3, 0xce, 0x42, 0x6c, 0xb0, 0x69, 0x88, 0xe9, 0x94,
+	0xb8, 0x96, 0x52, 0xe9, 0x4a, 0xfd, 0x5d, 0xed, 0x65, 0x12, 0xab, 0xbd, 0x0d, 0xb7, 0x5b, 0x58,
+	0xa8, 0x2b, 0x79, 0xf2, 0x8d, 0xc8, 0x5d, 0xe4, 0x29, 0xf9, 0x5b, 0x09, 0xbc, 0x63, 0x12, 0x9f,
+	0x62, 0x9f, 0xce, 0xa8, 0xc1, 0x07, 0x6a, 0x4c, 0xc8, 0xcc, 0xb7, 0xa8, 0x52, 0xed, 0x4a, 0xfd,
+	0xc6, 0xab, 0x0f, 0xfe, 0xd6, 0xb5, 0xa8, 0xe1, 0x03, 0xd4, 0x78, 0x85, 0xf6, 0x32, 0x73, 0xfe,
+	0x62, 0xe1, 0xbc, 0x80, 0x17, 0xea, 0x6d, 0xb3, 0xa0, 0x58, 0xb6, 0xc0, 0xae, 0xe7, 0xf8, 0x0c,
+	0x87, 0xc6, 0x57, 0x33, 0xc2, 0x10, 0x55, 0x6a, 0xfc, 0xd4, 0x7b, 0xc5, 0xfd, 0xcf, 0x38, 0xf4,
+	0x8b, 0x14, 0xa9, 0x1d, 0x66, 0x6d, 0xdb, 0xa2, 0xed, 0x1a, 0x0b, 0xd4, 0x9b, 0xde, 0x12, 0x4a,
+	0xe5, 0x6b, 0xd0, 0xf3, 0x50, 0x64, 0x84, 0xd8, 0x76, 0x28, 0xc3, 0x21, 0xb6, 0x0a, 0xc6, 0xa2,
+	0x3c, 0xe9, 0x4a, 0xfd, 0xaa, 0xf6, 0x51, 0x12, 0xab, 0xfd, 0x8c, 0xf2, 0xdf, 0x4a, 0xa0, 0xfe,
+	0xc2, 0x43, 0x91, 0xbe, 0x80, 0x6c, 0x4e, 0xf3, 0x64, 0xe7, 0xa7, 0x1b, 0xb5, 0xf4, 0xc7, 0x8d,
+	0x2a, 0xc1, 0xef, 0x25, 0xb0, 0xb7, 0xb5, 0xba, 0xf2, 0x6b, 0xf0, 0x34, 0xc8, 0x83, 0x06, 0x9b,
+	0x07, 0x58, 0x91, 0xba, 0x52, 0xbf, 0xae, 0x3d, 0x4f, 0x62, 0xf5, 0x6d, 0x21, 0x62, 0x3d, 0x0f,
+	0xf5, 0xdd, 0x45, 0xe0, 0x62, 0x1e, 0x60, 0xf9, 0x18, 0xd4, 0x97, 0x0e, 0xca, 0xdc, 0x41, 0x3b,
+	0x89, 0xd5, 0x96, 0x28, 0x5e, 0x51, 0xba, 0x63, 0xe7, 0xa2, 0xaa, 0x5c, 0xd0, 0x2f, 0x12, 0x68,
+	0x6d, 0x2e, 0xb1, 0xfc, 0x06, 0xb4, 0x16, 0x8b, 0x8b, 0x2c, 0x2b, 0xc4, 0x94, 0x66, 0x8a, 0xde,
+	0x4d, 0x62, 0xf5, 0xd9, 0xc6, 0x6a, 0x67, 0x08, 0xa8, 0xbf, 0x95, 0x87, 0x3e, 0x15, 0x91, 0x02,
+	0x5f, 0xe5, 0xff, 0xe2, 0xab, 0xf2, 0x08, 0x5f, 0x3f, 0x97, 0x41, 0xbb, 0x68, 0x4d, 0xe5, 0x33,
+	0xb0, 0xef, 0x39, 0xbe, 0x31, 0x71, 0x89, 0x79, 0x65, 0xa4, 0xa3, 0x9d, 0xcc, 0x19, 0x16, 0xf6,
+	0x2a, 0x5a, 0x67, 0xf9, 0xfd, 0x28, 0x00, 0x41, 0xbd, 0xe5, 0x39, 0xbe, 0x96, 0x06, 0xcf, 0x50,
+	0xa4, 0xa5, 0x21, 0x4e, 0x97, 0xe6, 0x37, 0xe8, 0xca, 0x5b, 0x74, 0x28, 0x2a, 0xa2, 0x43, 0xd1,
+	0x3a, 0xdd, 0x18, 0xec, 0xad, 0x37, 0xb6, 0x11, 0xe5, 0xbe, 0x2b, 0xda, 0x61, 0x12, 0xab, 0x4a,
+	0x91, 0x36, 0x3b, 0x5d, 0xf4, 0xa7, 0x2b, 0xca, 0xc6, 0x48, 0x10, 0xa1, 0x68, 0x83, 0xa8, 0xba,
+	0x45, 0x84, 0xa2, 0x6d, 0xa2, 0xa5, 0xa6, 0x31, 0xa2, 0xd9, 0x71, 0x7e, 0x57, 0x01, 0x8d, 0x95,
+	0x7f, 0xdd, 0xff, 0xb6, 0x21, 0x47, 0xa0, 0x66, 0x61, 0x9f, 0x78, 0xd9, 0x62, 0xb4, 0x92, 0x58,
+	0x6d, 0x8a, 0x62, 0x1e, 0x86, 0xba, 0x48, 0xcb, 0x27, 0xa0, 0x89, 0x03, 0x62, 0x4e, 0x0d, 0x17,
+	0xfb, 0x36, 0x9b, 0x66, 0xab, 0xf0, 0x2c, 0x89, 0xd5, 0x7d, 0x01, 0x5f, 0xcd, 0x42, 0xbd, 0xc1,
+	0x5f, 0x4f, 0xf9, 0x9b, 0x6c, 0x80, 0xba, 0xc8, 0x9a, 0x28, 0xe0, 0x47, 0x50, 0xd7, 0xb4, 0xf4,
+	0xa3, 0xf1, 0x5b, 0xac, 0x1e, 0xd9, 0x0e, 0x9b, 0xce, 0x26, 0x03, 0x93, 0x78, 0x43, 0x93, 0x50,
+	0x8f, 0xd0, 0xec, 0xe7, 0x63, 0x6a, 0x5d, 0x0d, 0xd3, 0x8d, 0xa4, 0x83, 0xcf, 0x7d, 0xb6, 0xdc,
+	0xb8, 0x05, 0x11, 0xd4, 0x77, 0xf8, 0xf3, 0x08, 0x05, 0xf2, 0x14, 0x34, 0x5d, 0xe7, 0x12, 0x33,
+	0xc7, 0xc3, 0xbc, 0x47, 0x8d, 0xf7, 0xf8, 0xec, 0xd1, 0x3d, 0x32, 0x2b, 0xab, 0x5c, 0x50, 0x6f,
+	0xe4, 0xaf, 0x23, 0x14, 0x88, 0x61, 0x68, 0xaf, 0x6f, 0xef, 0x3b, 0xd2, 0xdd, 0x7d, 0x47, 0xfa,
+	0xfd, 0xbe, 0x23, 0xfd, 0xf0, 0xd0, 0x29, 0xdd, 0x3d, 0x74, 0x4a, 0xbf, 0x3e, 0x74, 0x4a, 0x5f,
+	0xae, 0xf7, 0x12, 0x97, 0x36, 0xbf, 0x69, 0x87, 0x51, 0x76, 0x7b, 0xf3, 0x7e, 0x93, 0x27, 0xfc,
+	0x26, 0xfe, 0xe4, 0xaf, 0x01, 0x00, 0x77, 0xf6, 0x5b, 0xd8, 0xda, 0x07, 0x00, 0x00,
+}
+
+func (this *TgradeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TgradeParams)
+	if !ok {
+		that2, ok := that.(TgradeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CallbackGasLimits) != len(that1.CallbackGasLimits) {
+		return false
+	}
+	for i := range this.CallbackGasLimits {
+		if !this.CallbackGasLimits[i].Equal(&that1.CallbackGasLimits[i]) {
+			return false
+		}
+	}
+	if len(this.ContractCallbackGasLimits) != len(that1.ContractCallbackGasLimits) {
+		return false
+	}
+	for i := range this.ContractCallbackGasLimits {
+		if !this.ContractCallbackGasLimits[i].Equal(&that1.ContractCallbackGasLimits[i]) {
+			return false
+		}
+	}
+	if this.CallbackFailureThreshold != that1.CallbackFailureThreshold {
+		return false
+	}
+	if !this.ConsensusParamBounds.Equal(&that1.ConsensusParamBounds) {
+		return false
+	}
+	if len(this.MinterQuotas) != len(that1.MinterQuotas) {
+		return false
+	}
+	for i := range this.MinterQuotas {
+		if !this.MinterQuotas[i].Equal(&that1.MinterQuotas[i]) {
+			return false
+		}
+	}
+	if this.MaxRegisteredCallbackGasLimit != that1.MaxRegisteredCallbackGasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *PrivilegeGasLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrivilegeGasLimit)
+	if !ok {
+		that2, ok := that.(PrivilegeGasLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PrivilegeType != that1.PrivilegeType {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *ContractGasLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractGasLimit)
+	if !ok {
+		that2, ok := that.(ContractGasLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.PrivilegeType != that1.PrivilegeType {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *ConsensusParamBounds) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsensusParamBounds)
+	if !ok {
+		that2, ok := that.(ConsensusParamBounds)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinBlockMaxBytes != that1.MinBlockMaxBytes {
+		return false
+	}
+	if this.MaxBlockMaxBytes != that1.MaxBlockMaxBytes {
+		return false
+	}
+	if this.MinBlockMaxGas != that1.MinBlockMaxGas {
+		return false
+	}
+	if this.MaxBlockMaxGas != that1.MaxBlockMaxGas {
+		return false
+	}
+	return true
+}
+
+func (this *MinterQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterQuota)
+	if !ok {
+		that2, ok := that.(MinterQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if !this.EpochCap.Equal(that1.EpochCap) {
+		return false
+	}
+	if !this.LifetimeCap.Equal(that1.LifetimeCap) {
+		return false
+	}
+	return true
+}
+
+func (m *TgradeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TgradeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TgradeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRegisteredCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRegisteredCallbackGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MinterQuotas) > 0 {
+		for iNdEx := len(m.MinterQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.ConsensusParamBounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CallbackFailureThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackFailureThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractCallbackGasLimits) > 0 {
+		for iNdEx := len(m.ContractCallbackGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCallbackGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CallbackGasLimits) > 0 {
+		for iNdEx := len(m.CallbackGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivilegeGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivilegeGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivilegeGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusParamBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusParamBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusParamBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockMaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinBlockMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBlockMaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBlockMaxBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockMaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinBlockMaxBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBlockMaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinterQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LifetimeCap.Size()
+		i -= size
+		if _, err := m.LifetimeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EpochCap.Size()
+		i -= size
+		if _, err := m.EpochCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TgradeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CallbackGasLimits) > 0 {
+		for _, e := range m.CallbackGasLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ContractCallbackGasLimits) > 0 {
+		for _, e := range m.ContractCallbackGasLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CallbackFailureThreshold != 0 {
+		n += 1 + sovParams(uint64(m.CallbackFailureThreshold))
+	}
+	l = m.ConsensusParamBounds.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MinterQuotas) > 0 {
+		for _, e := range m.MinterQuotas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRegisteredCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxRegisteredCallbackGasLimit))
+	}
+	return n
+}
+
+func (m *PrivilegeGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovParams(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *ContractGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovParams(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *ConsensusParamBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinBlockMaxBytes != 0 {
+		n += 1 + sovParams(uint64(m.MinBlockMaxBytes))
+	}
+	if m.MaxBlockMaxBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockMaxBytes))
+	}
+	if m.MinBlockMaxGas != 0 {
+		n += 1 + sovParams(uint64(m.MinBlockMaxGas))
+	}
+	if m.MaxBlockMaxGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockMaxGas))
+	}
+	return n
+}
+
+func (m *MinterQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovParams(uint64(m.EpochLength))
+	}
+	l = m.EpochCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LifetimeCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TgradeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TgradeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TgradeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackGasLimits = append(m.CallbackGasLimits, PrivilegeGasLimit{})
+			if err := m.CallbackGasLimits[len(m.CallbackGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbackGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallbackGasLimits = append(m.ContractCallbackGasLimits, ContractGasLimit{})
+			if err := m.ContractCallbackGasLimits[len(m.ContractCallbackGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackFailureThreshold", wireType)
+			}
+			m.CallbackFailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackFailureThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusParamBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterQuotas = append(m.MinterQuotas, MinterQuota{})
+			if err := m.MinterQuotas[len(m.MinterQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegisteredCallbackGasLimit", wireType)
+			}
+			m.MaxRegisteredCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegisteredCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PrivilegeGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivilegeGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivilegeGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ConsensusParamBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusParamBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusParamBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockMaxBytes", wireType)
+			}
+			m.MinBlockMaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockMaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockMaxBytes", wireType)
+			}
+			m.MaxBlockMaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockMaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockMaxGas", wireType)
+			}
+			m.MinBlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockMaxGas", wireType)
+			}
+			m.MaxBlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MinterQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LifetimeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
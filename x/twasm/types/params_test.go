@@ -0,0 +1,335 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestTgradeParamsValidation(t *testing.T) {
+	myAddr := RandomBech32Address(t)
+	specs := map[string]struct {
+		src    TgradeParams
+		expErr bool
+	}{
+		"default": {
+			src: DefaultTgradeParams(),
+		},
+		"empty": {
+			src: TgradeParams{},
+		},
+		"with limits": {
+			src: TgradeParams{
+				CallbackGasLimits: []PrivilegeGasLimit{
+					{PrivilegeType: "begin_blocker", GasLimit: 1},
+					{PrivilegeType: "end_blocker", GasLimit: 1},
+				},
+				ContractCallbackGasLimits: []ContractGasLimit{
+					{ContractAddress: myAddr, PrivilegeType: "begin_blocker", GasLimit: 1},
+					{ContractAddress: myAddr, PrivilegeType: "end_blocker", GasLimit: 1},
+				},
+			},
+		},
+		"duplicate privilege type": {
+			src: TgradeParams{CallbackGasLimits: []PrivilegeGasLimit{
+				{PrivilegeType: "begin_blocker", GasLimit: 1},
+				{PrivilegeType: "begin_blocker", GasLimit: 2},
+			}},
+			expErr: true,
+		},
+		"unknown privilege type": {
+			src:    TgradeParams{CallbackGasLimits: []PrivilegeGasLimit{{PrivilegeType: "unknown", GasLimit: 1}}},
+			expErr: true,
+		},
+		"empty gas limit": {
+			src:    TgradeParams{CallbackGasLimits: []PrivilegeGasLimit{{PrivilegeType: "begin_blocker"}}},
+			expErr: true,
+		},
+		"duplicate contract privilege type": {
+			src: TgradeParams{ContractCallbackGasLimits: []ContractGasLimit{
+				{ContractAddress: myAddr, PrivilegeType: "begin_blocker", GasLimit: 1},
+				{ContractAddress: myAddr, PrivilegeType: "begin_blocker", GasLimit: 2},
+			}},
+			expErr: true,
+		},
+		"invalid contract address": {
+			src:    TgradeParams{ContractCallbackGasLimits: []ContractGasLimit{{ContractAddress: "invalid", PrivilegeType: "begin_blocker", GasLimit: 1}}},
+			expErr: true,
+		},
+		"empty contract gas limit": {
+			src:    TgradeParams{ContractCallbackGasLimits: []ContractGasLimit{{ContractAddress: myAddr, PrivilegeType: "begin_blocker"}}},
+			expErr: true,
+		},
+		"with consensus param bounds": {
+			src: TgradeParams{ConsensusParamBounds: ConsensusParamBounds{
+				MinBlockMaxBytes: 1, MaxBlockMaxBytes: 2, MinBlockMaxGas: 1, MaxBlockMaxGas: 2,
+			}},
+		},
+		"negative consensus param bound": {
+			src:    TgradeParams{ConsensusParamBounds: ConsensusParamBounds{MinBlockMaxGas: -1}},
+			expErr: true,
+		},
+		"consensus param min bytes above max": {
+			src:    TgradeParams{ConsensusParamBounds: ConsensusParamBounds{MinBlockMaxBytes: 2, MaxBlockMaxBytes: 1}},
+			expErr: true,
+		},
+		"consensus param min gas above max": {
+			src:    TgradeParams{ConsensusParamBounds: ConsensusParamBounds{MinBlockMaxGas: 2, MaxBlockMaxGas: 1}},
+			expErr: true,
+		},
+		"consensus param max bytes above tendermint limit": {
+			src:    TgradeParams{ConsensusParamBounds: ConsensusParamBounds{MaxBlockMaxBytes: tmtypes.MaxBlockSizeBytes + 1}},
+			expErr: true,
+		},
+		"minter quotas": {
+			src: TgradeParams{MinterQuotas: []MinterQuota{
+				{ContractAddress: myAddr, Denom: "alx", EpochLength: 60, EpochCap: sdk.NewInt(1), LifetimeCap: sdk.NewInt(2)},
+				{ContractAddress: myAddr, Denom: "blx", LifetimeCap: sdk.NewInt(2)},
+				{ContractAddress: myAddr, Denom: "clx"},
+			}},
+		},
+		"minter quota duplicate": {
+			src: TgradeParams{MinterQuotas: []MinterQuota{
+				{ContractAddress: myAddr, Denom: "alx", LifetimeCap: sdk.NewInt(1)},
+				{ContractAddress: myAddr, Denom: "alx", LifetimeCap: sdk.NewInt(2)},
+			}},
+			expErr: true,
+		},
+		"minter quota invalid address": {
+			src:    TgradeParams{MinterQuotas: []MinterQuota{{ContractAddress: "invalid", Denom: "alx"}}},
+			expErr: true,
+		},
+		"minter quota invalid denom": {
+			src:    TgradeParams{MinterQuotas: []MinterQuota{{ContractAddress: myAddr, Denom: "&&&"}}},
+			expErr: true,
+		},
+		"minter quota epoch cap without length": {
+			src:    TgradeParams{MinterQuotas: []MinterQuota{{ContractAddress: myAddr, Denom: "alx", EpochCap: sdk.NewInt(1)}}},
+			expErr: true,
+		},
+		"minter quota epoch cap above lifetime cap": {
+			src:    TgradeParams{MinterQuotas: []MinterQuota{{ContractAddress: myAddr, Denom: "alx", EpochLength: 60, EpochCap: sdk.NewInt(2), LifetimeCap: sdk.NewInt(1)}}},
+			expErr: true,
+		},
+		"minter quota negative cap": {
+			src:    TgradeParams{MinterQuotas: []MinterQuota{{ContractAddress: myAddr, Denom: "alx", LifetimeCap: sdk.NewInt(-1)}}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestCallbackGasLimit(t *testing.T) {
+	myAddr, otherAddr := RandomAddress(t), RandomAddress(t)
+	params := TgradeParams{
+		CallbackGasLimits: []PrivilegeGasLimit{
+			{PrivilegeType: "begin_blocker", GasLimit: 100},
+		},
+		ContractCallbackGasLimits: []ContractGasLimit{
+			{ContractAddress: myAddr.String(), PrivilegeType: "begin_blocker", GasLimit: 1000},
+		},
+	}
+	specs := map[string]struct {
+		privilegeType PrivilegeType
+		contract      sdk.AccAddress
+		registered    uint64
+		exp           uint64
+	}{
+		"contract limit": {
+			privilegeType: PrivilegeTypeBeginBlock,
+			contract:      myAddr,
+			registered:    10,
+			exp:           1000,
+		},
+		"default limit": {
+			privilegeType: PrivilegeTypeBeginBlock,
+			contract:      otherAddr,
+			exp:           100,
+		},
+		"registered below default limit": {
+			privilegeType: PrivilegeTypeBeginBlock,
+			contract:      otherAddr,
+			registered:    10,
+			exp:           10,
+		},
+		"registered above default limit": {
+			privilegeType: PrivilegeTypeBeginBlock,
+			contract:      otherAddr,
+			registered:    101,
+			exp:           101,
+		},
+		"registered without default limit": {
+			privilegeType: PrivilegeTypeEndBlock,
+			contract:      myAddr,
+			registered:    101,
+			exp:           101,
+		},
+		"no limit": {
+			privilegeType: PrivilegeTypeEndBlock,
+			contract:      myAddr,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := params.CallbackGasLimit(spec.privilegeType, spec.contract, spec.registered)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestValidateRegisteredGasLimit(t *testing.T) {
+	specs := map[string]struct {
+		max      uint64
+		gasLimit uint64
+		expErr   bool
+	}{
+		"below max": {
+			max:      100,
+			gasLimit: 99,
+		},
+		"equal max": {
+			max:      100,
+			gasLimit: 100,
+		},
+		"above max": {
+			max:      100,
+			gasLimit: 101,
+			expErr:   true,
+		},
+		"no gas limit": {
+			max: 100,
+		},
+		"no gas limit without max": {},
+		"gas limit without max": {
+			gasLimit: 1,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			params := TgradeParams{MaxRegisteredCallbackGasLimit: spec.max}
+			gotErr := params.ValidateRegisteredGasLimit(spec.gasLimit)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateBlockParams(t *testing.T) {
+	bounds := ConsensusParamBounds{
+		MinBlockMaxBytes: 100,
+		MaxBlockMaxBytes: 200,
+		MinBlockMaxGas:   1000,
+		MaxBlockMaxGas:   2000,
+	}
+	specs := map[string]struct {
+		bounds   ConsensusParamBounds
+		maxBytes int64
+		maxGas   int64
+		expErr   bool
+	}{
+		"within bounds": {
+			bounds:   bounds,
+			maxBytes: 100,
+			maxGas:   2000,
+		},
+		"no bounds": {
+			maxBytes: 1,
+			maxGas:   -1,
+		},
+		"max bytes below min": {
+			bounds:   bounds,
+			maxBytes: 99,
+			maxGas:   1000,
+			expErr:   true,
+		},
+		"max bytes above max": {
+			bounds:   bounds,
+			maxBytes: 201,
+			maxGas:   1000,
+			expErr:   true,
+		},
+		"max gas below min": {
+			bounds:   bounds,
+			maxBytes: 100,
+			maxGas:   999,
+			expErr:   true,
+		},
+		"max gas above max": {
+			bounds:   bounds,
+			maxBytes: 100,
+			maxGas:   2001,
+			expErr:   true,
+		},
+		"unlimited gas above max": {
+			bounds:   bounds,
+			maxBytes: 100,
+			maxGas:   -1,
+			expErr:   true,
+		},
+		"unlimited gas without max": {
+			bounds:   ConsensusParamBounds{MinBlockMaxGas: 1000},
+			maxBytes: 100,
+			maxGas:   -1,
+		},
+		"zero max bytes": {
+			maxGas: -1,
+			expErr: true,
+		},
+		"max bytes above tendermint limit": {
+			maxBytes: tmtypes.MaxBlockSizeBytes + 1,
+			maxGas:   -1,
+			expErr:   true,
+		},
+		"invalid max gas": {
+			maxBytes: 1,
+			maxGas:   -2,
+			expErr:   true,
+		},
+		"zero max gas": {
+			maxBytes: 1,
+			expErr:   true,
+		},
+		"zero max gas with bounds": {
+			bounds:   ConsensusParamBounds{MaxBlockMaxGas: 2000},
+			maxBytes: 1,
+			expErr:   true,
+		},
+		"below default bounds": {
+			bounds:   DefaultTgradeParams().ConsensusParamBounds,
+			maxBytes: DefaultMinBlockMaxBytes,
+			maxGas:   DefaultMinBlockMaxGas - 1,
+			expErr:   true,
+		},
+		"within default bounds": {
+			bounds:   DefaultTgradeParams().ConsensusParamBounds,
+			maxBytes: DefaultMinBlockMaxBytes,
+			maxGas:   DefaultMinBlockMaxGas,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.bounds.ValidateBlockParams(spec.maxBytes, spec.maxGas)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -44,8 +44,30 @@ var (
 	// The contract receives a sudo message of type export where the result is stored in genesis. For the import path the json object containing state
 	// is passed to the contract via sudo import method.
 	PrivilegeStateExporterImporter = registerCallbackType(0x8, "state_exporter_importer", false)
+
+	// PrivilegeTypeScheduler is a permission to schedule sudo callbacks at a future block height or time.
+	// The contract receives a sudo message of type scheduled_callback when the callback is due.
+	PrivilegeTypeScheduler = registerCallbackType(0x9, "scheduler", false)
+
+	// PrivilegeTypeTokenBurner is a permission to burn native tokens owned by the contract.
+	PrivilegeTypeTokenBurner = registerCallbackType(0xa, "token_burner", false)
+
+	// PrivilegeTypeValidatorSetObserver is called in the end block with the validator set diff that was returned by
+	// the validator_set_updater contract. Not called when the diff is empty.
+	PrivilegeTypeValidatorSetObserver = registerCallbackType(0xb, "validator_set_observer", false)
+
+	// PrivilegeTypeFeeOracle is a permission to set the fee denom ratios of the globalfee module.
+	// This privilege is exclusive to one contract instance, only.
+	PrivilegeTypeFeeOracle = registerCallbackType(0xc, "fee_oracle", true)
 )
 
+// criticalPrivilegeTypes must always have a contract registered. Otherwise, the chain can not produce blocks
+// or be governed anymore.
+var criticalPrivilegeTypes = map[PrivilegeType]struct{}{
+	PrivilegeTypeValidatorSetUpdate:  {},
+	PrivilegeTypeGovProposalExecutor: {},
+}
+
 var (
 	// callbackTypeToString stores the string representation for every type
 	callbackTypeToString = make(map[PrivilegeType]string)
@@ -102,6 +124,12 @@ func (t PrivilegeType) IsSingleton() bool {
 	return ok
 }
 
+// IsCritical returns if the chain depends on a contract registered for this type
+func (t PrivilegeType) IsCritical() bool {
+	_, ok := criticalPrivilegeTypes[t]
+	return ok
+}
+
 // ValidateBasic checks if the callback type was registered
 func (t PrivilegeType) ValidateBasic() error {
 	if _, ok := callbackTypeToString[t]; !ok {
@@ -77,14 +77,18 @@ func TestPrivilegeTypeValidation(t *testing.T) {
 func TestPrivilegedCallbackTypeSingletons(t *testing.T) {
 	// sanity check with manually curated list
 	specs := map[PrivilegeType]bool{
-		PrivilegeTypeBeginBlock:          false,
-		PrivilegeTypeEndBlock:            false,
-		PrivilegeTypeValidatorSetUpdate:  true,
-		PrivilegeTypeGovProposalExecutor: false,
-		PrivilegeTypeTokenMinter:         false,
-		PrivilegeConsensusParamChanger:   false,
-		PrivilegeDelegator:               false,
-		PrivilegeStateExporterImporter:   false,
+		PrivilegeTypeBeginBlock:           false,
+		PrivilegeTypeEndBlock:             false,
+		PrivilegeTypeValidatorSetUpdate:   true,
+		PrivilegeTypeGovProposalExecutor:  false,
+		PrivilegeTypeTokenMinter:          false,
+		PrivilegeConsensusParamChanger:    false,
+		PrivilegeDelegator:                false,
+		PrivilegeStateExporterImporter:    false,
+		PrivilegeTypeScheduler:            false,
+		PrivilegeTypeTokenBurner:          false,
+		PrivilegeTypeValidatorSetObserver: false,
+		PrivilegeTypeFeeOracle:            true,
 	}
 	for c, exp := range specs {
 		t.Run(c.String(), func(t *testing.T) {
@@ -52,16 +52,27 @@ func (p PromoteToPrivilegedContractProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
 		return sdkerrors.Wrap(err, "contract")
 	}
-	return nil
+	if p.Expiry != nil {
+		if err := p.Expiry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "expiry")
+		}
+	}
+	return sdkerrors.Wrap(ValidatePrivilegeTypeNames(p.AllowedPrivileges), "allowed privileges")
 }
 
 // String implements the Stringer interface.
 func (p PromoteToPrivilegedContractProposal) String() string {
+	var expiry string
+	if p.Expiry != nil {
+		expiry = p.Expiry.Description()
+	}
 	return fmt.Sprintf(`Store Code Proposal:
   Title:       %s
   Description: %s
   Contract:    %s
-`, p.Title, p.Description, p.Contract)
+  Allowed:     %s
+  Expiry:      %s
+`, p.Title, p.Description, p.Contract, strings.Join(p.AllowedPrivileges, ", "), expiry)
 }
 
 // MarshalYAML pretty prints the wasm byte code
@@ -91,6 +102,14 @@ func (p DemotePrivilegedContractProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
 		return sdkerrors.Wrap(err, "contract")
 	}
+	if p.Replacement != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Replacement); err != nil {
+			return sdkerrors.Wrap(err, "replacement")
+		}
+		if p.Replacement == p.Contract {
+			return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "replacement must not be the demoted contract")
+		}
+	}
 	return nil
 }
 
@@ -100,7 +119,9 @@ func (p DemotePrivilegedContractProposal) String() string {
   Title:       %s
   Description: %s
   Contract:    %s
-`, p.Title, p.Description, p.Contract)
+  Force:       %t
+  Replacement: %s
+`, p.Title, p.Description, p.Contract, p.Force, p.Replacement)
 }
 
 // MarshalYAML pretty prints the wasm byte code
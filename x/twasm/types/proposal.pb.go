@@ -40,6 +40,12 @@ type PromoteToPrivilegedContractProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
 	// Contract is the address of the smart contract
 	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// AllowedPrivileges is an optional list of privilege types that the contract
+	// can register for. All privilege types are allowed when empty.
+	AllowedPrivileges []string `protobuf:"bytes,4,rep,name=allowed_privileges,json=allowedPrivileges,proto3" json:"allowed_privileges,omitempty" yaml:"allowed_privileges,omitempty"`
+	// Expiry is an optional height or time after which the contract is demoted
+	// automatically
+	Expiry *PrivilegeExpiry `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty" yaml:"expiry,omitempty"`
 }
 
 func (m *PromoteToPrivilegedContractProposal) Reset()      { *m = PromoteToPrivilegedContractProposal{} }
@@ -88,6 +94,12 @@ type DemotePrivilegedContractProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
 	// Contract is the address of the smart contract
 	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Force demotes the contract even when the contract fails to handle the
+	// demotion callback
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty" yaml:"force,omitempty"`
+	// Replacement is the optional address of a contract that is promoted before
+	// the demotion so that it can take over critical privileges
+	Replacement string `protobuf:"bytes,5,opt,name=replacement,proto3" json:"replacement,omitempty" yaml:"replacement,omitempty"`
 }
 
 func (m *DemotePrivilegedContractProposal) Reset()      { *m = DemotePrivilegedContractProposal{} }
@@ -137,29 +149,38 @@ func init() {
 }
 
 var fileDescriptor_77ea8b6359ab7726 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x92, 0x4d, 0x4a, 0xc3, 0x40,
-	0x14, 0x80, 0x33, 0x8a, 0xa2, 0x51, 0x50, 0x62, 0x91, 0x5a, 0x64, 0x5a, 0x52, 0x28, 0xae, 0x32,
-	0x14, 0x37, 0xe2, 0xb2, 0xba, 0x74, 0x51, 0x8a, 0x2b, 0x37, 0x32, 0x49, 0xa7, 0xe3, 0x40, 0x92,
-	0x17, 0x66, 0xa6, 0xd5, 0xde, 0xc2, 0x63, 0x78, 0x01, 0xc1, 0x23, 0x74, 0xd9, 0x65, 0x57, 0xc5,
-	0xa6, 0x37, 0xe8, 0x09, 0xa4, 0x33, 0xd3, 0xd2, 0x2b, 0xb8, 0xcb, 0xcb, 0xf7, 0xbd, 0x9f, 0xe1,
-	0x3d, 0xbf, 0x99, 0x40, 0x3e, 0x10, 0x40, 0xf4, 0x3b, 0x55, 0x19, 0x19, 0xb5, 0x63, 0xa6, 0x69,
-	0x9b, 0x14, 0x12, 0x0a, 0x50, 0x34, 0x8d, 0x0a, 0x09, 0x1a, 0x82, 0x8a, 0x95, 0x22, 0x23, 0x45,
-	0x4e, 0xaa, 0x55, 0x38, 0x70, 0x30, 0x02, 0x59, 0x7f, 0x59, 0xb7, 0x86, 0x13, 0x50, 0x19, 0x28,
-	0x12, 0x53, 0xc5, 0xb6, 0xf5, 0x12, 0x10, 0xb9, 0xe3, 0xd7, 0x6b, 0x6e, 0x9a, 0xb9, 0x8e, 0x44,
-	0x8f, 0x0b, 0xa6, 0x1c, 0xbd, 0xb2, 0xd9, 0xaf, 0xb6, 0xac, 0x0d, 0x36, 0x88, 0x03, 0xf0, 0x94,
-	0x11, 0x13, 0xc5, 0xc3, 0x01, 0xa1, 0xf9, 0xd8, 0xa2, 0xf0, 0x07, 0xf9, 0xcd, 0xae, 0x84, 0x0c,
-	0x34, 0x7b, 0x86, 0xae, 0x14, 0x23, 0x91, 0x32, 0xce, 0xfa, 0x0f, 0x90, 0x6b, 0x49, 0x13, 0xdd,
-	0x75, 0xaf, 0x09, 0x5a, 0xfe, 0x81, 0x16, 0x3a, 0x65, 0x55, 0xd4, 0x40, 0x37, 0xc7, 0x9d, 0xf3,
-	0xd5, 0xbc, 0x7e, 0x3a, 0xa6, 0x59, 0x7a, 0x1f, 0x9a, 0xdf, 0x61, 0xcf, 0xe2, 0xe0, 0xce, 0x3f,
-	0xe9, 0x33, 0x95, 0x48, 0x51, 0x68, 0x01, 0x79, 0x75, 0xcf, 0xd8, 0x97, 0xab, 0x79, 0x3d, 0xb0,
-	0xf6, 0x0e, 0x0c, 0x7b, 0xbb, 0x6a, 0x40, 0xfc, 0xa3, 0xc4, 0x75, 0xad, 0xee, 0x9b, 0xb4, 0x8b,
-	0xd5, 0xbc, 0x7e, 0x66, 0xd3, 0x36, 0x24, 0xec, 0x6d, 0xa5, 0xf0, 0x1b, 0xf9, 0x8d, 0x47, 0xb6,
-	0x9e, 0xfc, 0x5f, 0xcd, 0xdd, 0x79, 0x9a, 0x2c, 0xb0, 0x37, 0x5b, 0x60, 0xef, 0xab, 0xc4, 0x68,
-	0x52, 0x62, 0x34, 0x2d, 0x31, 0xfa, 0x2d, 0x31, 0xfa, 0x5c, 0x62, 0x6f, 0xba, 0xc4, 0xde, 0x6c,
-	0x89, 0xbd, 0x97, 0x16, 0x17, 0xfa, 0x6d, 0x18, 0x47, 0x09, 0x64, 0x64, 0x73, 0x68, 0x5c, 0xd2,
-	0x3e, 0x23, 0x1f, 0xee, 0xe2, 0xcc, 0xf2, 0xe3, 0x43, 0xb3, 0xc7, 0xdb, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x6d, 0x82, 0xb6, 0xb6, 0x8e, 0x02, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0xe3, 0xb4, 0xa9, 0x1a, 0x07, 0x09, 0x30, 0x55, 0x31, 0xa1, 0xb2, 0x2d, 0x47, 0x94,
+	0x2c, 0xc0, 0x43, 0x61, 0x83, 0x58, 0x1a, 0xd8, 0xb1, 0x88, 0x22, 0xc4, 0xa2, 0x9b, 0x68, 0xe2,
+	0xbc, 0x98, 0x91, 0x6c, 0x3f, 0x6b, 0x66, 0xda, 0x26, 0xb7, 0xe0, 0x18, 0x48, 0x5c, 0xa4, 0xcb,
+	0x2e, 0xbb, 0xb2, 0x68, 0xb2, 0x65, 0xe5, 0x13, 0xa0, 0xcc, 0x4c, 0x22, 0x4b, 0xf4, 0x02, 0xec,
+	0x3c, 0xf3, 0xfd, 0xbe, 0xf7, 0xcf, 0xf3, 0xec, 0x41, 0x82, 0xc5, 0x9c, 0x21, 0x91, 0x57, 0x54,
+	0xe4, 0xe4, 0xf2, 0x6c, 0x0a, 0x92, 0x9e, 0x91, 0x92, 0x63, 0x89, 0x82, 0x66, 0x51, 0xc9, 0x51,
+	0xa2, 0x73, 0xa4, 0xa1, 0x48, 0x41, 0x91, 0x81, 0xfa, 0x47, 0x29, 0xa6, 0xa8, 0x00, 0xb2, 0xf9,
+	0xd2, 0x6c, 0xdf, 0x4b, 0x50, 0xe4, 0x28, 0xc8, 0x94, 0x0a, 0xd8, 0xc5, 0x4b, 0x90, 0x15, 0x46,
+	0x3f, 0xd9, 0xe8, 0x2a, 0x99, 0xc9, 0x48, 0xe4, 0xb2, 0x04, 0x61, 0xd4, 0x67, 0xda, 0x3d, 0xd1,
+	0x61, 0xf5, 0x61, 0x2b, 0xa5, 0x88, 0x69, 0x06, 0x44, 0x9d, 0xa6, 0x17, 0x73, 0x42, 0x8b, 0xa5,
+	0x91, 0x5e, 0xdf, 0xdb, 0x44, 0x82, 0x85, 0xe4, 0x34, 0x91, 0x13, 0x58, 0x48, 0x28, 0x04, 0x43,
+	0x53, 0x42, 0xf8, 0xa7, 0x6d, 0x0f, 0x46, 0x1c, 0x73, 0x94, 0xf0, 0x15, 0x47, 0x9c, 0x5d, 0xb2,
+	0x0c, 0x52, 0x98, 0x7d, 0x34, 0xfc, 0xc8, 0x34, 0xef, 0x9c, 0xda, 0x1d, 0xc9, 0x64, 0x06, 0xae,
+	0x15, 0x58, 0xc3, 0x6e, 0xfc, 0xa8, 0xae, 0xfc, 0x07, 0x4b, 0x9a, 0x67, 0x1f, 0x42, 0x75, 0x1d,
+	0x8e, 0xb5, 0xec, 0xbc, 0xb7, 0x7b, 0x33, 0x10, 0x09, 0x67, 0xa5, 0x64, 0x58, 0xb8, 0x6d, 0x45,
+	0x1f, 0xd7, 0x95, 0xef, 0x68, 0xba, 0x21, 0x86, 0xe3, 0x26, 0xea, 0x10, 0xfb, 0x70, 0x5b, 0xa5,
+	0xbb, 0xa7, 0x6c, 0x4f, 0xea, 0xca, 0x7f, 0xa8, 0x6d, 0x5b, 0x25, 0x1c, 0xef, 0x20, 0xe7, 0x9b,
+	0xed, 0xd0, 0x2c, 0xc3, 0x2b, 0x98, 0x4d, 0xca, 0x6d, 0xe1, 0xc2, 0xdd, 0x0f, 0xf6, 0x86, 0xdd,
+	0xf8, 0x65, 0x5d, 0xf9, 0x03, 0x6d, 0xfd, 0x97, 0x79, 0x85, 0x39, 0x93, 0x90, 0x97, 0x72, 0x19,
+	0x8e, 0x1f, 0x1b, 0x79, 0xd7, 0xba, 0x70, 0xce, 0xed, 0x03, 0x58, 0x94, 0x8c, 0x2f, 0xdd, 0x4e,
+	0x60, 0x0d, 0x7b, 0x6f, 0x5f, 0x44, 0xf7, 0xfd, 0xf2, 0x68, 0xe7, 0xf8, 0xac, 0xe0, 0xf8, 0x79,
+	0x5d, 0xf9, 0x4f, 0x75, 0x4a, 0x6d, 0x6f, 0xa6, 0x31, 0x11, 0xc3, 0x5f, 0x6d, 0x3b, 0xf8, 0x04,
+	0x9b, 0x69, 0xff, 0x5f, 0xb3, 0x7e, 0x63, 0x77, 0xe6, 0xc8, 0x13, 0x70, 0xf7, 0x03, 0x6b, 0x78,
+	0x18, 0xf7, 0xeb, 0xca, 0x3f, 0xd6, 0xb4, 0xba, 0x6e, 0xb6, 0xaa, 0x41, 0x27, 0xb6, 0x7b, 0x1c,
+	0xca, 0x8c, 0x26, 0x90, 0x43, 0x21, 0xd5, 0x28, 0xbb, 0x71, 0x50, 0x57, 0xfe, 0x89, 0xf6, 0x35,
+	0xc4, 0xa6, 0xbb, 0x69, 0x8a, 0xbf, 0x5c, 0xdf, 0x79, 0xad, 0xdb, 0x3b, 0xaf, 0xf5, 0x73, 0xe5,
+	0x59, 0xd7, 0x2b, 0xcf, 0xba, 0x59, 0x79, 0xd6, 0xef, 0x95, 0x67, 0xfd, 0x58, 0x7b, 0xad, 0x9b,
+	0xb5, 0xd7, 0xba, 0x5d, 0x7b, 0xad, 0xf3, 0xd3, 0x94, 0xc9, 0xef, 0x17, 0xd3, 0x28, 0xc1, 0x9c,
+	0x6c, 0x1f, 0x7f, 0xca, 0xe9, 0x0c, 0xc8, 0xc2, 0x6c, 0x81, 0xda, 0xaa, 0xe9, 0x81, 0x7a, 0xf1,
+	0xef, 0xfe, 0x0e, 0x00, 0xf1, 0xec, 0x5f, 0x77, 0xe7, 0x03, 0x00, 0x00,
 }
 
 func (this *PromoteToPrivilegedContractProposal) Equal(that interface{}) bool {
@@ -190,6 +211,17 @@ func (this *PromoteToPrivilegedContractProposal) Equal(that interface{}) bool {
 	if this.Contract != that1.Contract {
 		return false
 	}
+	if len(this.AllowedPrivileges) != len(that1.AllowedPrivileges) {
+		return false
+	}
+	for i := range this.AllowedPrivileges {
+		if this.AllowedPrivileges[i] != that1.AllowedPrivileges[i] {
+			return false
+		}
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
 	return true
 }
 
@@ -221,6 +253,12 @@ func (this *DemotePrivilegedContractProposal) Equal(that interface{}) bool {
 	if this.Contract != that1.Contract {
 		return false
 	}
+	if this.Force != that1.Force {
+		return false
+	}
+	if this.Replacement != that1.Replacement {
+		return false
+	}
 	return true
 }
 
@@ -244,6 +282,27 @@ func (m *PromoteToPrivilegedContractProposal) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AllowedPrivileges) > 0 {
+		for iNdEx := len(m.AllowedPrivileges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPrivileges[iNdEx])
+			copy(dAtA[i:], m.AllowedPrivileges[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.AllowedPrivileges[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Contract) > 0 {
 		i -= len(m.Contract)
 		copy(dAtA[i:], m.Contract)
@@ -288,6 +347,23 @@ func (m *DemotePrivilegedContractProposal) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.Replacement) > 0 {
+		i -= len(m.Replacement)
+		copy(dAtA[i:], m.Replacement)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Replacement)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Contract) > 0 {
 		i -= len(m.Contract)
 		copy(dAtA[i:], m.Contract)
@@ -342,6 +418,16 @@ func (m *PromoteToPrivilegedContractProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if len(m.AllowedPrivileges) > 0 {
+		for _, s := range m.AllowedPrivileges {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -363,6 +449,13 @@ func (m *DemotePrivilegedContractProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.Force {
+		n += 2
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -499,6 +592,74 @@ func (m *PromoteToPrivilegedContractProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPrivileges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPrivileges = append(m.AllowedPrivileges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &PrivilegeExpiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -646,6 +807,58 @@ func (m *DemotePrivilegedContractProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"strings"
 	"testing"
+	"time"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,47 @@ func TestValidatePromoteToPrivilegedContractProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"with allowed privileges": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				p.AllowedPrivileges = []string{"begin_blocker", "token_minter"}
+			}),
+		},
+		"with unknown allowed privilege": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				p.AllowedPrivileges = []string{"unknown"}
+			}),
+			expErr: true,
+		},
+		"with duplicate allowed privileges": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				p.AllowedPrivileges = []string{"begin_blocker", "begin_blocker"}
+			}),
+			expErr: true,
+		},
+		"with expiry height": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				p.Expiry = &PrivilegeExpiry{Height: 1}
+			}),
+		},
+		"with expiry time": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				now := time.Now()
+				p.Expiry = &PrivilegeExpiry{Time: &now}
+			}),
+		},
+		"with empty expiry": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				p.Expiry = &PrivilegeExpiry{}
+			}),
+			expErr: true,
+		},
+		"with expiry height and time": {
+			src: PromoteProposalFixture(func(p *PromoteToPrivilegedContractProposal) {
+				now := time.Now()
+				p.Expiry = &PrivilegeExpiry{Height: 1, Time: &now}
+			}),
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -70,7 +112,23 @@ func TestValidateDemotePrivilegedContractProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
-
+		"with replacement": {
+			src: DemoteProposalFixture(func(p *DemotePrivilegedContractProposal) {
+				p.Replacement = RandomBech32Address(t)
+			}),
+		},
+		"with invalid replacement address": {
+			src: DemoteProposalFixture(func(p *DemotePrivilegedContractProposal) {
+				p.Replacement = "invalid address"
+			}),
+			expErr: true,
+		},
+		"with demoted contract as replacement": {
+			src: DemoteProposalFixture(func(p *DemotePrivilegedContractProposal) {
+				p.Replacement = p.Contract
+			}),
+			expErr: true,
+		},
 		"base data missing": {
 			src: DemoteProposalFixture(func(p *DemotePrivilegedContractProposal) {
 				p.Title = ""
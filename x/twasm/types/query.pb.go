@@ -11,7 +11,7 @@ import (
 	math_bits "math/bits"
 
 	_ "github.com/CosmWasm/wasmd/x/wasm/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -82,6 +82,8 @@ var xxx_messageInfo_QueryPrivilegedContractsRequest proto.InternalMessageInfo
 type QueryPrivilegedContractsResponse struct {
 	// contracts are a set of contract addresses
 	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// expiries are the pending expirations of privileged contracts
+	Expiries []PrivilegedContractExpiry `protobuf:"bytes,2,rep,name=expiries,proto3" json:"expiries"`
 }
 
 func (m *QueryPrivilegedContractsResponse) Reset()         { *m = QueryPrivilegedContractsResponse{} }
@@ -129,6 +131,71 @@ func (m *QueryPrivilegedContractsResponse) GetContracts() []string {
 	return nil
 }
 
+func (m *QueryPrivilegedContractsResponse) GetExpiries() []PrivilegedContractExpiry {
+	if m != nil {
+		return m.Expiries
+	}
+	return nil
+}
+
+// PrivilegedContractExpiry is the deadline for a privileged contract
+type PrivilegedContractExpiry struct {
+	ContractAddress string          `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Expiry          PrivilegeExpiry `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry"`
+}
+
+func (m *PrivilegedContractExpiry) Reset()         { *m = PrivilegedContractExpiry{} }
+func (m *PrivilegedContractExpiry) String() string { return proto.CompactTextString(m) }
+func (*PrivilegedContractExpiry) ProtoMessage()    {}
+func (*PrivilegedContractExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{2}
+}
+
+func (m *PrivilegedContractExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PrivilegedContractExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivilegedContractExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PrivilegedContractExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegedContractExpiry.Merge(m, src)
+}
+
+func (m *PrivilegedContractExpiry) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PrivilegedContractExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegedContractExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivilegedContractExpiry proto.InternalMessageInfo
+
+func (m *PrivilegedContractExpiry) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *PrivilegedContractExpiry) GetExpiry() PrivilegeExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return PrivilegeExpiry{}
+}
+
 // QueryContractsByPrivilegeTypeRequest is the request type for the
 // Query/ContractsByPrivilegeType RPC method
 type QueryContractsByPrivilegeTypeRequest struct {
@@ -139,7 +206,7 @@ func (m *QueryContractsByPrivilegeTypeRequest) Reset()         { *m = QueryContr
 func (m *QueryContractsByPrivilegeTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByPrivilegeTypeRequest) ProtoMessage()    {}
 func (*QueryContractsByPrivilegeTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dcfe179625ad95e, []int{2}
+	return fileDescriptor_1dcfe179625ad95e, []int{3}
 }
 
 func (m *QueryContractsByPrivilegeTypeRequest) XXX_Unmarshal(b []byte) error {
@@ -191,7 +258,7 @@ func (m *QueryContractsByPrivilegeTypeResponse) Reset()         { *m = QueryCont
 func (m *QueryContractsByPrivilegeTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByPrivilegeTypeResponse) ProtoMessage()    {}
 func (*QueryContractsByPrivilegeTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dcfe179625ad95e, []int{3}
+	return fileDescriptor_1dcfe179625ad95e, []int{4}
 }
 
 func (m *QueryContractsByPrivilegeTypeResponse) XXX_Unmarshal(b []byte) error {
@@ -232,357 +299,1925 @@ func (m *QueryContractsByPrivilegeTypeResponse) GetContracts() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPrivilegedContractsRequest)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsRequest")
-	proto.RegisterType((*QueryPrivilegedContractsResponse)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsResponse")
-	proto.RegisterType((*QueryContractsByPrivilegeTypeRequest)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeRequest")
-	proto.RegisterType((*QueryContractsByPrivilegeTypeResponse)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeResponse")
+// QueryCallbackFailuresRequest is the request type for the
+// Query/CallbackFailures RPC method
+type QueryCallbackFailuresRequest struct{}
+
+func (m *QueryCallbackFailuresRequest) Reset()         { *m = QueryCallbackFailuresRequest{} }
+func (m *QueryCallbackFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackFailuresRequest) ProtoMessage()    {}
+func (*QueryCallbackFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{5}
 }
 
-func init() { proto.RegisterFile("confio/twasm/v1beta1/query.proto", fileDescriptor_1dcfe179625ad95e) }
+func (m *QueryCallbackFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-var fileDescriptor_1dcfe179625ad95e = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0xcf, 0x4b,
-	0xcb, 0xcc, 0xd7, 0x2f, 0x29, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0, 0x07,
-	0xb1, 0x20, 0x6a, 0xa5, 0x64, 0x92, 0xf3, 0x8b, 0x73, 0xc1, 0x26, 0x41, 0x8d, 0xd3, 0x2f, 0xa9,
-	0x2c, 0x48, 0x2d, 0x86, 0xc9, 0xa6, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea,
-	0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0xc1, 0x64, 0xb5, 0x40, 0x7a, 0xf3,
-	0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0x21, 0x0e, 0x80, 0x3b, 0xa7, 0x20, 0x31, 0x3d, 0x33, 0x0f,
-	0xac, 0x18, 0xa2, 0x56, 0x49, 0x91, 0x4b, 0x3e, 0x10, 0xa4, 0x22, 0xa0, 0x28, 0xb3, 0x2c, 0x33,
-	0x27, 0x35, 0x3d, 0x35, 0xc5, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x38, 0x28, 0xb5,
-	0xb0, 0x34, 0xb5, 0xb8, 0x44, 0xc9, 0x81, 0x4b, 0x01, 0xb7, 0x92, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x19, 0x2e, 0xce, 0x64, 0x98, 0xa0, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x42,
-	0x40, 0xc9, 0x97, 0x4b, 0x05, 0x6c, 0x02, 0x5c, 0x9f, 0x13, 0xc2, 0xb0, 0x90, 0xca, 0x82, 0x54,
-	0xa8, 0x4d, 0x42, 0xaa, 0x5c, 0x7c, 0x05, 0x30, 0xf1, 0x78, 0x90, 0x7f, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x83, 0x78, 0x0b, 0x90, 0x55, 0x2b, 0xb9, 0x72, 0xa9, 0x12, 0x30, 0x8e, 0x18, 0x57,
-	0x19, 0xcd, 0x61, 0xe6, 0x62, 0x05, 0x9b, 0x23, 0xb4, 0x83, 0x91, 0x4b, 0x18, 0x8b, 0xef, 0x84,
-	0x4c, 0xf5, 0xb0, 0xc5, 0x98, 0x1e, 0x81, 0x00, 0x93, 0x32, 0x23, 0x55, 0x1b, 0xc4, 0xb9, 0x4a,
-	0x46, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0xd2, 0x11, 0xd2, 0xd2, 0x2f, 0x49, 0x2f, 0x4a, 0x4c, 0x49,
-	0x45, 0x4b, 0x4a, 0x70, 0x97, 0xeb, 0xc3, 0x83, 0x23, 0x45, 0xe8, 0x3a, 0x23, 0x97, 0x04, 0xae,
-	0x70, 0x10, 0xb2, 0xc2, 0xe3, 0x10, 0x02, 0x71, 0x21, 0x65, 0x4d, 0x96, 0x5e, 0xa8, 0x4f, 0x9c,
-	0xc0, 0x3e, 0xb1, 0x11, 0xb2, 0x22, 0xda, 0x27, 0xfa, 0xd5, 0xa8, 0x31, 0x5f, 0xeb, 0xe4, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xb0, 0x4c, 0x07, 0xb1, 0xa6, 0x02, 0x6a, 0x11, 0x38, 0xaf,
-	0x24, 0xb1, 0x81, 0x93, 0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x07, 0x61, 0xc0, 0x9a,
-	0x03, 0x00, 0x00,
+func (m *QueryCallbackFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryCallbackFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackFailuresRequest.Merge(m, src)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryCallbackFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// PrivilegedContracts returns all privileged contracts
-	PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error)
-	// ContractsByPrivilegeType returns all contracts that have registered for the
-	// privilege type
-	ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error)
+func (m *QueryCallbackFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackFailuresRequest.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryCallbackFailuresRequest proto.InternalMessageInfo
+
+// QueryCallbackFailuresResponse is the response type for the
+// Query/CallbackFailures RPC method
+type QueryCallbackFailuresResponse struct {
+	// counters are the failure counters of all contracts with failed callbacks
+	Counters []CallbackFailureCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCallbackFailuresResponse) Reset()         { *m = QueryCallbackFailuresResponse{} }
+func (m *QueryCallbackFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCallbackFailuresResponse) ProtoMessage()    {}
+func (*QueryCallbackFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{6}
 }
 
-func (c *queryClient) PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error) {
-	out := new(QueryPrivilegedContractsResponse)
-	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/PrivilegedContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCallbackFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (c *queryClient) ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error) {
-	out := new(QueryContractsByPrivilegeTypeResponse)
-	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCallbackFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCallbackFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// PrivilegedContracts returns all privileged contracts
-	PrivilegedContracts(context.Context, *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error)
-	// ContractsByPrivilegeType returns all contracts that have registered for the
-	// privilege type
-	ContractsByPrivilegeType(context.Context, *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error)
+func (m *QueryCallbackFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCallbackFailuresResponse.Merge(m, src)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
-
-func (*UnimplementedQueryServer) PrivilegedContracts(ctx context.Context, req *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedContracts not implemented")
+func (m *QueryCallbackFailuresResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (*UnimplementedQueryServer) ContractsByPrivilegeType(ctx context.Context, req *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractsByPrivilegeType not implemented")
+func (m *QueryCallbackFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCallbackFailuresResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryCallbackFailuresResponse proto.InternalMessageInfo
 
-func _Query_PrivilegedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPrivilegedContractsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PrivilegedContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.twasm.v1beta1.Query/PrivilegedContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PrivilegedContracts(ctx, req.(*QueryPrivilegedContractsRequest))
+func (m *QueryCallbackFailuresResponse) GetCounters() []CallbackFailureCounter {
+	if m != nil {
+		return m.Counters
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ContractsByPrivilegeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractsByPrivilegeTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractsByPrivilegeType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractsByPrivilegeType(ctx, req.(*QueryContractsByPrivilegeTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// CallbackFailureCounter is the number of consecutive failed callbacks for a
+// contract and privilege type
+type CallbackFailureCounter struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	PrivilegeType   string `protobuf:"bytes,2,opt,name=privilege_type,json=privilegeType,proto3" json:"privilege_type,omitempty"`
+	Failures        uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.twasm.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PrivilegedContracts",
-			Handler:    _Query_PrivilegedContracts_Handler,
-		},
-		{
-			MethodName: "ContractsByPrivilegeType",
-			Handler:    _Query_ContractsByPrivilegeType_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/twasm/v1beta1/query.proto",
+func (m *CallbackFailureCounter) Reset()         { *m = CallbackFailureCounter{} }
+func (m *CallbackFailureCounter) String() string { return proto.CompactTextString(m) }
+func (*CallbackFailureCounter) ProtoMessage()    {}
+func (*CallbackFailureCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{7}
 }
 
-func (m *QueryPrivilegedContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackFailureCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CallbackFailureCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackFailureCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryPrivilegedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CallbackFailureCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackFailureCounter.Merge(m, src)
 }
 
-func (m *QueryPrivilegedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *CallbackFailureCounter) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryPrivilegedContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CallbackFailureCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackFailureCounter.DiscardUnknown(m)
 }
 
-func (m *QueryPrivilegedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CallbackFailureCounter proto.InternalMessageInfo
+
+func (m *CallbackFailureCounter) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func (m *QueryPrivilegedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CallbackFailureCounter) GetPrivilegeType() string {
+	if m != nil {
+		return m.PrivilegeType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CallbackFailureCounter) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryScheduledCallbacksRequest is the request type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksRequest struct {
+	// ContractAddress is the address of the contract to query
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrivilegeType) > 0 {
-		i -= len(m.PrivilegeType)
-		copy(dAtA[i:], m.PrivilegeType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryScheduledCallbacksRequest) Reset()         { *m = QueryScheduledCallbacksRequest{} }
+func (m *QueryScheduledCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksRequest) ProtoMessage()    {}
+func (*QueryScheduledCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{8}
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduledCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.Merge(m, src)
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryScheduledCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCallbacksRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScheduledCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Contracts[iNdEx])
-			copy(dAtA[i:], m.Contracts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+// QueryScheduledCallbacksResponse is the response type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksResponse struct {
+	// callbacks are the pending callbacks of the contract ordered by ID
+	Callbacks []ScheduledCallback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallbacksResponse) Reset()         { *m = QueryScheduledCallbacksResponse{} }
+func (m *QueryScheduledCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksResponse) ProtoMessage()    {}
+func (*QueryScheduledCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{9}
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduledCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.Merge(m, src)
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryScheduledCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCallbacksResponse) GetCallbacks() []ScheduledCallback {
+	if m != nil {
+		return m.Callbacks
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
 
-func (m *QueryPrivilegedContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduledCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryPrivilegedContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryMintedSupplyRequest is the request type for the Query/MintedSupply RPC
+// method
+type QueryMintedSupplyRequest struct {
+	// ContractAddress is the address of the contract to query
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryMintedSupplyRequest) Reset()         { *m = QueryMintedSupplyRequest{} }
+func (m *QueryMintedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyRequest) ProtoMessage()    {}
+func (*QueryMintedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{10}
+}
+
+func (m *QueryMintedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMintedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+}
+
+func (m *QueryMintedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyRequest.Merge(m, src)
+}
+
+func (m *QueryMintedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMintedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryMintedSupplyRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryMintedSupplyResponse is the response type for the Query/MintedSupply RPC
+// method
+type QueryMintedSupplyResponse struct {
+	// supply are the minted totals of the contract ordered by denom
+	Supply []MintedSupply `protobuf:"bytes,1,rep,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryMintedSupplyResponse) Reset()         { *m = QueryMintedSupplyResponse{} }
+func (m *QueryMintedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyResponse) ProtoMessage()    {}
+func (*QueryMintedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dcfe179625ad95e, []int{11}
+}
+
+func (m *QueryMintedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryMintedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyResponse.Merge(m, src)
+}
+
+func (m *QueryMintedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryMintedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMintedSupplyResponse) GetSupply() []MintedSupply {
+	if m != nil {
+		return m.Supply
 	}
-	var l int
-	_ = l
-	l = len(m.PrivilegeType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPrivilegedContractsRequest)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsRequest")
+	proto.RegisterType((*QueryPrivilegedContractsResponse)(nil), "confio.twasm.v1beta1.QueryPrivilegedContractsResponse")
+	proto.RegisterType((*PrivilegedContractExpiry)(nil), "confio.twasm.v1beta1.PrivilegedContractExpiry")
+	proto.RegisterType((*QueryContractsByPrivilegeTypeRequest)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeRequest")
+	proto.RegisterType((*QueryContractsByPrivilegeTypeResponse)(nil), "confio.twasm.v1beta1.QueryContractsByPrivilegeTypeResponse")
+	proto.RegisterType((*QueryCallbackFailuresRequest)(nil), "confio.twasm.v1beta1.QueryCallbackFailuresRequest")
+	proto.RegisterType((*QueryCallbackFailuresResponse)(nil), "confio.twasm.v1beta1.QueryCallbackFailuresResponse")
+	proto.RegisterType((*CallbackFailureCounter)(nil), "confio.twasm.v1beta1.CallbackFailureCounter")
+	proto.RegisterType((*QueryScheduledCallbacksRequest)(nil), "confio.twasm.v1beta1.QueryScheduledCallbacksRequest")
+	proto.RegisterType((*QueryScheduledCallbacksResponse)(nil), "confio.twasm.v1beta1.QueryScheduledCallbacksResponse")
+	proto.RegisterType((*QueryMintedSupplyRequest)(nil), "confio.twasm.v1beta1.QueryMintedSupplyRequest")
+	proto.RegisterType((*QueryMintedSupplyResponse)(nil), "confio.twasm.v1beta1.QueryMintedSupplyResponse")
+}
+
+func init() { proto.RegisterFile("confio/twasm/v1beta1/query.proto", fileDescriptor_1dcfe179625ad95e) }
+
+var fileDescriptor_1dcfe179625ad95e = []byte{
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xce, 0x04, 0x96, 0x25, 0x8f, 0xfd, 0x81, 0x66, 0xd1, 0x2a, 0x6b, 0xb1, 0x26, 0x6b, 0x2d,
+	0x90, 0x45, 0xc4, 0x16, 0x61, 0x59, 0x69, 0xd9, 0x3d, 0xd0, 0x20, 0x28, 0x52, 0x05, 0xa2, 0xa1,
+	0xa7, 0x4a, 0x15, 0x72, 0xec, 0xc1, 0x58, 0x4d, 0x3c, 0xc6, 0xe3, 0x50, 0x22, 0xc4, 0xa5, 0x87,
+	0x1e, 0x7a, 0xaa, 0xca, 0xff, 0xd2, 0x1f, 0xff, 0x01, 0x47, 0xaa, 0x1e, 0xda, 0x53, 0x55, 0x41,
+	0xfb, 0x7f, 0x54, 0x19, 0xcf, 0x38, 0x81, 0x38, 0x09, 0xe1, 0x96, 0x3c, 0x7f, 0xef, 0x9b, 0xef,
+	0x7b, 0x6f, 0xde, 0xd3, 0x40, 0xce, 0xa2, 0xde, 0x9e, 0x4b, 0x8d, 0xf0, 0x89, 0xc9, 0x6a, 0xc6,
+	0xe1, 0x42, 0x85, 0x84, 0xe6, 0x82, 0x71, 0x50, 0x27, 0x41, 0x43, 0xf7, 0x03, 0x1a, 0x52, 0x3c,
+	0x11, 0x21, 0x74, 0x8e, 0xd0, 0x05, 0x42, 0x99, 0x70, 0xa8, 0x43, 0x39, 0xc0, 0x68, 0xfe, 0x8a,
+	0xb0, 0xca, 0xa4, 0x45, 0x59, 0x8d, 0x33, 0x09, 0x3a, 0x23, 0x6c, 0xf8, 0x84, 0xc9, 0xaf, 0x0e,
+	0xa5, 0x4e, 0x95, 0x18, 0xa6, 0xef, 0x1a, 0xa6, 0xe7, 0xd1, 0xd0, 0x0c, 0x5d, 0xea, 0xc9, 0xaf,
+	0x73, 0xcd, 0x5c, 0xca, 0x8c, 0x8a, 0xc9, 0x48, 0x24, 0x20, 0x96, 0xe3, 0x9b, 0x8e, 0xeb, 0x71,
+	0xb0, 0xc0, 0x16, 0x12, 0x55, 0x5b, 0xd4, 0x0b, 0x03, 0xd3, 0x0a, 0x77, 0xc9, 0x51, 0x48, 0x3c,
+	0x16, 0xc3, 0xb5, 0x3f, 0x60, 0xea, 0x7e, 0x93, 0x70, 0x3b, 0x70, 0x0f, 0xdd, 0x2a, 0x71, 0x88,
+	0xbd, 0x2a, 0xa0, 0xac, 0x4c, 0x0e, 0xea, 0x84, 0x85, 0xda, 0x4b, 0x04, 0xb9, 0xee, 0x18, 0xe6,
+	0x53, 0x8f, 0x11, 0x3c, 0x09, 0x19, 0x79, 0x06, 0xcb, 0xa2, 0xdc, 0x50, 0x3e, 0x53, 0x6e, 0x05,
+	0xf0, 0x36, 0x8c, 0x92, 0x23, 0xdf, 0x0d, 0x5c, 0xc2, 0xb2, 0xe9, 0xdc, 0x50, 0x7e, 0xac, 0xa8,
+	0xeb, 0x49, 0xb5, 0xd3, 0x3b, 0x8f, 0x58, 0x6b, 0xe6, 0x35, 0x4a, 0xc3, 0x67, 0x9f, 0xa6, 0x52,
+	0xe5, 0x98, 0x45, 0x7b, 0x8e, 0x20, 0xdb, 0x0d, 0x8c, 0xff, 0x82, 0xf1, 0xd8, 0xb0, 0x69, 0xdb,
+	0x01, 0x61, 0x4d, 0x4d, 0x28, 0x9f, 0x29, 0xff, 0x2c, 0xe3, 0x77, 0xa2, 0x30, 0x5e, 0x85, 0x11,
+	0xce, 0xd9, 0xc8, 0xa6, 0x73, 0x28, 0x3f, 0x56, 0x9c, 0xee, 0xa3, 0xeb, 0x8a, 0x1c, 0x91, 0xaa,
+	0x6d, 0xc2, 0x9f, 0xbc, 0x40, 0x71, 0x59, 0x4a, 0xad, 0x5a, 0x3d, 0x68, 0xf8, 0x44, 0x54, 0x12,
+	0x4f, 0xc3, 0x4f, 0xbe, 0x8c, 0xef, 0x36, 0xdb, 0x2f, 0x54, 0xfd, 0xe8, 0xb7, 0xa3, 0xb5, 0x35,
+	0x98, 0xee, 0x43, 0x77, 0x93, 0xa2, 0x6b, 0x2a, 0x4c, 0x46, 0x34, 0x66, 0xb5, 0x5a, 0x31, 0xad,
+	0xc7, 0xeb, 0xa6, 0x5b, 0xad, 0x07, 0x24, 0xee, 0x2b, 0x85, 0xdf, 0xbb, 0x7c, 0x17, 0xf4, 0x5b,
+	0x30, 0x6a, 0xd1, 0xba, 0x17, 0x92, 0x20, 0x62, 0x1f, 0x2b, 0xce, 0x27, 0x57, 0xe7, 0x1a, 0xc3,
+	0x6a, 0x94, 0x24, 0x7b, 0x26, 0x39, 0xb4, 0x67, 0x08, 0x7e, 0x4d, 0x86, 0x0e, 0xd2, 0xb1, 0xce,
+	0x22, 0xa6, 0x13, 0x8a, 0x88, 0x15, 0x18, 0xdd, 0x13, 0x86, 0xb2, 0x43, 0x39, 0x94, 0x1f, 0x2e,
+	0xc7, 0xff, 0xb5, 0x53, 0x04, 0x2a, 0xb7, 0xbe, 0x63, 0xed, 0x13, 0xbb, 0x5e, 0x25, 0xb6, 0x94,
+	0x25, 0x8b, 0x33, 0x88, 0xa0, 0x75, 0x80, 0xd6, 0x14, 0x8a, 0x6b, 0x34, 0xa3, 0x47, 0x23, 0xab,
+	0x37, 0x47, 0x56, 0x8f, 0x76, 0x46, 0x7c, 0x97, 0x4c, 0x47, 0xde, 0x88, 0x72, 0x5b, 0xa6, 0xf6,
+	0x1a, 0xc1, 0x54, 0x57, 0x55, 0xa2, 0x25, 0xf7, 0x20, 0x63, 0xc9, 0xa0, 0xe8, 0xc9, 0x6c, 0x72,
+	0x4f, 0x3a, 0x48, 0x44, 0x3b, 0x5a, 0xf9, 0xf8, 0x6e, 0x82, 0xf0, 0xd9, 0xbe, 0xc2, 0x23, 0x25,
+	0x57, 0x94, 0xaf, 0x41, 0x96, 0x0b, 0xdf, 0x74, 0xbd, 0x90, 0xd8, 0x3b, 0x75, 0xdf, 0xaf, 0x36,
+	0x06, 0x2f, 0xa4, 0xf6, 0x08, 0x7e, 0x4b, 0xa0, 0x11, 0xce, 0x57, 0x60, 0x84, 0xf1, 0x88, 0xb0,
+	0xad, 0x25, 0xdb, 0x6e, 0xcf, 0x95, 0x53, 0x1a, 0xe5, 0x15, 0xbf, 0x7e, 0x0f, 0xdf, 0x71, 0x7e,
+	0xfc, 0x06, 0xc1, 0x2f, 0x09, 0xcb, 0x0c, 0x2f, 0x25, 0x73, 0xf6, 0x59, 0x90, 0xca, 0x3f, 0x83,
+	0xa6, 0x45, 0x96, 0xb4, 0xe2, 0xd3, 0xf7, 0x5f, 0x4e, 0xd3, 0xf3, 0x78, 0xce, 0x08, 0x9d, 0xc0,
+	0xb4, 0x49, 0x97, 0x9d, 0xcd, 0x8c, 0xf8, 0x66, 0xdb, 0xf8, 0x03, 0x82, 0x6c, 0xb7, 0xbd, 0x80,
+	0x97, 0x7b, 0x08, 0xe9, 0xb3, 0x9b, 0x94, 0xff, 0x6e, 0x95, 0x2b, 0x9c, 0x94, 0xb8, 0x93, 0xff,
+	0xf1, 0xf2, 0x8d, 0x9d, 0x18, 0xc7, 0x57, 0x87, 0xf8, 0x04, 0xbf, 0x42, 0x30, 0x7e, 0x7d, 0x15,
+	0xe1, 0x62, 0x2f, 0x55, 0xc9, 0x7b, 0x4d, 0x59, 0x1c, 0x28, 0x47, 0x38, 0xf8, 0x97, 0x3b, 0x58,
+	0xc4, 0x0b, 0xfd, 0x1c, 0xc8, 0xf1, 0x29, 0xc8, 0x6d, 0x82, 0xdf, 0x21, 0xc0, 0x9d, 0x23, 0x8b,
+	0xff, 0xee, 0x21, 0xa3, 0xeb, 0xde, 0x51, 0x96, 0x06, 0xcc, 0x12, 0xf2, 0xb7, 0xb8, 0xfc, 0x0d,
+	0xbc, 0xde, 0x4f, 0xfe, 0xf1, 0xf5, 0x61, 0x3c, 0x31, 0x98, 0xa4, 0x2d, 0xb4, 0x56, 0xc3, 0x5b,
+	0x04, 0x3f, 0xb4, 0x8f, 0x12, 0xd6, 0x7b, 0xe8, 0x4a, 0x18, 0x7b, 0xc5, 0xb8, 0x31, 0x5e, 0x38,
+	0xd8, 0xe0, 0x0e, 0x4a, 0x78, 0xe5, 0x16, 0x0e, 0x6a, 0x9c, 0xb0, 0x10, 0xcd, 0x79, 0x69, 0xe5,
+	0xec, 0x42, 0x45, 0xe7, 0x17, 0x2a, 0xfa, 0x7c, 0xa1, 0xa2, 0x17, 0x97, 0x6a, 0xea, 0xfc, 0x52,
+	0x4d, 0x7d, 0xbc, 0x54, 0x53, 0x0f, 0x67, 0x1c, 0x37, 0xdc, 0xaf, 0x57, 0x74, 0x8b, 0xd6, 0x0c,
+	0xf9, 0x4c, 0x8a, 0x0e, 0x3b, 0x12, 0xc7, 0xf1, 0x37, 0x59, 0x65, 0x84, 0xbf, 0x8d, 0x16, 0xbf,
+	0x0d, 0x00, 0xd3, 0xc4, 0xae, 0x20, 0x02, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PrivilegedContracts returns all privileged contracts
+	PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error)
+	// ContractsByPrivilegeType returns all contracts that have registered for the
+	// privilege type
+	ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error)
+	// CallbackFailures returns the consecutive failure counters of the privileged
+	// contract callbacks
+	CallbackFailures(ctx context.Context, in *QueryCallbackFailuresRequest, opts ...grpc.CallOption) (*QueryCallbackFailuresResponse, error)
+	// ScheduledCallbacks returns the pending scheduled callbacks of a contract
+	ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error)
+	// MintedSupply returns the running totals of tokens minted by a contract
+	MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error) {
+	out := new(QueryPrivilegedContractsResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/PrivilegedContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractsByPrivilegeType(ctx context.Context, in *QueryContractsByPrivilegeTypeRequest, opts ...grpc.CallOption) (*QueryContractsByPrivilegeTypeResponse, error) {
+	out := new(QueryContractsByPrivilegeTypeResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CallbackFailures(ctx context.Context, in *QueryCallbackFailuresRequest, opts ...grpc.CallOption) (*QueryCallbackFailuresResponse, error) {
+	out := new(QueryCallbackFailuresResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/CallbackFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error) {
+	out := new(QueryScheduledCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/ScheduledCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error) {
+	out := new(QueryMintedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/confio.twasm.v1beta1.Query/MintedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PrivilegedContracts returns all privileged contracts
+	PrivilegedContracts(context.Context, *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error)
+	// ContractsByPrivilegeType returns all contracts that have registered for the
+	// privilege type
+	ContractsByPrivilegeType(context.Context, *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error)
+	// CallbackFailures returns the consecutive failure counters of the privileged
+	// contract callbacks
+	CallbackFailures(context.Context, *QueryCallbackFailuresRequest) (*QueryCallbackFailuresResponse, error)
+	// ScheduledCallbacks returns the pending scheduled callbacks of a contract
+	ScheduledCallbacks(context.Context, *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error)
+	// MintedSupply returns the running totals of tokens minted by a contract
+	MintedSupply(context.Context, *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) PrivilegedContracts(ctx context.Context, req *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedContracts not implemented")
+}
+
+func (*UnimplementedQueryServer) ContractsByPrivilegeType(ctx context.Context, req *QueryContractsByPrivilegeTypeRequest) (*QueryContractsByPrivilegeTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByPrivilegeType not implemented")
+}
+
+func (*UnimplementedQueryServer) CallbackFailures(ctx context.Context, req *QueryCallbackFailuresRequest) (*QueryCallbackFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackFailures not implemented")
+}
+
+func (*UnimplementedQueryServer) ScheduledCallbacks(ctx context.Context, req *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCallbacks not implemented")
+}
+
+func (*UnimplementedQueryServer) MintedSupply(ctx context.Context, req *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintedSupply not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PrivilegedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrivilegedContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrivilegedContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/PrivilegedContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrivilegedContracts(ctx, req.(*QueryPrivilegedContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByPrivilegeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByPrivilegeTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByPrivilegeType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/ContractsByPrivilegeType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByPrivilegeType(ctx, req.(*QueryContractsByPrivilegeTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallbackFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCallbackFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallbackFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/CallbackFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallbackFailures(ctx, req.(*QueryCallbackFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/ScheduledCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, req.(*QueryScheduledCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.twasm.v1beta1.Query/MintedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintedSupply(ctx, req.(*QueryMintedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.twasm.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PrivilegedContracts",
+			Handler:    _Query_PrivilegedContracts_Handler,
+		},
+		{
+			MethodName: "ContractsByPrivilegeType",
+			Handler:    _Query_ContractsByPrivilegeType_Handler,
+		},
+		{
+			MethodName: "CallbackFailures",
+			Handler:    _Query_CallbackFailures_Handler,
+		},
+		{
+			MethodName: "ScheduledCallbacks",
+			Handler:    _Query_ScheduledCallbacks_Handler,
+		},
+		{
+			MethodName: "MintedSupply",
+			Handler:    _Query_MintedSupply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/twasm/v1beta1/query.proto",
+}
+
+func (m *QueryPrivilegedContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrivilegedContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivilegedContractExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivilegedContractExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivilegedContractExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCallbackFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCallbackFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCallbackFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counters) > 0 {
+		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackFailureCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackFailureCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackFailureCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrivilegeType) > 0 {
+		i -= len(m.PrivilegeType)
+		copy(dAtA[i:], m.PrivilegeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrivilegeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryPrivilegedContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPrivilegedContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrivilegedContractExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCallbackFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCallbackFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counters) > 0 {
+		for _, e := range m.Counters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackFailureCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrivilegeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovQuery(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiries = append(m.Expiries, PrivilegedContractExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PrivilegedContractExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivilegedContractExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivilegedContractExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryCallbackFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryCallbackFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCallbackFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCallbackFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counters = append(m.Counters, CallbackFailureCounter{})
+			if err := m.Counters[len(m.Counters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *CallbackFailureCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -605,12 +2240,95 @@ func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackFailureCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackFailureCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -633,7 +2351,7 @@ func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallbacksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,15 +2374,15 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -692,7 +2410,43 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -716,7 +2470,7 @@ func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,17 +2493,17 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -759,23 +2513,61 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivilegeType = string(dAtA[iNdEx:postIndex])
+			m.Callbacks = append(m.Callbacks, ScheduledCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -799,7 +2591,7 @@ func (m *QueryContractsByPrivilegeTypeRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,15 +2614,15 @@ func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByPrivilegeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -858,7 +2650,92 @@ func (m *QueryContractsByPrivilegeTypeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMintedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, MintedSupply{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex